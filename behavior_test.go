@@ -0,0 +1,185 @@
+package pgdesc
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestSystemObjects checks that commands exclude system objects only when
+// neither a pattern nor showSystem is given, as psql does.
+func TestSystemObjects(t *testing.T) {
+	tests := []struct {
+		name    string
+		f       func(*PgDesc, string, bool) (*Result, error)
+		pattern string
+		system  bool
+		col     string
+		min     int
+		has     []string
+		none    []string
+	}{
+		{"Tables", queryTables, "", false, "Schema", 0, []string{"public"}, []string{"pg_catalog", "information_schema"}},
+		{"Tables", queryTables, "", true, "Schema", 0, []string{"public", "pg_catalog"}, nil},
+		{"Tables", queryTables, "pg_class", false, "Schema", 0, []string{"pg_catalog"}, nil},
+		{"TableDetails", queryTableDetails, "", false, "nspname", 0, []string{"public"}, []string{"pg_catalog", "information_schema"}},
+		{"TableDetails", queryTableDetails, "pg_class", false, "nspname", 0, []string{"pg_catalog"}, nil},
+		{"TableDetails", queryTableDetails, "information_schema.tables", false, "nspname", 0, []string{"information_schema"}, nil},
+		{"ObjectDescription", queryObjectDescription, "", false, "Schema", 0, []string{"public"}, []string{"pg_catalog", "information_schema"}},
+		{"Roles", queryRoles, "", false, "rolname", 0, []string{"pgdesc_role"}, []string{"pg_"}},
+		{"Roles", queryRoles, "pg_monitor", false, "rolname", 100000, []string{"pg_monitor"}, nil},
+		{"Schemas", querySchemas, "", false, "Name", 0, []string{"public"}, []string{"pg_", "information_schema"}},
+		{"Schemas", querySchemas, "pg_catalog", false, "Name", 0, []string{"pg_catalog"}, nil},
+		{"Functions", queryFunctions, "", false, "Schema", 0, []string{"public"}, []string{"pg_catalog", "information_schema"}},
+		{"Functions", queryFunctions, "pg_catalog.lower", false, "Schema", 0, []string{"pg_catalog"}, nil},
+	}
+	forEachCluster(t, func(t *testing.T, c *testCluster) {
+		d := c.desc()
+		for _, test := range tests {
+			t.Run(test.name+"/"+test.pattern, func(t *testing.T) {
+				if d.version < test.min {
+					t.Skipf("requires server version %d", test.min)
+				}
+				res, err := test.f(d, test.pattern, test.system)
+				if err != nil {
+					t.Fatalf("expected no error, got: %v", err)
+				}
+				values := column(t, res, test.col)
+				for _, s := range test.has {
+					if !contains(values, s) {
+						t.Errorf("expected %s to contain %q, got: %q", test.col, s, values)
+					}
+				}
+				for _, v := range values {
+					for _, s := range test.none {
+						if strings.HasPrefix(v, s) {
+							t.Errorf("expected no %s starting with %q, got: %q", test.col, s, v)
+						}
+					}
+				}
+			})
+		}
+	})
+}
+
+// TestSystemObjectsQuery checks the system object exclusions in the queries
+// built by the commands, without a server.
+func TestSystemObjectsQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		f       func(*PgDesc, *QueryBuffer, string, bool) error
+		exclude string
+	}{
+		{"Tables", func(d *PgDesc, w *QueryBuffer, pattern string, system bool) error {
+			return d.Tables(w, "", pattern, false, system)
+		}, "n.nspname <> 'pg_catalog'"},
+		{"TableDetails", func(d *PgDesc, w *QueryBuffer, pattern string, system bool) error {
+			return d.TableDetails(w, pattern, false, system)
+		}, "n.nspname <> 'pg_catalog'"},
+		{"ObjectDescription", func(d *PgDesc, w *QueryBuffer, pattern string, system bool) error {
+			return d.ObjectDescription(w, pattern, system)
+		}, "n.nspname <> 'pg_catalog'"},
+		{"Roles", func(d *PgDesc, w *QueryBuffer, pattern string, system bool) error {
+			return d.Roles(w, pattern, false, system)
+		}, "r.rolname !~ '^pg_'"},
+		{"Aggregates", func(d *PgDesc, w *QueryBuffer, pattern string, system bool) error {
+			return d.Aggregates(w, pattern, false, system)
+		}, "n.nspname <> 'pg_catalog'"},
+		{"Collations", func(d *PgDesc, w *QueryBuffer, pattern string, system bool) error {
+			return d.Collations(w, pattern, false, system)
+		}, "n.nspname <> 'pg_catalog'"},
+		{"Conversions", func(d *PgDesc, w *QueryBuffer, pattern string, system bool) error {
+			return d.Conversions(w, pattern, false, system)
+		}, "n.nspname <> 'pg_catalog'"},
+		{"Domains", func(d *PgDesc, w *QueryBuffer, pattern string, system bool) error {
+			return d.Domains(w, pattern, false, system)
+		}, "n.nspname <> 'pg_catalog'"},
+		{"Functions", func(d *PgDesc, w *QueryBuffer, pattern string, system bool) error {
+			return d.Functions(w, "", pattern, false, system)
+		}, "n.nspname <> 'pg_catalog'"},
+		{"Languages", func(d *PgDesc, w *QueryBuffer, pattern string, system bool) error {
+			return d.Languages(w, pattern, false, system)
+		}, "l.lanplcallfoid != 0"},
+		{"Operators", func(d *PgDesc, w *QueryBuffer, pattern string, system bool) error {
+			return d.Operators(w, pattern, false, system)
+		}, "n.nspname <> 'pg_catalog'"},
+		{"Schemas", func(d *PgDesc, w *QueryBuffer, pattern string, system bool) error {
+			return d.Schemas(w, pattern, false, system)
+		}, "n.nspname !~ '^pg_'"},
+		{"Types", func(d *PgDesc, w *QueryBuffer, pattern string, system bool) error {
+			return d.Types(w, pattern, false, system)
+		}, "n.nspname <> 'pg_catalog'"},
+	}
+	d := NewPgDesc(nil, 170000)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, c := range []struct {
+				pattern string
+				system  bool
+				exp     bool
+			}{
+				{"", false, true},
+				{"", true, false},
+				{"foo", false, false},
+				{"foo", true, false},
+			} {
+				buf := new(QueryBuffer)
+				if err := test.f(d, buf, c.pattern, c.system); err != nil {
+					t.Fatalf("expected no error, got: %v", err)
+				}
+				if s := buf.String(); strings.Contains(s, test.exclude) != c.exp {
+					t.Errorf("pattern %q, showSystem %t: expected exclusion %t, got:\n%s", c.pattern, c.system, c.exp, s)
+				}
+			}
+		})
+	}
+}
+
+func queryTables(d *PgDesc, pattern string, system bool) (*Result, error) {
+	return d.QueryTables("", pattern, false, system)
+}
+
+func queryTableDetails(d *PgDesc, pattern string, system bool) (*Result, error) {
+	return d.QueryTableDetails(pattern, false, system)
+}
+
+func queryObjectDescription(d *PgDesc, pattern string, system bool) (*Result, error) {
+	return d.QueryObjectDescription(pattern, system)
+}
+
+func queryRoles(d *PgDesc, pattern string, system bool) (*Result, error) {
+	return d.QueryRoles(pattern, false, system)
+}
+
+func querySchemas(d *PgDesc, pattern string, system bool) (*Result, error) {
+	return d.QuerySchemas(pattern, false, system)
+}
+
+func queryFunctions(d *PgDesc, pattern string, system bool) (*Result, error) {
+	return d.QueryFunctions("", pattern, false, system)
+}
+
+// column returns the values of the named column in res.
+func column(t *testing.T, res *Result, name string) []string {
+	t.Helper()
+	for j, col := range res.Columns {
+		if col == name {
+			values := make([]string, res.Len())
+			for i := range values {
+				values[i] = res.Value(i, j)
+			}
+			return values
+		}
+	}
+	t.Fatalf("expected column %q, got: %q", name, res.Columns)
+	return nil
+}
+
+// contains returns whether or not values contains s.
+func contains(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,439 @@
+package pgdesc
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	_ "github.com/lib/pq"
+)
+
+// testCluster is a throwaway postgres cluster started for the tests,
+// listening only on a unix socket in a temporary directory.
+type testCluster struct {
+	bindir  string
+	name    string
+	dir     string
+	db      *sql.DB
+	version int
+	err     error
+}
+
+// clusterFixture is the schema loaded into each test cluster, covering the
+// objects described by the commands. Statements are only executed on servers
+// with at least the listed version.
+var clusterFixture = []struct {
+	version int
+	sql     string
+}{
+	{0, `CREATE SCHEMA pgdesc_s`},
+	{0, `CREATE TABLE public.pgdesc_t (id integer CONSTRAINT pgdesc_t_id_check CHECK (id > 0), name text)`},
+	{90000, `COMMENT ON CONSTRAINT pgdesc_t_id_check ON public.pgdesc_t IS 'positive ids'`},
+	{0, `CREATE INDEX pgdesc_i ON public.pgdesc_t (name)`},
+	{0, `CREATE VIEW public.pgdesc_v AS SELECT id FROM public.pgdesc_t`},
+	{0, `CREATE SEQUENCE public.pgdesc_seq`},
+	{90300, `CREATE MATERIALIZED VIEW public.pgdesc_mv AS SELECT id FROM public.pgdesc_t`},
+	{100000, `CREATE TABLE public.pgdesc_pt (id integer) PARTITION BY RANGE (id)`},
+	{100000, `CREATE TABLE public.pgdesc_pt_1 PARTITION OF public.pgdesc_pt FOR VALUES FROM (0) TO (100)`},
+	{80100, `CREATE ROLE pgdesc_role`},
+	{0, `GRANT SELECT ON public.pgdesc_t TO pgdesc_role`},
+	{90000, `ALTER ROLE pgdesc_role SET search_path = public`},
+	{90000, `ALTER DEFAULT PRIVILEGES IN SCHEMA pgdesc_s GRANT SELECT ON TABLES TO pgdesc_role`},
+	{160000, `GRANT pgdesc_role TO postgres`},
+	{0, `CREATE FUNCTION public.pgdesc_f(integer) RETURNS integer AS 'SELECT $1' LANGUAGE sql`},
+	{80200, `CREATE AGGREGATE public.pgdesc_agg(integer) (SFUNC = int4pl, STYPE = integer)`},
+	{0, `CREATE OPERATOR public.### (LEFTARG = integer, RIGHTARG = integer, PROCEDURE = int4pl)`},
+	{0, `CREATE DOMAIN public.pgdesc_d AS integer CHECK (VALUE > 0)`},
+	{0, `CREATE TYPE public.pgdesc_ty AS (a integer)`},
+	{80400, `CREATE CAST (public.pgdesc_ty AS text) WITH INOUT`},
+	{0, `CREATE CONVERSION public.pgdesc_conv FOR 'LATIN1' TO 'UTF8' FROM iso8859_1_to_utf8`},
+	{90100, `CREATE COLLATION public.pgdesc_coll (LOCALE = 'C')`},
+	{80300, `CREATE TEXT SEARCH CONFIGURATION public.pgdesc_tsc (COPY = pg_catalog.english)`},
+	{80300, `CREATE TEXT SEARCH DICTIONARY public.pgdesc_tsd (TEMPLATE = pg_catalog.simple)`},
+	{80400, `CREATE FOREIGN DATA WRAPPER pgdesc_fdw`},
+	{80400, `CREATE SERVER pgdesc_srv FOREIGN DATA WRAPPER pgdesc_fdw`},
+	{80400, `CREATE USER MAPPING FOR pgdesc_role SERVER pgdesc_srv`},
+	{90100, `CREATE FOREIGN TABLE public.pgdesc_ft (id integer) SERVER pgdesc_srv`},
+	{100000, `CREATE STATISTICS public.pgdesc_stat ON id, name FROM public.pgdesc_t`},
+	{100000, `CREATE PUBLICATION pgdesc_pub FOR TABLE public.pgdesc_t`},
+	{150000, `CREATE PUBLICATION pgdesc_pub_filter FOR TABLE public.pgdesc_t (id, name) WHERE (id > 0)`},
+	{150000, `CREATE PUBLICATION pgdesc_pub_schema FOR TABLES IN SCHEMA pgdesc_s`},
+	{100000, `CREATE SUBSCRIPTION pgdesc_sub CONNECTION 'dbname=pgdesc' PUBLICATION pgdesc_pub WITH (connect = false, slot_name = NONE, enabled = false, create_slot = false)`},
+	{90300, `CREATE FUNCTION public.pgdesc_et() RETURNS event_trigger AS 'BEGIN END' LANGUAGE plpgsql`},
+	{90300, `CREATE EVENT TRIGGER pgdesc_evt ON ddl_command_start EXECUTE PROCEDURE public.pgdesc_et()`},
+}
+
+var (
+	clustersOnce sync.Once
+	clusters     []*testCluster
+)
+
+// TestMain stops the test clusters, if started, after running the tests.
+func TestMain(m *testing.M) {
+	code := m.Run()
+	for _, c := range clusters {
+		c.stop()
+	}
+	os.Exit(code)
+}
+
+// forEachCluster runs f as a subtest for each test cluster, one per installed
+// postgres version, starting the clusters on first use. The test is skipped
+// when no postgres binaries are found, or when they cannot be run.
+//
+// The binaries are looked for in the directories listed in
+// $PGDESC_TEST_BINDIR, or else in $PATH and the usual install locations.
+func forEachCluster(t *testing.T, f func(*testing.T, *testCluster)) {
+	t.Helper()
+	switch {
+	case testing.Short():
+		t.Skip("skipping postgres cluster tests in short mode")
+	case os.Geteuid() == 0:
+		t.Skip("skipping postgres cluster tests: postgres cannot be run as root")
+	}
+	clustersOnce.Do(func() {
+		for _, bindir := range pgBinDirs() {
+			clusters = append(clusters, startCluster(bindir))
+		}
+	})
+	if len(clusters) == 0 {
+		t.Skip("skipping postgres cluster tests: no postgres binaries found (set PGDESC_TEST_BINDIR)")
+	}
+	for _, c := range clusters {
+		t.Run(c.name, func(t *testing.T) {
+			if c.err != nil {
+				t.Fatalf("expected no error starting cluster in %s, got: %v", c.bindir, c.err)
+			}
+			f(t, c)
+		})
+	}
+}
+
+// pgBinDirs returns the directories containing initdb and pg_ctl, one per
+// major version, ordered by version.
+func pgBinDirs() []string {
+	var dirs []string
+	if s := os.Getenv("PGDESC_TEST_BINDIR"); s != "" {
+		dirs = filepath.SplitList(s)
+	} else {
+		if name, err := exec.LookPath("pg_ctl"); err == nil {
+			dirs = append(dirs, filepath.Dir(name))
+		}
+		for _, pattern := range []string{
+			"/usr/lib/postgresql/*/bin",
+			"/usr/pgsql-*/bin",
+			"/usr/local/pgsql/bin",
+			"/opt/homebrew/opt/postgresql@*/bin",
+			"/usr/local/opt/postgresql@*/bin",
+		} {
+			m, _ := filepath.Glob(pattern)
+			dirs = append(dirs, m...)
+		}
+	}
+	var bindirs []string
+	versions, seen := make(map[string]int), make(map[int]bool)
+	for _, dir := range dirs {
+		version, _ := pgVersion(dir)
+		if version == 0 || seen[version] {
+			continue
+		}
+		versions[dir], seen[version] = version, true
+		bindirs = append(bindirs, dir)
+	}
+	sort.Slice(bindirs, func(i, j int) bool {
+		return versions[bindirs[i]] < versions[bindirs[j]]
+	})
+	return bindirs
+}
+
+// pgVersionRE matches the version in the output of pg_ctl --version.
+var pgVersionRE = regexp.MustCompile(`\(PostgreSQL\) (\d+)(?:\.(\d+))?`)
+
+// pgVersion returns the major version of the binaries in bindir as a version
+// number (ie, 90600, 160000) and name (ie, 9.6, 16), or 0 when pg_ctl cannot
+// be run.
+func pgVersion(bindir string) (int, string) {
+	buf, err := exec.Command(filepath.Join(bindir, "pg_ctl"), "--version").Output()
+	if err != nil {
+		return 0, ""
+	}
+	m := pgVersionRE.FindSubmatch(buf)
+	if m == nil {
+		return 0, ""
+	}
+	major, _ := strconv.Atoi(string(m[1]))
+	if major >= 10 {
+		return major * 10000, string(m[1])
+	}
+	minor, _ := strconv.Atoi(string(m[2]))
+	return major*10000 + minor*100, string(m[1]) + "." + string(m[2])
+}
+
+// startCluster initializes and starts a cluster using the binaries in bindir,
+// and loads the fixture schema. Errors are recorded on the cluster.
+func startCluster(bindir string) *testCluster {
+	_, name := pgVersion(bindir)
+	c := &testCluster{bindir: bindir, name: "pg" + name}
+	if c.err = c.start(); c.err != nil {
+		c.stop()
+	}
+	return c
+}
+
+// start initializes and starts the cluster, and loads the fixture schema.
+func (c *testCluster) start() error {
+	// the socket path is limited in length, so a short temp dir is used
+	var err error
+	if c.dir, err = os.MkdirTemp("", "pgdesc"); err != nil {
+		return err
+	}
+	data := filepath.Join(c.dir, "data")
+	if err := c.run("initdb", "-D", data, "-U", "postgres", "-A", "trust", "-E", "UTF8", "--locale=C"); err != nil {
+		return err
+	}
+	opts := fmt.Sprintf("-c listen_addresses='' -k %s -c fsync=off", c.dir)
+	if err := c.run("pg_ctl", "-D", data, "-l", filepath.Join(c.dir, "log"), "-o", opts, "-w", "start"); err != nil {
+		return err
+	}
+	if c.db, err = sql.Open("postgres", fmt.Sprintf("host=%s user=postgres dbname=postgres sslmode=disable", c.dir)); err != nil {
+		return err
+	}
+	d, err := NewPgDescFromServer(c.db)
+	if err != nil {
+		return err
+	}
+	c.version = d.version
+	for _, f := range clusterFixture {
+		if c.version < f.version {
+			continue
+		}
+		if _, err := c.db.Exec(f.sql); err != nil {
+			return fmt.Errorf("%s: %v", f.sql, err)
+		}
+	}
+	return nil
+}
+
+// run runs the named postgres binary.
+func (c *testCluster) run(name string, args ...string) error {
+	buf, err := exec.Command(filepath.Join(c.bindir, name), args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s: %v\n%s", name, err, buf)
+	}
+	return nil
+}
+
+// stop stops the cluster and removes its directory.
+func (c *testCluster) stop() {
+	if c.db != nil {
+		c.db.Close()
+		c.db = nil
+	}
+	if c.dir == "" {
+		return
+	}
+	data := filepath.Join(c.dir, "data")
+	if _, err := os.Stat(filepath.Join(data, "postmaster.pid")); err == nil {
+		_ = c.run("pg_ctl", "-D", data, "-m", "immediate", "-w", "stop")
+	}
+	os.RemoveAll(c.dir)
+	c.dir = ""
+}
+
+// desc returns a description for the cluster.
+func (c *testCluster) desc() *PgDesc {
+	return NewPgDesc(c.db, c.version, WithDatabase("postgres"))
+}
+
+// TestClusterCommands checks that the queries built by each generated func
+// execute, with each combination of parameters used by TestGolden.
+func TestClusterCommands(t *testing.T) {
+	funcs, err := generatedFuncs("pgdesc.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	forEachCluster(t, func(t *testing.T, c *testCluster) {
+		d := c.desc()
+		for _, f := range funcs {
+			t.Run(f.name, func(t *testing.T) {
+				for _, combo := range goldenCombos(f) {
+					m := reflect.ValueOf(d).MethodByName(f.name)
+					_, err := d.query(func(w io.Writer) error {
+						args := []reflect.Value{reflect.ValueOf(w)}
+						for _, v := range combo {
+							args = append(args, reflect.ValueOf(v))
+						}
+						err, _ := m.Call(args)[0].Interface().(error)
+						return err
+					})
+					if err != nil && !unsupported(err) {
+						t.Errorf("%v: expected no error, got: %v", combo, err)
+					}
+				}
+			})
+		}
+	})
+}
+
+// TestClusterObjects checks that each command finds the fixture objects.
+func TestClusterObjects(t *testing.T) {
+	tests := []struct {
+		name    string
+		version int
+		f       func(*PgDesc) (*Result, error)
+		exp     string
+	}{
+		{"AccessMethods", 90600, func(d *PgDesc) (*Result, error) { return d.QueryAccessMethods("btree", false) }, "btree"},
+		{"Aggregates", 80200, func(d *PgDesc) (*Result, error) { return d.QueryAggregates("pgdesc_agg", false, false) }, "pgdesc_agg"},
+		{"Casts", 80400, func(d *PgDesc) (*Result, error) { return d.QueryCasts("pgdesc_ty", false) }, "pgdesc_ty"},
+		{"Collations", 90100, func(d *PgDesc) (*Result, error) { return d.QueryCollations("pgdesc_coll", false, false) }, "pgdesc_coll"},
+		{"ConfigurationParameters", 150000, func(d *PgDesc) (*Result, error) { return d.QueryConfigurationParameters("work_mem", false, false) }, "work_mem"},
+		{"Conversions", 0, func(d *PgDesc) (*Result, error) { return d.QueryConversions("pgdesc_conv", false, false) }, "pgdesc_conv"},
+		{"DatabaseRoleSettings", 90000, func(d *PgDesc) (*Result, error) { return d.QueryDatabaseRoleSettings("pgdesc_role", "") }, "pgdesc_role"},
+		{"Databases", 0, func(d *PgDesc) (*Result, error) { return d.QueryDatabases("postgres", false) }, "postgres"},
+		{"DefaultACLS", 90000, func(d *PgDesc) (*Result, error) { return d.QueryDefaultACLS("pgdesc_s") }, "pgdesc_s"},
+		{"Domains", 0, func(d *PgDesc) (*Result, error) { return d.QueryDomains("pgdesc_d", false, false) }, "pgdesc_d"},
+		{"EventTriggers", 90300, func(d *PgDesc) (*Result, error) { return d.QueryEventTriggers("pgdesc_evt", false) }, "pgdesc_evt"},
+		{"ExtendedStats", 100000, func(d *PgDesc) (*Result, error) { return d.QueryExtendedStats("pgdesc_stat") }, "pgdesc_stat"},
+		{"ExtensionContents", 90100, func(d *PgDesc) (*Result, error) { return d.QueryExtensionContents("plpgsql") }, "plpgsql"},
+		{"Extensions", 90100, func(d *PgDesc) (*Result, error) { return d.QueryExtensions("plpgsql") }, "plpgsql"},
+		{"ForeignDataWrappers", 80400, func(d *PgDesc) (*Result, error) { return d.QueryForeignDataWrappers("pgdesc_fdw", false) }, "pgdesc_fdw"},
+		{"ForeignServers", 80400, func(d *PgDesc) (*Result, error) { return d.QueryForeignServers("pgdesc_srv", false) }, "pgdesc_srv"},
+		{"ForeignTables", 90100, func(d *PgDesc) (*Result, error) { return d.QueryForeignTables("pgdesc_ft", false) }, "pgdesc_ft"},
+		{"Functions", 0, func(d *PgDesc) (*Result, error) { return d.QueryFunctions("", "pgdesc_f", false, false) }, "pgdesc_f"},
+		{"Languages", 90000, func(d *PgDesc) (*Result, error) { return d.QueryLanguages("plpgsql", false, false) }, "plpgsql"},
+		{"ObjectDescription", 90000, func(d *PgDesc) (*Result, error) { return d.QueryObjectDescription("pgdesc_t_id_check", false) }, "positive ids"},
+		{"Operators", 0, func(d *PgDesc) (*Result, error) { return d.QueryOperators("public.###", false, false) }, "###"},
+		{"PartitionedTables", 120000, func(d *PgDesc) (*Result, error) { return d.QueryPartitionedTables("", "pgdesc_pt", false) }, "pgdesc_pt"},
+		{"Permissions", 0, func(d *PgDesc) (*Result, error) { return d.QueryPermissions("pgdesc_t") }, "pgdesc_t"},
+		{"PublicationDetails", 100000, func(d *PgDesc) (*Result, error) { return d.QueryPublicationDetails("pgdesc_pub") }, "pgdesc_pub"},
+		{"Publications", 100000, func(d *PgDesc) (*Result, error) { return d.QueryPublications("pgdesc_pub") }, "pgdesc_pub"},
+		{"RoleGrants", 160000, func(d *PgDesc) (*Result, error) { return d.QueryRoleGrants("pgdesc_role", false) }, "pgdesc_role"},
+		{"Roles", 80100, func(d *PgDesc) (*Result, error) { return d.QueryRoles("pgdesc_role", false, false) }, "pgdesc_role"},
+		{"Schemas", 0, func(d *PgDesc) (*Result, error) { return d.QuerySchemas("pgdesc_s", false, false) }, "pgdesc_s"},
+		{"Subscriptions", 100000, func(d *PgDesc) (*Result, error) { return d.QuerySubscriptions("pgdesc_sub", false) }, "pgdesc_sub"},
+		{"TableDetails", 0, func(d *PgDesc) (*Result, error) { return d.QueryTableDetails("pgdesc_t", false, false) }, "pgdesc_t"},
+		{"Tables", 0, func(d *PgDesc) (*Result, error) { return d.QueryTables("", "pgdesc_*", false, false) }, "pgdesc_v"},
+		{"Tablespaces", 80000, func(d *PgDesc) (*Result, error) { return d.QueryTablespaces("pg_default", false) }, "pg_default"},
+		{"TextSearchConfigs", 80300, func(d *PgDesc) (*Result, error) { return d.QueryTextSearchConfigs("pgdesc_tsc", false) }, "pgdesc_tsc"},
+		{"TextSearchConfigsVerbose", 80300, func(d *PgDesc) (*Result, error) { return d.QueryTextSearchConfigsVerbose("pgdesc_tsc") }, "pgdesc_tsc"},
+		{"TextSearchDictionaries", 80300, func(d *PgDesc) (*Result, error) { return d.QueryTextSearchDictionaries("pgdesc_tsd", false) }, "pgdesc_tsd"},
+		{"TextSearchParsers", 80300, func(d *PgDesc) (*Result, error) { return d.QueryTextSearchParsers("default", false) }, "default"},
+		{"TextSearchParsersVerbose", 80300, func(d *PgDesc) (*Result, error) { return d.QueryTextSearchParsersVerbose("default") }, "default"},
+		{"TextSearchTemplates", 80300, func(d *PgDesc) (*Result, error) { return d.QueryTextSearchTemplates("simple", false) }, "simple"},
+		{"Types", 0, func(d *PgDesc) (*Result, error) { return d.QueryTypes("pgdesc_ty", false, false) }, "pgdesc_ty"},
+		{"UserMappings", 80400, func(d *PgDesc) (*Result, error) { return d.QueryUserMappings("pgdesc_srv", false) }, "pgdesc_srv"},
+	}
+	forEachCluster(t, func(t *testing.T, c *testCluster) {
+		d := c.desc()
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				if c.version < test.version {
+					t.Skipf("requires server version %d", test.version)
+				}
+				res, err := test.f(d)
+				if err != nil {
+					t.Fatalf("expected no error, got: %v", err)
+				}
+				if !hasValue(res, test.exp) {
+					t.Errorf("expected a value %q, got: %v", test.exp, res.Rows)
+				}
+			})
+		}
+	})
+}
+
+// TestClusterTableDetails checks that the table descriptions for the fixture
+// relations are built.
+func TestClusterTableDetails(t *testing.T) {
+	forEachCluster(t, func(t *testing.T, c *testCluster) {
+		d := c.desc()
+		for _, verbose := range []bool{false, true} {
+			tables, err := d.GetTableDetails("pgdesc_*", verbose, false)
+			if err != nil {
+				t.Fatalf("verbose %t: expected no error, got: %v", verbose, err)
+			}
+			if len(tables) == 0 {
+				t.Errorf("verbose %t: expected table descriptions, got none", verbose)
+			}
+		}
+	})
+}
+
+// unsupported returns whether or not err is returned by a command because it
+// is not supported by the server version.
+func unsupported(err error) bool {
+	s := err.Error()
+	return strings.Contains(s, "does not support") || strings.Contains(s, "with server version")
+}
+
+// hasValue returns whether or not any value in res is s.
+func hasValue(res *Result, s string) bool {
+	for i := range res.Rows {
+		for j := range res.Columns {
+			if res.Value(i, j) == s {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// TestClusterVerbose checks the multi-query verbose commands print each
+// object's tables.
+func TestClusterVerbose(t *testing.T) {
+	tests := []struct {
+		name    string
+		version int
+		f       func(*PgDesc, io.Writer) error
+		exp     []string
+	}{
+		{"TextSearchConfigsVerbose", 80300, func(d *PgDesc, w io.Writer) error {
+			return d.PrintTextSearchConfigsVerbose(w, "pgdesc_tsc")
+		}, []string{`Text search configuration "public.pgdesc_tsc"`, `Parser: "pg_catalog.default"`}},
+		{"TextSearchParsersVerbose", 80300, func(d *PgDesc, w io.Writer) error {
+			return d.PrintTextSearchParsersVerbose(w, "default")
+		}, []string{`Text search parser "pg_catalog.default"`, `Token types for parser "pg_catalog.default"`}},
+		{"ExtensionContents", 90100, func(d *PgDesc, w io.Writer) error {
+			return d.PrintExtensionContents(w, "plpgsql")
+		}, []string{`Objects in extension "plpgsql"`, "language plpgsql"}},
+		{"PublicationDetails", 100000, func(d *PgDesc, w io.Writer) error {
+			return d.PrintPublicationDetails(w, "pgdesc_pub")
+		}, []string{"Publication pgdesc_pub", "Tables:", `    "public.pgdesc_t"`}},
+		{"PublicationDetailsFilter", 150000, func(d *PgDesc, w io.Writer) error {
+			return d.PrintPublicationDetails(w, "pgdesc_pub_filter")
+		}, []string{`    "public.pgdesc_t" (id, name) WHERE (id > 0)`}},
+		{"PublicationDetailsSchema", 150000, func(d *PgDesc, w io.Writer) error {
+			return d.PrintPublicationDetails(w, "pgdesc_pub_schema")
+		}, []string{"Tables from schemas:", `    "pgdesc_s"`}},
+	}
+	forEachCluster(t, func(t *testing.T, c *testCluster) {
+		d := c.desc()
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				if c.version < test.version {
+					t.Skipf("requires server version %d", test.version)
+				}
+				buf := new(strings.Builder)
+				if err := test.f(d, buf); err != nil {
+					t.Fatalf("expected no error, got: %v", err)
+				}
+				for _, s := range test.exp {
+					if !strings.Contains(buf.String(), s) {
+						t.Errorf("expected output to contain %q, got:\n%s", s, buf.String())
+					}
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,689 @@
+package pgdesc
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// PrintAccessMethods executes and prints \dA.
+func (d *PgDesc) PrintAccessMethods(w io.Writer, pattern string, verbose bool) error {
+	q, err := d.PlanAccessMethods(pattern, verbose)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintAccessMethodsContext is the same as PrintAccessMethods, but with a context.
+func (d *PgDesc) PrintAccessMethodsContext(ctx context.Context, w io.Writer, pattern string, verbose bool) error {
+	return d.withContext(ctx).PrintAccessMethods(w, pattern, verbose)
+}
+
+// PrintAggregates executes and prints \da.
+func (d *PgDesc) PrintAggregates(w io.Writer, pattern string, verbose, showSystem bool) error {
+	q, err := d.PlanAggregates(pattern, verbose, showSystem)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintAggregatesContext is the same as PrintAggregates, but with a context.
+func (d *PgDesc) PrintAggregatesContext(ctx context.Context, w io.Writer, pattern string, verbose, showSystem bool) error {
+	return d.withContext(ctx).PrintAggregates(w, pattern, verbose, showSystem)
+}
+
+// PrintCasts executes and prints \dC.
+func (d *PgDesc) PrintCasts(w io.Writer, pattern string, verbose bool) error {
+	q, err := d.PlanCasts(pattern, verbose)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintCastsContext is the same as PrintCasts, but with a context.
+func (d *PgDesc) PrintCastsContext(ctx context.Context, w io.Writer, pattern string, verbose bool) error {
+	return d.withContext(ctx).PrintCasts(w, pattern, verbose)
+}
+
+// PrintCollations executes and prints \dO.
+func (d *PgDesc) PrintCollations(w io.Writer, pattern string, verbose, showSystem bool) error {
+	q, err := d.PlanCollations(pattern, verbose, showSystem)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintCollationsContext is the same as PrintCollations, but with a context.
+func (d *PgDesc) PrintCollationsContext(ctx context.Context, w io.Writer, pattern string, verbose, showSystem bool) error {
+	return d.withContext(ctx).PrintCollations(w, pattern, verbose, showSystem)
+}
+
+// PrintConfigurationParameters executes and prints \dconfig.
+func (d *PgDesc) PrintConfigurationParameters(w io.Writer, pattern string, verbose, showSystem bool) error {
+	q, err := d.PlanConfigurationParameters(pattern, verbose, showSystem)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintConfigurationParametersContext is the same as PrintConfigurationParameters, but with a context.
+func (d *PgDesc) PrintConfigurationParametersContext(ctx context.Context, w io.Writer, pattern string, verbose, showSystem bool) error {
+	return d.withContext(ctx).PrintConfigurationParameters(w, pattern, verbose, showSystem)
+}
+
+// PrintConversions executes and prints \dc.
+func (d *PgDesc) PrintConversions(w io.Writer, pattern string, verbose, showSystem bool) error {
+	q, err := d.PlanConversions(pattern, verbose, showSystem)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintConversionsContext is the same as PrintConversions, but with a context.
+func (d *PgDesc) PrintConversionsContext(ctx context.Context, w io.Writer, pattern string, verbose, showSystem bool) error {
+	return d.withContext(ctx).PrintConversions(w, pattern, verbose, showSystem)
+}
+
+// PrintDatabaseRoleSettings executes and prints \drds.
+func (d *PgDesc) PrintDatabaseRoleSettings(w io.Writer, pattern, pattern2 string) error {
+	q, err := d.PlanDatabaseRoleSettings(pattern, pattern2)
+	if err != nil {
+		return err
+	}
+	res, err := d.Exec(q)
+	if err != nil {
+		return err
+	}
+
+	// Most functions in this file are content to print an empty table when
+	// there are no matching objects.  We intentionally deviate from that
+	// here, because of the possibility that the user is confused about what
+	// the two pattern arguments mean.
+	if res.Len() == 0 {
+		switch {
+		case pattern != NULL && pattern2 != NULL:
+			d.logError("Did not find any settings for role \"%s\" and database \"%s\".\n",
+				pattern, pattern2)
+		case pattern != NULL:
+			d.logError("Did not find any settings for role \"%s\".\n",
+				pattern)
+		default:
+			d.logError("Did not find any settings.\n")
+		}
+		return nil
+	}
+
+	return d.print(w, q.Table(res))
+}
+
+// PrintDatabaseRoleSettingsContext is the same as PrintDatabaseRoleSettings, but with a context.
+func (d *PgDesc) PrintDatabaseRoleSettingsContext(ctx context.Context, w io.Writer, pattern, pattern2 string) error {
+	return d.withContext(ctx).PrintDatabaseRoleSettings(w, pattern, pattern2)
+}
+
+// PrintDatabases executes and prints \l.
+func (d *PgDesc) PrintDatabases(w io.Writer, pattern string, verbose bool) error {
+	q, err := d.PlanDatabases(pattern, verbose)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintDatabasesContext is the same as PrintDatabases, but with a context.
+func (d *PgDesc) PrintDatabasesContext(ctx context.Context, w io.Writer, pattern string, verbose bool) error {
+	return d.withContext(ctx).PrintDatabases(w, pattern, verbose)
+}
+
+// PrintDefaultACLS executes and prints \ddp.
+func (d *PgDesc) PrintDefaultACLS(w io.Writer, pattern string) error {
+	q, err := d.PlanDefaultACLS(pattern)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintDefaultACLSContext is the same as PrintDefaultACLS, but with a context.
+func (d *PgDesc) PrintDefaultACLSContext(ctx context.Context, w io.Writer, pattern string) error {
+	return d.withContext(ctx).PrintDefaultACLS(w, pattern)
+}
+
+// PrintDomains executes and prints \dD.
+func (d *PgDesc) PrintDomains(w io.Writer, pattern string, verbose, showSystem bool) error {
+	q, err := d.PlanDomains(pattern, verbose, showSystem)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintDomainsContext is the same as PrintDomains, but with a context.
+func (d *PgDesc) PrintDomainsContext(ctx context.Context, w io.Writer, pattern string, verbose, showSystem bool) error {
+	return d.withContext(ctx).PrintDomains(w, pattern, verbose, showSystem)
+}
+
+// PrintEventTriggers executes and prints \dy.
+func (d *PgDesc) PrintEventTriggers(w io.Writer, pattern string, verbose bool) error {
+	q, err := d.PlanEventTriggers(pattern, verbose)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintEventTriggersContext is the same as PrintEventTriggers, but with a context.
+func (d *PgDesc) PrintEventTriggersContext(ctx context.Context, w io.Writer, pattern string, verbose bool) error {
+	return d.withContext(ctx).PrintEventTriggers(w, pattern, verbose)
+}
+
+// PrintExtendedStats executes and prints \dX.
+func (d *PgDesc) PrintExtendedStats(w io.Writer, pattern string) error {
+	q, err := d.PlanExtendedStats(pattern)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintExtendedStatsContext is the same as PrintExtendedStats, but with a context.
+func (d *PgDesc) PrintExtendedStatsContext(ctx context.Context, w io.Writer, pattern string) error {
+	return d.withContext(ctx).PrintExtendedStats(w, pattern)
+}
+
+// PrintExtensionContents executes and prints \dx+, listing the objects in
+// each matching extension.
+func (d *PgDesc) PrintExtensionContents(w io.Writer, pattern string) error {
+	return d.psql(w, func(w io.Writer) error {
+		return d.ExtensionContents(w, pattern)
+	})
+}
+
+// PrintExtensionContentsContext is the same as PrintExtensionContents, but with a context.
+func (d *PgDesc) PrintExtensionContentsContext(ctx context.Context, w io.Writer, pattern string) error {
+	return d.withContext(ctx).PrintExtensionContents(w, pattern)
+}
+
+// PrintExtensions executes and prints \dx.
+func (d *PgDesc) PrintExtensions(w io.Writer, pattern string) error {
+	q, err := d.PlanExtensions(pattern)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintExtensionsContext is the same as PrintExtensions, but with a context.
+func (d *PgDesc) PrintExtensionsContext(ctx context.Context, w io.Writer, pattern string) error {
+	return d.withContext(ctx).PrintExtensions(w, pattern)
+}
+
+// PrintForeignDataWrappers executes and prints \dew.
+func (d *PgDesc) PrintForeignDataWrappers(w io.Writer, pattern string, verbose bool) error {
+	q, err := d.PlanForeignDataWrappers(pattern, verbose)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintForeignDataWrappersContext is the same as PrintForeignDataWrappers, but with a context.
+func (d *PgDesc) PrintForeignDataWrappersContext(ctx context.Context, w io.Writer, pattern string, verbose bool) error {
+	return d.withContext(ctx).PrintForeignDataWrappers(w, pattern, verbose)
+}
+
+// PrintForeignServers executes and prints \des.
+func (d *PgDesc) PrintForeignServers(w io.Writer, pattern string, verbose bool) error {
+	q, err := d.PlanForeignServers(pattern, verbose)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintForeignServersContext is the same as PrintForeignServers, but with a context.
+func (d *PgDesc) PrintForeignServersContext(ctx context.Context, w io.Writer, pattern string, verbose bool) error {
+	return d.withContext(ctx).PrintForeignServers(w, pattern, verbose)
+}
+
+// PrintForeignTables executes and prints \det.
+func (d *PgDesc) PrintForeignTables(w io.Writer, pattern string, verbose bool) error {
+	q, err := d.PlanForeignTables(pattern, verbose)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintForeignTablesContext is the same as PrintForeignTables, but with a context.
+func (d *PgDesc) PrintForeignTablesContext(ctx context.Context, w io.Writer, pattern string, verbose bool) error {
+	return d.withContext(ctx).PrintForeignTables(w, pattern, verbose)
+}
+
+// PrintFunctions executes and prints \df, \dfa, \dfn, \dft, \dfw, etc.
+func (d *PgDesc) PrintFunctions(w io.Writer, functypes, pattern string, verbose, showSystem bool) error {
+	q, err := d.PlanFunctions(functypes, pattern, verbose, showSystem)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintFunctionsContext is the same as PrintFunctions, but with a context.
+func (d *PgDesc) PrintFunctionsContext(ctx context.Context, w io.Writer, functypes, pattern string, verbose, showSystem bool) error {
+	return d.withContext(ctx).PrintFunctions(w, functypes, pattern, verbose, showSystem)
+}
+
+// PrintLanguages executes and prints \dL.
+func (d *PgDesc) PrintLanguages(w io.Writer, pattern string, verbose, showSystem bool) error {
+	q, err := d.PlanLanguages(pattern, verbose, showSystem)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintLanguagesContext is the same as PrintLanguages, but with a context.
+func (d *PgDesc) PrintLanguagesContext(ctx context.Context, w io.Writer, pattern string, verbose, showSystem bool) error {
+	return d.withContext(ctx).PrintLanguages(w, pattern, verbose, showSystem)
+}
+
+// PrintObjectDescription executes and prints \dd.
+func (d *PgDesc) PrintObjectDescription(w io.Writer, pattern string, showSystem bool) error {
+	q, err := d.PlanObjectDescription(pattern, showSystem)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintObjectDescriptionContext is the same as PrintObjectDescription, but with a context.
+func (d *PgDesc) PrintObjectDescriptionContext(ctx context.Context, w io.Writer, pattern string, showSystem bool) error {
+	return d.withContext(ctx).PrintObjectDescription(w, pattern, showSystem)
+}
+
+// PrintOperators executes and prints \do.
+func (d *PgDesc) PrintOperators(w io.Writer, pattern string, verbose, showSystem bool) error {
+	q, err := d.PlanOperators(pattern, verbose, showSystem)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintOperatorsContext is the same as PrintOperators, but with a context.
+func (d *PgDesc) PrintOperatorsContext(ctx context.Context, w io.Writer, pattern string, verbose, showSystem bool) error {
+	return d.withContext(ctx).PrintOperators(w, pattern, verbose, showSystem)
+}
+
+// PrintPartitionedTables executes and prints \dP, \dPt, \dPi, \dPn, etc.
+func (d *PgDesc) PrintPartitionedTables(w io.Writer, reltypes, pattern string, verbose bool) error {
+	q, err := d.PlanPartitionedTables(reltypes, pattern, verbose)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintPartitionedTablesContext is the same as PrintPartitionedTables, but with a context.
+func (d *PgDesc) PrintPartitionedTablesContext(ctx context.Context, w io.Writer, reltypes, pattern string, verbose bool) error {
+	return d.withContext(ctx).PrintPartitionedTables(w, reltypes, pattern, verbose)
+}
+
+// PrintPermissions executes and prints \z (or \dp).
+func (d *PgDesc) PrintPermissions(w io.Writer, pattern string) error {
+	q, err := d.PlanPermissions(pattern)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintPermissionsContext is the same as PrintPermissions, but with a context.
+func (d *PgDesc) PrintPermissionsContext(ctx context.Context, w io.Writer, pattern string) error {
+	return d.withContext(ctx).PrintPermissions(w, pattern)
+}
+
+// PrintPublicationDetails executes and prints \dRp+.
+func (d *PgDesc) PrintPublicationDetails(w io.Writer, pattern string) error {
+	v, err := d.GetPublicationDetails(pattern)
+	if err != nil {
+		return err
+	}
+	for _, p := range v {
+		if err := d.print(w, p.Table()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PrintPublicationDetailsContext is the same as PrintPublicationDetails, but with a context.
+func (d *PgDesc) PrintPublicationDetailsContext(ctx context.Context, w io.Writer, pattern string) error {
+	return d.withContext(ctx).PrintPublicationDetails(w, pattern)
+}
+
+// PrintPublications executes and prints \dRp.
+func (d *PgDesc) PrintPublications(w io.Writer, pattern string) error {
+	q, err := d.PlanPublications(pattern)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintPublicationsContext is the same as PrintPublications, but with a context.
+func (d *PgDesc) PrintPublicationsContext(ctx context.Context, w io.Writer, pattern string) error {
+	return d.withContext(ctx).PrintPublications(w, pattern)
+}
+
+// PrintRoleGrants executes and prints \drg.
+func (d *PgDesc) PrintRoleGrants(w io.Writer, pattern string, showSystem bool) error {
+	q, err := d.PlanRoleGrants(pattern, showSystem)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintRoleGrantsContext is the same as PrintRoleGrants, but with a context.
+func (d *PgDesc) PrintRoleGrantsContext(ctx context.Context, w io.Writer, pattern string, showSystem bool) error {
+	return d.withContext(ctx).PrintRoleGrants(w, pattern, showSystem)
+}
+
+// PrintRoles executes and prints \du, \dg.
+func (d *PgDesc) PrintRoles(w io.Writer, pattern string, verbose, showSystem bool) error {
+	q, err := d.PlanRoles(pattern, verbose, showSystem)
+	if err != nil {
+		return err
+	}
+	res, err := d.Exec(q)
+	if err != nil {
+		return err
+	}
+
+	t := &Table{
+		Title: q.Title,
+		Headers: []string{
+			Gettext("Role name"),
+			Gettext("Attributes"),
+			Gettext("Member of"),
+		},
+	}
+	descr := verbose && d.version >= 80200
+	if descr {
+		t.Headers = append(t.Headers, Gettext("Description"))
+	}
+
+	for i := 0; i < res.Len(); i++ {
+		var attrs []string
+		if res.named(i, "rolsuper") == "t" {
+			attrs = append(attrs, Gettext("Superuser"))
+		}
+		if res.named(i, "rolinherit") != "t" {
+			attrs = append(attrs, Gettext("No inheritance"))
+		}
+		if res.named(i, "rolcreaterole") == "t" {
+			attrs = append(attrs, Gettext("Create role"))
+		}
+		if res.named(i, "rolcreatedb") == "t" {
+			attrs = append(attrs, Gettext("Create DB"))
+		}
+		if res.named(i, "rolcanlogin") != "t" {
+			attrs = append(attrs, Gettext("Cannot login"))
+		}
+		// rolreplication and rolbypassrls are only selected for servers 9.1
+		// and 9.5 and later, respectively
+		if res.named(i, "rolreplication") == "t" {
+			attrs = append(attrs, Gettext("Replication"))
+		}
+		if res.named(i, "rolbypassrls") == "t" {
+			attrs = append(attrs, Gettext("Bypass RLS"))
+		}
+		attr := strings.Join(attrs, ", ")
+
+		if conns, _ := strconv.Atoi(res.named(i, "rolconnlimit")); conns >= 0 {
+			if attr != "" {
+				attr += "\n"
+			}
+			switch conns {
+			case 0:
+				attr += Gettext("No connections")
+			case 1:
+				attr += Gettext("%d connection", conns)
+			default:
+				attr += Gettext("%d connections", conns)
+			}
+		}
+
+		if validUntil := res.named(i, "rolvaliduntil"); validUntil != "" {
+			if attr != "" {
+				attr += "\n"
+			}
+			attr += Gettext("Password valid until ") + validUntil
+		}
+
+		row := []string{res.named(i, "rolname"), attr, res.named(i, "memberof")}
+		if descr {
+			row = append(row, res.named(i, "description"))
+		}
+		t.Rows = append(t.Rows, row)
+	}
+
+	return d.print(w, t)
+}
+
+// PrintRolesContext is the same as PrintRoles, but with a context.
+func (d *PgDesc) PrintRolesContext(ctx context.Context, w io.Writer, pattern string, verbose, showSystem bool) error {
+	return d.withContext(ctx).PrintRoles(w, pattern, verbose, showSystem)
+}
+
+// PrintSchemas executes and prints \dn.
+func (d *PgDesc) PrintSchemas(w io.Writer, pattern string, verbose, showSystem bool) error {
+	q, err := d.PlanSchemas(pattern, verbose, showSystem)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintSchemasContext is the same as PrintSchemas, but with a context.
+func (d *PgDesc) PrintSchemasContext(ctx context.Context, w io.Writer, pattern string, verbose, showSystem bool) error {
+	return d.withContext(ctx).PrintSchemas(w, pattern, verbose, showSystem)
+}
+
+// PrintSubscriptions executes and prints \dRs.
+func (d *PgDesc) PrintSubscriptions(w io.Writer, pattern string, verbose bool) error {
+	q, err := d.PlanSubscriptions(pattern, verbose)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintSubscriptionsContext is the same as PrintSubscriptions, but with a context.
+func (d *PgDesc) PrintSubscriptionsContext(ctx context.Context, w io.Writer, pattern string, verbose bool) error {
+	return d.withContext(ctx).PrintSubscriptions(w, pattern, verbose)
+}
+
+// PrintTableDetails executes and prints \d foo.
+func (d *PgDesc) PrintTableDetails(w io.Writer, pattern string, verbose, showSystem bool) error {
+	v, err := d.GetTableDetails(pattern, verbose, showSystem)
+	if err != nil {
+		return err
+	}
+	for _, t := range v {
+		if err := d.print(w, t.Table()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PrintTableDetailsContext is the same as PrintTableDetails, but with a context.
+func (d *PgDesc) PrintTableDetailsContext(ctx context.Context, w io.Writer, pattern string, verbose, showSystem bool) error {
+	return d.withContext(ctx).PrintTableDetails(w, pattern, verbose, showSystem)
+}
+
+// PrintTables executes and prints \dt, \di, \ds, \dS, etc.
+func (d *PgDesc) PrintTables(w io.Writer, tabtypes, pattern string, verbose, showSystem bool) error {
+	q, err := d.PlanTables(tabtypes, pattern, verbose, showSystem)
+	if err != nil {
+		return err
+	}
+	res, err := d.Exec(q)
+	if err != nil {
+		return err
+	}
+
+	// Most functions in this file are content to print an empty table when
+	// there are no matching objects.  We intentionally deviate from that
+	// here, for historical reasons.
+	if res.Len() == 0 {
+		if pattern != NULL {
+			d.logError("Did not find any relation named \"%s\".\n",
+				pattern)
+		} else {
+			d.logError("Did not find any relations.\n")
+		}
+		return nil
+	}
+
+	return d.print(w, q.Table(res))
+}
+
+// PrintTablesContext is the same as PrintTables, but with a context.
+func (d *PgDesc) PrintTablesContext(ctx context.Context, w io.Writer, tabtypes, pattern string, verbose, showSystem bool) error {
+	return d.withContext(ctx).PrintTables(w, tabtypes, pattern, verbose, showSystem)
+}
+
+// PrintTablespaces executes and prints \db.
+func (d *PgDesc) PrintTablespaces(w io.Writer, pattern string, verbose bool) error {
+	q, err := d.PlanTablespaces(pattern, verbose)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintTablespacesContext is the same as PrintTablespaces, but with a context.
+func (d *PgDesc) PrintTablespacesContext(ctx context.Context, w io.Writer, pattern string, verbose bool) error {
+	return d.withContext(ctx).PrintTablespaces(w, pattern, verbose)
+}
+
+// PrintTextSearchConfigs executes and prints \dF.
+func (d *PgDesc) PrintTextSearchConfigs(w io.Writer, pattern string) error {
+	q, err := d.PlanTextSearchConfigs(pattern)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintTextSearchConfigsContext is the same as PrintTextSearchConfigs, but with a context.
+func (d *PgDesc) PrintTextSearchConfigsContext(ctx context.Context, w io.Writer, pattern string) error {
+	return d.withContext(ctx).PrintTextSearchConfigs(w, pattern)
+}
+
+// PrintTextSearchConfigsVerbose executes and prints \dF+, describing each
+// matching configuration.
+func (d *PgDesc) PrintTextSearchConfigsVerbose(w io.Writer, pattern string) error {
+	return d.psql(w, func(w io.Writer) error {
+		return d.TextSearchConfigs(w, pattern, true)
+	})
+}
+
+// PrintTextSearchConfigsVerboseContext is the same as PrintTextSearchConfigsVerbose, but with a context.
+func (d *PgDesc) PrintTextSearchConfigsVerboseContext(ctx context.Context, w io.Writer, pattern string) error {
+	return d.withContext(ctx).PrintTextSearchConfigsVerbose(w, pattern)
+}
+
+// PrintTextSearchDictionaries executes and prints \dFd.
+func (d *PgDesc) PrintTextSearchDictionaries(w io.Writer, pattern string, verbose bool) error {
+	q, err := d.PlanTextSearchDictionaries(pattern, verbose)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintTextSearchDictionariesContext is the same as PrintTextSearchDictionaries, but with a context.
+func (d *PgDesc) PrintTextSearchDictionariesContext(ctx context.Context, w io.Writer, pattern string, verbose bool) error {
+	return d.withContext(ctx).PrintTextSearchDictionaries(w, pattern, verbose)
+}
+
+// PrintTextSearchParsers executes and prints \dFp.
+func (d *PgDesc) PrintTextSearchParsers(w io.Writer, pattern string) error {
+	q, err := d.PlanTextSearchParsers(pattern)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintTextSearchParsersContext is the same as PrintTextSearchParsers, but with a context.
+func (d *PgDesc) PrintTextSearchParsersContext(ctx context.Context, w io.Writer, pattern string) error {
+	return d.withContext(ctx).PrintTextSearchParsers(w, pattern)
+}
+
+// PrintTextSearchParsersVerbose executes and prints \dFp+, describing each
+// matching parser and its token types.
+func (d *PgDesc) PrintTextSearchParsersVerbose(w io.Writer, pattern string) error {
+	return d.psql(w, func(w io.Writer) error {
+		return d.TextSearchParsers(w, pattern, true)
+	})
+}
+
+// PrintTextSearchParsersVerboseContext is the same as PrintTextSearchParsersVerbose, but with a context.
+func (d *PgDesc) PrintTextSearchParsersVerboseContext(ctx context.Context, w io.Writer, pattern string) error {
+	return d.withContext(ctx).PrintTextSearchParsersVerbose(w, pattern)
+}
+
+// PrintTextSearchTemplates executes and prints \dFt.
+func (d *PgDesc) PrintTextSearchTemplates(w io.Writer, pattern string, verbose bool) error {
+	q, err := d.PlanTextSearchTemplates(pattern, verbose)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintTextSearchTemplatesContext is the same as PrintTextSearchTemplates, but with a context.
+func (d *PgDesc) PrintTextSearchTemplatesContext(ctx context.Context, w io.Writer, pattern string, verbose bool) error {
+	return d.withContext(ctx).PrintTextSearchTemplates(w, pattern, verbose)
+}
+
+// PrintTypes executes and prints \dT.
+func (d *PgDesc) PrintTypes(w io.Writer, pattern string, verbose, showSystem bool) error {
+	q, err := d.PlanTypes(pattern, verbose, showSystem)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintTypesContext is the same as PrintTypes, but with a context.
+func (d *PgDesc) PrintTypesContext(ctx context.Context, w io.Writer, pattern string, verbose, showSystem bool) error {
+	return d.withContext(ctx).PrintTypes(w, pattern, verbose, showSystem)
+}
+
+// PrintUserMappings executes and prints \deu.
+func (d *PgDesc) PrintUserMappings(w io.Writer, pattern string, verbose bool) error {
+	q, err := d.PlanUserMappings(pattern, verbose)
+	if err != nil {
+		return err
+	}
+	return d.printQuery(w, q)
+}
+
+// PrintUserMappingsContext is the same as PrintUserMappings, but with a context.
+func (d *PgDesc) PrintUserMappingsContext(ctx context.Context, w io.Writer, pattern string, verbose bool) error {
+	return d.withContext(ctx).PrintUserMappings(w, pattern, verbose)
+}
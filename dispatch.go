@@ -0,0 +1,321 @@
+package pgdesc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// Command is a parsed backslash command.
+type Command struct {
+	// Name is the command name, without the leading backslash (ie, "dfS+").
+	Name string
+
+	// Args are the command arguments.
+	Args []string
+}
+
+// arg returns argument i, or NULL when not present.
+func (c *Command) arg(i int) string {
+	if i < len(c.Args) {
+		return c.Args[i]
+	}
+	return NULL
+}
+
+// ParseCommand parses a backslash command line (ie, `\dfS+ public.*`) into
+// its command name and arguments.
+//
+// Arguments are parsed similar to psql's OT_NORMAL options: arguments are
+// separated by whitespace, single quoted text is de-quoted (processing
+// backslash escapes), and double quoted text is passed through as-is
+// (including the quotes), so that patterns can match quoted identifiers.
+// Variable interpolation and backquoted commands are not supported.
+func ParseCommand(line string) (*Command, error) {
+	line = strings.TrimLeftFunc(line, unicode.IsSpace)
+	if !strings.HasPrefix(line, `\`) {
+		return nil, fmt.Errorf("invalid command %s\n", line)
+	}
+	line = line[1:]
+
+	// command name
+	i := strings.IndexFunc(line, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '\\' || r == '"' || r == '\''
+	})
+	if i == -1 {
+		i = len(line)
+	}
+	cmd := &Command{Name: line[:i]}
+	if cmd.Name == "" {
+		return nil, fmt.Errorf("invalid command \\\n")
+	}
+
+	// arguments
+	r := []rune(line[i:])
+	for j := 0; j < len(r); {
+		// skip whitespace
+		if unicode.IsSpace(r[j]) {
+			j++
+			continue
+		}
+		// stop at the next backslash command
+		if r[j] == '\\' {
+			break
+		}
+		var b strings.Builder
+		for ; j < len(r) && !unicode.IsSpace(r[j]) && r[j] != '\\'; j++ {
+			switch r[j] {
+			case '\'':
+				s, n, err := singleQuoted(r[j:])
+				if err != nil {
+					return nil, err
+				}
+				b.WriteString(s)
+				j += n - 1
+			case '"':
+				s, n, err := doubleQuoted(r[j:])
+				if err != nil {
+					return nil, err
+				}
+				b.WriteString(s)
+				j += n - 1
+			default:
+				b.WriteRune(r[j])
+			}
+		}
+		cmd.Args = append(cmd.Args, b.String())
+	}
+	return cmd, nil
+}
+
+// singleQuoted de-quotes the single quoted string at the start of r,
+// processing backslash escapes. Returns the string and the number of runes
+// consumed.
+func singleQuoted(r []rune) (string, int, error) {
+	var b strings.Builder
+	for i := 1; i < len(r); i++ {
+		switch {
+		case r[i] == '\'' && i+1 < len(r) && r[i+1] == '\'':
+			b.WriteRune('\'')
+			i++
+		case r[i] == '\'':
+			return b.String(), i + 1, nil
+		case r[i] == '\\' && i+1 < len(r):
+			i++
+			switch r[i] {
+			case 'n':
+				b.WriteRune('\n')
+			case 't':
+				b.WriteRune('\t')
+			case 'b':
+				b.WriteRune('\b')
+			case 'r':
+				b.WriteRune('\r')
+			case 'f':
+				b.WriteRune('\f')
+			default:
+				b.WriteRune(r[i])
+			}
+		default:
+			b.WriteRune(r[i])
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated quoted string\n")
+}
+
+// doubleQuoted returns the double quoted string at the start of r, including
+// the quotes. Returns the string and the number of runes consumed.
+func doubleQuoted(r []rune) (string, int, error) {
+	for i := 1; i < len(r); i++ {
+		if r[i] == '"' {
+			if i+1 < len(r) && r[i+1] == '"' {
+				i++
+				continue
+			}
+			return string(r[:i+1]), i + 1, nil
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated quoted string\n")
+}
+
+// Dispatch parses and executes a describe backslash command line (ie, `\dt+
+// pattern`, `\dfS+ public.*`, or `\drds role db`), printing the result to
+// w.
+//
+// The commands \d*, \l, and \z are supported, and are mapped to the Print
+// methods in the same manner as psql's exec_command_d. Extra arguments are
+// ignored. Unknown commands are rejected with psql's error message.
+func (d *PgDesc) Dispatch(w io.Writer, line string) error {
+	cmd, err := ParseCommand(line)
+	if err != nil {
+		return err
+	}
+	return d.Run(w, cmd)
+}
+
+// DispatchContext is the same as Dispatch, but with a context.
+func (d *PgDesc) DispatchContext(ctx context.Context, w io.Writer, line string) error {
+	return d.withContext(ctx).Dispatch(w, line)
+}
+
+// Run executes a parsed describe backslash command, printing the result to
+// w. See Dispatch.
+func (d *PgDesc) Run(w io.Writer, cmd *Command) error {
+	name, pattern := cmd.Name, cmd.arg(0)
+	verbose := strings.ContainsRune(name, '+')
+	showSystem := strings.ContainsRune(name, 'S')
+
+	switch {
+	case name == "l" || name == "l+" || name == "list" || name == "list+":
+		return d.PrintDatabases(w, pattern, verbose)
+	case name == "z":
+		return d.PrintPermissions(w, pattern)
+	case name == "" || name[0] != 'd':
+		return invalidCommand(name)
+	}
+
+	// next returns the command character at i, or 0
+	next := func(i int) byte {
+		if i < len(name) {
+			return name[i]
+		}
+		return 0
+	}
+
+	switch next(1) {
+	case 0, '+', 'S':
+		if pattern != NULL {
+			return d.PrintTableDetails(w, pattern, verbose, showSystem)
+		}
+		// standard listing of interesting things
+		return d.PrintTables(w, "tvmsE", NULL, verbose, showSystem)
+	case 'A':
+		return d.PrintAccessMethods(w, pattern, verbose)
+	case 'a':
+		return d.PrintAggregates(w, pattern, verbose, showSystem)
+	case 'b':
+		return d.PrintTablespaces(w, pattern, verbose)
+	case 'c':
+		if strings.HasPrefix(name, "dconfig") {
+			return d.PrintConfigurationParameters(w, pattern, verbose, showSystem)
+		}
+		return d.PrintConversions(w, pattern, verbose, showSystem)
+	case 'C':
+		return d.PrintCasts(w, pattern, verbose)
+	case 'd':
+		if strings.HasPrefix(name, "ddp") {
+			return d.PrintDefaultACLS(w, pattern)
+		}
+		return d.PrintObjectDescription(w, pattern, showSystem)
+	case 'D':
+		return d.PrintDomains(w, pattern, verbose, showSystem)
+	case 'f': // function subsystem
+		switch next(2) {
+		case 0, '+', 'S', 'a', 'n', 'p', 't', 'w':
+			return d.PrintFunctions(w, name[2:], pattern, verbose, showSystem)
+		}
+	case 'g':
+		// no longer distinct from \du
+		return d.PrintRoles(w, pattern, verbose, showSystem)
+	case 'l':
+		return notSupported(name)
+	case 'L':
+		return d.PrintLanguages(w, pattern, verbose, showSystem)
+	case 'n':
+		return d.PrintSchemas(w, pattern, verbose, showSystem)
+	case 'o':
+		return d.PrintOperators(w, pattern, verbose, showSystem)
+	case 'O':
+		return d.PrintCollations(w, pattern, verbose, showSystem)
+	case 'p':
+		return d.PrintPermissions(w, pattern)
+	case 'P':
+		switch next(2) {
+		case 0, '+', 't', 'i', 'n':
+			return d.PrintPartitionedTables(w, name[2:], pattern, verbose)
+		}
+	case 'T':
+		return d.PrintTypes(w, pattern, verbose, showSystem)
+	case 't', 'v', 'm', 'i', 's', 'E':
+		return d.PrintTables(w, name[1:], pattern, verbose, showSystem)
+	case 'r':
+		if next(2) == 'd' && next(3) == 's' {
+			pattern2 := NULL
+			if pattern != NULL {
+				pattern2 = cmd.arg(1)
+			}
+			return d.PrintDatabaseRoleSettings(w, pattern, pattern2)
+		}
+		if next(2) == 'g' {
+			return d.PrintRoleGrants(w, pattern, showSystem)
+		}
+	case 'R':
+		switch next(2) {
+		case 'p':
+			if verbose {
+				return d.PrintPublicationDetails(w, pattern)
+			}
+			return d.PrintPublications(w, pattern)
+		case 's':
+			return d.PrintSubscriptions(w, pattern, verbose)
+		}
+	case 'u':
+		return d.PrintRoles(w, pattern, verbose, showSystem)
+	case 'F': // text search subsystem
+		switch next(2) {
+		case 0, '+':
+			if verbose {
+				return d.PrintTextSearchConfigsVerbose(w, pattern)
+			}
+			return d.PrintTextSearchConfigs(w, pattern)
+		case 'p':
+			if verbose {
+				return d.PrintTextSearchParsersVerbose(w, pattern)
+			}
+			return d.PrintTextSearchParsers(w, pattern)
+		case 'd':
+			return d.PrintTextSearchDictionaries(w, pattern, verbose)
+		case 't':
+			return d.PrintTextSearchTemplates(w, pattern, verbose)
+		}
+	case 'e': // SQL/MED subsystem
+		switch next(2) {
+		case 's':
+			return d.PrintForeignServers(w, pattern, verbose)
+		case 'u':
+			return d.PrintUserMappings(w, pattern, verbose)
+		case 'w':
+			return d.PrintForeignDataWrappers(w, pattern, verbose)
+		case 't':
+			return d.PrintForeignTables(w, pattern, verbose)
+		}
+	case 'X': // Extended Statistics
+		return d.PrintExtendedStats(w, pattern)
+	case 'x': // extensions
+		if verbose {
+			return d.PrintExtensionContents(w, pattern)
+		}
+		return d.PrintExtensions(w, pattern)
+	case 'y': // event triggers
+		return d.PrintEventTriggers(w, pattern, verbose)
+	}
+	return invalidCommand(name)
+}
+
+// RunContext is the same as Run, but with a context.
+func (d *PgDesc) RunContext(ctx context.Context, w io.Writer, cmd *Command) error {
+	return d.withContext(ctx).Run(w, cmd)
+}
+
+// invalidCommand returns psql's error for an unknown command.
+func invalidCommand(name string) error {
+	return fmt.Errorf("Invalid command \\%s. Try \\? for help.\n", name)
+}
+
+// notSupported returns the error for a valid psql command that cannot be
+// executed.
+func notSupported(name string) error {
+	return fmt.Errorf("\\%s is not supported\n", name)
+}
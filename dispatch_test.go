@@ -0,0 +1,216 @@
+package pgdesc
+
+import (
+	"database/sql"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		line string
+		name string
+		args []string
+		err  string
+	}{
+		{`\dt`, "dt", nil, ""},
+		{`  \dt+  `, "dt+", nil, ""},
+		{`\dfS+ public.*`, "dfS+", []string{"public.*"}, ""},
+		{`\drds role db extra`, "drds", []string{"role", "db", "extra"}, ""},
+		{`\d "Foo Bar"`, "d", []string{`"Foo Bar"`}, ""},
+		{`\d "a""b".c`, "d", []string{`"a""b".c`}, ""},
+		{`\d+"foo"`, "d+", []string{`"foo"`}, ""},
+		{`\dt 'a b'`, "dt", []string{"a b"}, ""},
+		{`\dt 'it''s'`, "dt", []string{"it's"}, ""},
+		{`\dt 'a\nb\tc\'d\\e'`, "dt", []string{"a\nb\tc'd\\e"}, ""},
+		{`\dt pub"X".'y z'`, "dt", []string{`pub"X".y z`}, ""},
+		{`\dt ''`, "dt", []string{""}, ""},
+		{`\dt foo\dv bar`, "dt", []string{"foo"}, ""},
+		{`\dt foo \\ bar`, "dt", []string{"foo"}, ""},
+		{`\dt 'abc`, "", nil, "unterminated quoted string\n"},
+		{`\dt "abc`, "", nil, "unterminated quoted string\n"},
+		{`\dt 'abc\'`, "", nil, "unterminated quoted string\n"},
+		{`\dt "a""`, "", nil, "unterminated quoted string\n"},
+		{`dt foo`, "", nil, "invalid command dt foo\n"},
+		{``, "", nil, "invalid command \n"},
+		{`\`, "", nil, "invalid command \\\n"},
+		{`\ dt`, "", nil, "invalid command \\\n"},
+	}
+	for _, test := range tests {
+		cmd, err := ParseCommand(test.line)
+		switch {
+		case test.err != "":
+			if err == nil || err.Error() != test.err {
+				t.Errorf("ParseCommand(%q) expected error %q, got: %v", test.line, test.err, err)
+			}
+		case err != nil:
+			t.Errorf("ParseCommand(%q) expected no error, got: %v", test.line, err)
+		case cmd.Name != test.name || !reflect.DeepEqual(cmd.Args, test.args):
+			t.Errorf("ParseCommand(%q) expected %q %q, got: %q %q", test.line, test.name, test.args, cmd.Name, cmd.Args)
+		}
+	}
+}
+
+func TestDispatch(t *testing.T) {
+	tests := []struct {
+		line string
+		exp  func(d *PgDesc) (*Result, error)
+	}{
+		{`\d`, func(d *PgDesc) (*Result, error) { return d.QueryTables("tvmsE", NULL, false, false) }},
+		{`\dS+`, func(d *PgDesc) (*Result, error) { return d.QueryTables("tvmsE", NULL, true, true) }},
+		{`\d foo`, func(d *PgDesc) (*Result, error) { return d.QueryTableDetails("foo", false, false) }},
+		{`\dS+ foo`, func(d *PgDesc) (*Result, error) { return d.QueryTableDetails("foo", true, true) }},
+		{`\dt public.*`, func(d *PgDesc) (*Result, error) { return d.QueryTables("t", "public.*", false, false) }},
+		{`\dtvS+`, func(d *PgDesc) (*Result, error) { return d.QueryTables("tvS+", NULL, true, true) }},
+		{`\dv`, func(d *PgDesc) (*Result, error) { return d.QueryTables("v", NULL, false, false) }},
+		{`\dm`, func(d *PgDesc) (*Result, error) { return d.QueryTables("m", NULL, false, false) }},
+		{`\di`, func(d *PgDesc) (*Result, error) { return d.QueryTables("i", NULL, false, false) }},
+		{`\ds`, func(d *PgDesc) (*Result, error) { return d.QueryTables("s", NULL, false, false) }},
+		{`\dE`, func(d *PgDesc) (*Result, error) { return d.QueryTables("E", NULL, false, false) }},
+		{`\dA+ btree`, func(d *PgDesc) (*Result, error) { return d.QueryAccessMethods("btree", true) }},
+		{`\daS`, func(d *PgDesc) (*Result, error) { return d.QueryAggregates(NULL, false, true) }},
+		{`\db`, func(d *PgDesc) (*Result, error) { return d.QueryTablespaces(NULL, false) }},
+		{`\dc`, func(d *PgDesc) (*Result, error) { return d.QueryConversions(NULL, false, false) }},
+		{`\dconfig+ work*`, func(d *PgDesc) (*Result, error) { return d.QueryConfigurationParameters("work*", true, false) }},
+		{`\dC`, func(d *PgDesc) (*Result, error) { return d.QueryCasts(NULL, false) }},
+		{`\dd foo`, func(d *PgDesc) (*Result, error) { return d.QueryObjectDescription("foo", false) }},
+		{`\ddp`, func(d *PgDesc) (*Result, error) { return d.QueryDefaultACLS(NULL) }},
+		{`\dD`, func(d *PgDesc) (*Result, error) { return d.QueryDomains(NULL, false, false) }},
+		{`\df`, func(d *PgDesc) (*Result, error) { return d.QueryFunctions("", NULL, false, false) }},
+		{`\dfa+`, func(d *PgDesc) (*Result, error) { return d.QueryFunctions("a+", NULL, true, false) }},
+		{`\dfSnp`, func(d *PgDesc) (*Result, error) { return d.QueryFunctions("Snp", NULL, false, true) }},
+		{`\dg`, func(d *PgDesc) (*Result, error) { return d.QueryRoles(NULL, false, false) }},
+		{`\du+ postgres`, func(d *PgDesc) (*Result, error) { return d.QueryRoles("postgres", true, false) }},
+		{`\dL`, func(d *PgDesc) (*Result, error) { return d.QueryLanguages(NULL, false, false) }},
+		{`\dn+`, func(d *PgDesc) (*Result, error) { return d.QuerySchemas(NULL, true, false) }},
+		{`\do`, func(d *PgDesc) (*Result, error) { return d.QueryOperators(NULL, false, false) }},
+		{`\dO`, func(d *PgDesc) (*Result, error) { return d.QueryCollations(NULL, false, false) }},
+		{`\dp foo`, func(d *PgDesc) (*Result, error) { return d.QueryPermissions("foo") }},
+		{`\z foo`, func(d *PgDesc) (*Result, error) { return d.QueryPermissions("foo") }},
+		{`\dP`, func(d *PgDesc) (*Result, error) { return d.QueryPartitionedTables("", NULL, false) }},
+		{`\dPt+`, func(d *PgDesc) (*Result, error) { return d.QueryPartitionedTables("t+", NULL, true) }},
+		{`\dT`, func(d *PgDesc) (*Result, error) { return d.QueryTypes(NULL, false, false) }},
+		{`\drds`, func(d *PgDesc) (*Result, error) { return d.QueryDatabaseRoleSettings(NULL, NULL) }},
+		{`\drds role db`, func(d *PgDesc) (*Result, error) { return d.QueryDatabaseRoleSettings("role", "db") }},
+		{`\drg`, func(d *PgDesc) (*Result, error) { return d.QueryRoleGrants(NULL, false) }},
+		{`\dRp`, func(d *PgDesc) (*Result, error) { return d.QueryPublications(NULL) }},
+		{`\dRp+ pub`, func(d *PgDesc) (*Result, error) { return d.QueryPublicationDetails("pub") }},
+		{`\dRs+`, func(d *PgDesc) (*Result, error) { return d.QuerySubscriptions(NULL, true) }},
+		{`\dF`, func(d *PgDesc) (*Result, error) { return d.QueryTextSearchConfigs(NULL, false) }},
+		{`\dF+`, func(d *PgDesc) (*Result, error) { return d.QueryTextSearchConfigsVerbose(NULL) }},
+		{`\dFp`, func(d *PgDesc) (*Result, error) { return d.QueryTextSearchParsers(NULL, false) }},
+		{`\dFp+`, func(d *PgDesc) (*Result, error) { return d.QueryTextSearchParsersVerbose(NULL) }},
+		{`\dFd+`, func(d *PgDesc) (*Result, error) { return d.QueryTextSearchDictionaries(NULL, true) }},
+		{`\dFt`, func(d *PgDesc) (*Result, error) { return d.QueryTextSearchTemplates(NULL, false) }},
+		{`\des`, func(d *PgDesc) (*Result, error) { return d.QueryForeignServers(NULL, false) }},
+		{`\deu`, func(d *PgDesc) (*Result, error) { return d.QueryUserMappings(NULL, false) }},
+		{`\dew`, func(d *PgDesc) (*Result, error) { return d.QueryForeignDataWrappers(NULL, false) }},
+		{`\det+`, func(d *PgDesc) (*Result, error) { return d.QueryForeignTables(NULL, true) }},
+		{`\dX`, func(d *PgDesc) (*Result, error) { return d.QueryExtendedStats(NULL) }},
+		{`\dx`, func(d *PgDesc) (*Result, error) { return d.QueryExtensions(NULL) }},
+		{`\dx+ plpgsql`, func(d *PgDesc) (*Result, error) { return d.QueryExtensionContents("plpgsql") }},
+		{`\dy`, func(d *PgDesc) (*Result, error) { return d.QueryEventTriggers(NULL, false) }},
+		{`\l`, func(d *PgDesc) (*Result, error) { return d.QueryDatabases(NULL, false) }},
+		{`\list+ postgres`, func(d *PgDesc) (*Result, error) { return d.QueryDatabases("postgres", true) }},
+	}
+	for _, test := range tests {
+		t.Run(test.line, func(t *testing.T) {
+			d, fdb := openEmptyFakeDB(t)
+			if err := d.Dispatch(io.Discard, test.line); err == nil {
+				t.Fatal("expected an error for a query without a result set, got nil")
+			}
+			d, exp := openEmptyFakeDB(t)
+			if _, err := test.exp(d); err == nil {
+				t.Fatal("expected an error for a query without a result set, got nil")
+			}
+			if len(fdb.queries) != 1 || len(exp.queries) != 1 {
+				t.Fatalf("expected 1 query, got: %d and %d", len(fdb.queries), len(exp.queries))
+			}
+			if q, e := fdb.queries[0], exp.queries[0]; q.query != e.query || !reflect.DeepEqual(q.args, e.args) {
+				t.Errorf("expected query:\n%s\n%v\ngot:\n%s\n%v", e.query, e.args, q.query, q.args)
+			}
+		})
+	}
+}
+
+func TestDispatchInvalid(t *testing.T) {
+	tests := []struct {
+		line string
+		err  string
+	}{
+		{`\x`, "Invalid command \\x. Try \\? for help.\n"},
+		{`\lx`, "Invalid command \\lx. Try \\? for help.\n"},
+		{`\dq`, "Invalid command \\dq. Try \\? for help.\n"},
+		{`\dfq`, "Invalid command \\dfq. Try \\? for help.\n"},
+		{`\dPq`, "Invalid command \\dPq. Try \\? for help.\n"},
+		{`\dr`, "Invalid command \\dr. Try \\? for help.\n"},
+		{`\drd`, "Invalid command \\drd. Try \\? for help.\n"},
+		{`\dR`, "Invalid command \\dR. Try \\? for help.\n"},
+		{`\dRx`, "Invalid command \\dRx. Try \\? for help.\n"},
+		{`\dFx`, "Invalid command \\dFx. Try \\? for help.\n"},
+		{`\de`, "Invalid command \\de. Try \\? for help.\n"},
+		{`\dl`, "\\dl is not supported\n"},
+		{`\dt 'foo`, "unterminated quoted string\n"},
+		{`dt`, "invalid command dt\n"},
+	}
+	for _, test := range tests {
+		d, fdb := openEmptyFakeDB(t)
+		if err := d.Dispatch(io.Discard, test.line); err == nil || err.Error() != test.err {
+			t.Errorf("Dispatch(%q) expected error %q, got: %v", test.line, test.err, err)
+		}
+		if len(fdb.queries) != 0 {
+			t.Errorf("Dispatch(%q) expected no queries, got: %d", test.line, len(fdb.queries))
+		}
+	}
+}
+
+func TestDispatchQualifiedNames(t *testing.T) {
+	tests := []struct {
+		line string
+		err  string
+	}{
+		{`\dn a.b`, "cross-database references are not implemented: a.b"},
+		{`\dn a.b.c`, "improper qualified name (too many dotted names): a.b.c"},
+		{`\dL a.b`, "cross-database references are not implemented: a.b"},
+		{`\du a.b`, "improper qualified name (too many dotted names): a.b"},
+		{`\ddp a.b.c`, "cross-database references are not implemented: a.b.c"},
+		{`\dt a.b.c`, "cross-database references are not implemented: a.b.c"},
+		{`\dt a.b.c.d`, "improper qualified name (too many dotted names): a.b.c.d"},
+	}
+	for _, test := range tests {
+		fdb := new(fakeDB)
+		db := sql.OpenDB(fdb)
+		defer db.Close()
+		d := NewPgDesc(db, 170000, WithDatabase("regression"))
+		if err := d.Dispatch(io.Discard, test.line); err == nil || err.Error() != test.err {
+			t.Errorf("Dispatch(%q) expected error %q, got: %v", test.line, test.err, err)
+		}
+		if len(fdb.queries) != 0 {
+			t.Errorf("Dispatch(%q) expected no queries, got: %d", test.line, len(fdb.queries))
+		}
+	}
+}
+
+func TestRunEmptyName(t *testing.T) {
+	d, fdb := openEmptyFakeDB(t)
+	for _, cmd := range []*Command{{}, {Args: []string{"foo"}}} {
+		err := d.Run(io.Discard, cmd)
+		if exp := "Invalid command \\. Try \\? for help.\n"; err == nil || err.Error() != exp {
+			t.Errorf("Run(%v) expected error %q, got: %v", cmd, exp, err)
+		}
+	}
+	if len(fdb.queries) != 0 {
+		t.Errorf("expected no queries, got: %d", len(fdb.queries))
+	}
+}
+
+// openEmptyFakeDB returns a PgDesc for a fakeDB without result sets, that
+// records the queries executed and answers each with an error.
+func openEmptyFakeDB(t *testing.T) (*PgDesc, *fakeDB) {
+	t.Helper()
+	fdb := new(fakeDB)
+	db := sql.OpenDB(fdb)
+	t.Cleanup(func() { db.Close() })
+	return NewPgDesc(db, 170000), fdb
+}
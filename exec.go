@@ -0,0 +1,698 @@
+package pgdesc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Queryer is the interface for database handles that describe queries can be
+// executed against.
+//
+// Satisfied by *sql.DB, *sql.Conn, and *sql.Tx.
+type Queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// ErrNoDB is the error returned when executing a query without a database
+// handle.
+var ErrNoDB = errors.New("no database handle")
+
+// invalidQueryer is the Queryer used for a db handle passed to NewPgDesc that
+// does not satisfy Queryer.
+type invalidQueryer struct {
+	db interface{}
+}
+
+// QueryContext satisfies the Queryer interface.
+func (q invalidQueryer) QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error) {
+	return nil, fmt.Errorf("database handle %T does not implement Queryer", q.db)
+}
+
+// Result is the result of an executed query.
+//
+// Similar to libpq's PGresult, all values are held in their text
+// representation.
+type Result struct {
+	// Columns are the result column names.
+	Columns []string
+
+	// Types are the result column database type names, as reported by the
+	// driver (ie, INT4, TEXT). Empty when not reported.
+	Types []string
+
+	// Rows are the result rows.
+	Rows [][]sql.NullString
+}
+
+// Len returns the number of rows in the result.
+func (r *Result) Len() int {
+	return len(r.Rows)
+}
+
+// Value returns the value for row i, column j, or the empty string when the
+// value is NULL.
+func (r *Result) Value(i, j int) string {
+	return r.Rows[i][j].String
+}
+
+// IsNull returns whether or not the value for row i, column j is NULL.
+func (r *Result) IsNull(i, j int) bool {
+	return !r.Rows[i][j].Valid
+}
+
+// Column returns the index of the named column, or -1 when the result does
+// not have the column.
+func (r *Result) Column(name string) int {
+	for j, col := range r.Columns {
+		if col == name {
+			return j
+		}
+	}
+	return -1
+}
+
+// named returns the value for row i of the named column, or the empty string
+// when the value is NULL or the result does not have the column (ie, for
+// columns only selected by newer server versions).
+func (r *Result) named(i int, name string) string {
+	if j := r.Column(name); j != -1 {
+		return r.Value(i, j)
+	}
+	return ""
+}
+
+// query builds a query by calling f, and executes it, passing patterns and
+// other caller supplied values as query parameters.
+func (d *PgDesc) query(f func(io.Writer) error) (*Result, error) {
+	buf := new(QueryBuffer)
+	if err := f(buf); err != nil {
+		return nil, err
+	}
+	return d.exec(buf.String(), buf.Args...)
+}
+
+// execBuffer executes the query in buf with its parameters, and resets buf
+// for the next query.
+func (d *PgDesc) execBuffer(buf *QueryBuffer) (*Result, error) {
+	res, err := d.exec(buf.String(), buf.Args...)
+	buf.Reset()
+	buf.Args = nil
+	return res, err
+}
+
+// exec executes the query against the database handle, reading all rows.
+func (d *PgDesc) exec(query string, args ...interface{}) (*Result, error) {
+	if d.db == nil {
+		return nil, ErrNoDB
+	}
+
+	rows, err := d.db.QueryContext(d.context(), query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Result{Columns: cols, Types: make([]string, len(cols))}
+	if types, err := rows.ColumnTypes(); err == nil {
+		for i, typ := range types {
+			res.Types[i] = typ.DatabaseTypeName()
+		}
+	}
+	vals, ptrs := make([]interface{}, len(cols)), make([]interface{}, len(cols))
+	for i := range vals {
+		ptrs[i] = &vals[i]
+	}
+	for rows.Next() {
+		if err = rows.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+		row := make([]sql.NullString, len(cols))
+		for i, v := range vals {
+			row[i] = textValue(v)
+		}
+		res.Rows = append(res.Rows, row)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// textValue converts a value returned by a driver to its postgres text
+// representation.
+func textValue(v interface{}) sql.NullString {
+	var s string
+	switch x := v.(type) {
+	case nil:
+		return sql.NullString{}
+	case string:
+		s = x
+	case []byte:
+		s = string(x)
+	case bool:
+		s = "f"
+		if x {
+			s = "t"
+		}
+	case int64:
+		s = strconv.FormatInt(x, 10)
+	case float64:
+		s = strconv.FormatFloat(x, 'g', -1, 64)
+	case time.Time:
+		layout := "2006-01-02 15:04:05.999999-07"
+		if _, offset := x.Zone(); offset%3600 != 0 {
+			layout += ":00"
+		}
+		s = x.Format(layout)
+	default:
+		s = fmt.Sprintf("%v", x)
+	}
+	return sql.NullString{String: s, Valid: true}
+}
+
+// QueryAccessMethods executes \dA, returning the result.
+func (d *PgDesc) QueryAccessMethods(pattern string, verbose bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.AccessMethods(w, pattern, verbose)
+	})
+}
+
+// QueryAccessMethodsContext is the same as QueryAccessMethods, but with a context.
+func (d *PgDesc) QueryAccessMethodsContext(ctx context.Context, pattern string, verbose bool) (*Result, error) {
+	return d.withContext(ctx).QueryAccessMethods(pattern, verbose)
+}
+
+// QueryAggregates executes \da, returning the result.
+func (d *PgDesc) QueryAggregates(pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.Aggregates(w, pattern, verbose, showSystem)
+	})
+}
+
+// QueryAggregatesContext is the same as QueryAggregates, but with a context.
+func (d *PgDesc) QueryAggregatesContext(ctx context.Context, pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.withContext(ctx).QueryAggregates(pattern, verbose, showSystem)
+}
+
+// QueryCasts executes \dC, returning the result.
+func (d *PgDesc) QueryCasts(pattern string, verbose bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.Casts(w, pattern, verbose)
+	})
+}
+
+// QueryCastsContext is the same as QueryCasts, but with a context.
+func (d *PgDesc) QueryCastsContext(ctx context.Context, pattern string, verbose bool) (*Result, error) {
+	return d.withContext(ctx).QueryCasts(pattern, verbose)
+}
+
+// QueryCollations executes \dO, returning the result.
+func (d *PgDesc) QueryCollations(pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.Collations(w, pattern, verbose, showSystem)
+	})
+}
+
+// QueryCollationsContext is the same as QueryCollations, but with a context.
+func (d *PgDesc) QueryCollationsContext(ctx context.Context, pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.withContext(ctx).QueryCollations(pattern, verbose, showSystem)
+}
+
+// QueryConfigurationParameters executes \dconfig, returning the result.
+func (d *PgDesc) QueryConfigurationParameters(pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.ConfigurationParameters(w, pattern, verbose, showSystem)
+	})
+}
+
+// QueryConfigurationParametersContext is the same as QueryConfigurationParameters, but with a context.
+func (d *PgDesc) QueryConfigurationParametersContext(ctx context.Context, pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.withContext(ctx).QueryConfigurationParameters(pattern, verbose, showSystem)
+}
+
+// QueryConversions executes \dc, returning the result.
+func (d *PgDesc) QueryConversions(pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.Conversions(w, pattern, verbose, showSystem)
+	})
+}
+
+// QueryConversionsContext is the same as QueryConversions, but with a context.
+func (d *PgDesc) QueryConversionsContext(ctx context.Context, pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.withContext(ctx).QueryConversions(pattern, verbose, showSystem)
+}
+
+// QueryDatabaseRoleSettings executes \drds, returning the result.
+func (d *PgDesc) QueryDatabaseRoleSettings(pattern, pattern2 string) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.DatabaseRoleSettings(w, pattern, pattern2)
+	})
+}
+
+// QueryDatabaseRoleSettingsContext is the same as QueryDatabaseRoleSettings, but with a context.
+func (d *PgDesc) QueryDatabaseRoleSettingsContext(ctx context.Context, pattern, pattern2 string) (*Result, error) {
+	return d.withContext(ctx).QueryDatabaseRoleSettings(pattern, pattern2)
+}
+
+// QueryDatabases executes \l, returning the result.
+func (d *PgDesc) QueryDatabases(pattern string, verbose bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.Databases(w, pattern, verbose)
+	})
+}
+
+// QueryDatabasesContext is the same as QueryDatabases, but with a context.
+func (d *PgDesc) QueryDatabasesContext(ctx context.Context, pattern string, verbose bool) (*Result, error) {
+	return d.withContext(ctx).QueryDatabases(pattern, verbose)
+}
+
+// QueryDefaultACLS executes \ddp, returning the result.
+func (d *PgDesc) QueryDefaultACLS(pattern string) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.DefaultACLS(w, pattern)
+	})
+}
+
+// QueryDefaultACLSContext is the same as QueryDefaultACLS, but with a context.
+func (d *PgDesc) QueryDefaultACLSContext(ctx context.Context, pattern string) (*Result, error) {
+	return d.withContext(ctx).QueryDefaultACLS(pattern)
+}
+
+// QueryDomains executes \dD, returning the result.
+func (d *PgDesc) QueryDomains(pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.Domains(w, pattern, verbose, showSystem)
+	})
+}
+
+// QueryDomainsContext is the same as QueryDomains, but with a context.
+func (d *PgDesc) QueryDomainsContext(ctx context.Context, pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.withContext(ctx).QueryDomains(pattern, verbose, showSystem)
+}
+
+// QueryEventTriggers executes \dy, returning the result.
+func (d *PgDesc) QueryEventTriggers(pattern string, verbose bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.EventTriggers(w, pattern, verbose)
+	})
+}
+
+// QueryEventTriggersContext is the same as QueryEventTriggers, but with a context.
+func (d *PgDesc) QueryEventTriggersContext(ctx context.Context, pattern string, verbose bool) (*Result, error) {
+	return d.withContext(ctx).QueryEventTriggers(pattern, verbose)
+}
+
+// QueryExtendedStats executes \dX, returning the result.
+func (d *PgDesc) QueryExtendedStats(pattern string) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.ExtendedStats(w, pattern)
+	})
+}
+
+// QueryExtendedStatsContext is the same as QueryExtendedStats, but with a context.
+func (d *PgDesc) QueryExtendedStatsContext(ctx context.Context, pattern string) (*Result, error) {
+	return d.withContext(ctx).QueryExtendedStats(pattern)
+}
+
+// QueryExtensionContents executes \dx+, returning the result.
+func (d *PgDesc) QueryExtensionContents(pattern string) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.ExtensionContents(w, pattern)
+	})
+}
+
+// QueryExtensionContentsContext is the same as QueryExtensionContents, but with a context.
+func (d *PgDesc) QueryExtensionContentsContext(ctx context.Context, pattern string) (*Result, error) {
+	return d.withContext(ctx).QueryExtensionContents(pattern)
+}
+
+// QueryExtensions executes \dx, returning the result.
+func (d *PgDesc) QueryExtensions(pattern string) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.Extensions(w, pattern)
+	})
+}
+
+// QueryExtensionsContext is the same as QueryExtensions, but with a context.
+func (d *PgDesc) QueryExtensionsContext(ctx context.Context, pattern string) (*Result, error) {
+	return d.withContext(ctx).QueryExtensions(pattern)
+}
+
+// QueryForeignDataWrappers executes \dew, returning the result.
+func (d *PgDesc) QueryForeignDataWrappers(pattern string, verbose bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.ForeignDataWrappers(w, pattern, verbose)
+	})
+}
+
+// QueryForeignDataWrappersContext is the same as QueryForeignDataWrappers, but with a context.
+func (d *PgDesc) QueryForeignDataWrappersContext(ctx context.Context, pattern string, verbose bool) (*Result, error) {
+	return d.withContext(ctx).QueryForeignDataWrappers(pattern, verbose)
+}
+
+// QueryForeignServers executes \des, returning the result.
+func (d *PgDesc) QueryForeignServers(pattern string, verbose bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.ForeignServers(w, pattern, verbose)
+	})
+}
+
+// QueryForeignServersContext is the same as QueryForeignServers, but with a context.
+func (d *PgDesc) QueryForeignServersContext(ctx context.Context, pattern string, verbose bool) (*Result, error) {
+	return d.withContext(ctx).QueryForeignServers(pattern, verbose)
+}
+
+// QueryForeignTables executes \det, returning the result.
+func (d *PgDesc) QueryForeignTables(pattern string, verbose bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.ForeignTables(w, pattern, verbose)
+	})
+}
+
+// QueryForeignTablesContext is the same as QueryForeignTables, but with a context.
+func (d *PgDesc) QueryForeignTablesContext(ctx context.Context, pattern string, verbose bool) (*Result, error) {
+	return d.withContext(ctx).QueryForeignTables(pattern, verbose)
+}
+
+// QueryFunctions executes \df, \dfa, \dfn, \dft, \dfw, etc, returning the result.
+func (d *PgDesc) QueryFunctions(functypes, pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.Functions(w, functypes, pattern, verbose, showSystem)
+	})
+}
+
+// QueryFunctionsContext is the same as QueryFunctions, but with a context.
+func (d *PgDesc) QueryFunctionsContext(ctx context.Context, functypes, pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.withContext(ctx).QueryFunctions(functypes, pattern, verbose, showSystem)
+}
+
+// QueryLanguages executes \dL, returning the result.
+func (d *PgDesc) QueryLanguages(pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.Languages(w, pattern, verbose, showSystem)
+	})
+}
+
+// QueryLanguagesContext is the same as QueryLanguages, but with a context.
+func (d *PgDesc) QueryLanguagesContext(ctx context.Context, pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.withContext(ctx).QueryLanguages(pattern, verbose, showSystem)
+}
+
+// QueryObjectDescription executes \dd, returning the result.
+func (d *PgDesc) QueryObjectDescription(pattern string, showSystem bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.ObjectDescription(w, pattern, showSystem)
+	})
+}
+
+// QueryObjectDescriptionContext is the same as QueryObjectDescription, but with a context.
+func (d *PgDesc) QueryObjectDescriptionContext(ctx context.Context, pattern string, showSystem bool) (*Result, error) {
+	return d.withContext(ctx).QueryObjectDescription(pattern, showSystem)
+}
+
+// QueryOneExtensionContents executes the contents query for a single extension, returning the result.
+func (d *PgDesc) QueryOneExtensionContents(extname, oid string) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.OneExtensionContents(w, extname, oid)
+	})
+}
+
+// QueryOneExtensionContentsContext is the same as QueryOneExtensionContents, but with a context.
+func (d *PgDesc) QueryOneExtensionContentsContext(ctx context.Context, extname, oid string) (*Result, error) {
+	return d.withContext(ctx).QueryOneExtensionContents(extname, oid)
+}
+
+// QueryOneTextSearchConfig executes the mapping query for a single text search configuration, returning the result.
+func (d *PgDesc) QueryOneTextSearchConfig(oid, nspname, cfgname, pnspname, prsname string) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.OneTextSearchConfig(w, oid, nspname, cfgname, pnspname, prsname)
+	})
+}
+
+// QueryOneTextSearchConfigContext is the same as QueryOneTextSearchConfig, but with a context.
+func (d *PgDesc) QueryOneTextSearchConfigContext(ctx context.Context, oid, nspname, cfgname, pnspname, prsname string) (*Result, error) {
+	return d.withContext(ctx).QueryOneTextSearchConfig(oid, nspname, cfgname, pnspname, prsname)
+}
+
+// QueryOneTextSearchParser executes the methods query for a single text search parser, returning the result.
+func (d *PgDesc) QueryOneTextSearchParser(oid, nspname, prsname string) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.OneTextSearchParser(w, oid, nspname, prsname)
+	})
+}
+
+// QueryOneTextSearchParserContext is the same as QueryOneTextSearchParser, but with a context.
+func (d *PgDesc) QueryOneTextSearchParserContext(ctx context.Context, oid, nspname, prsname string) (*Result, error) {
+	return d.withContext(ctx).QueryOneTextSearchParser(oid, nspname, prsname)
+}
+
+// QueryOperators executes \do, returning the result.
+func (d *PgDesc) QueryOperators(pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.Operators(w, pattern, verbose, showSystem)
+	})
+}
+
+// QueryOperatorsContext is the same as QueryOperators, but with a context.
+func (d *PgDesc) QueryOperatorsContext(ctx context.Context, pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.withContext(ctx).QueryOperators(pattern, verbose, showSystem)
+}
+
+// QueryPartitionedTables executes \dP, \dPt, \dPi, \dPn, etc., returning the result.
+func (d *PgDesc) QueryPartitionedTables(reltypes, pattern string, verbose bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.PartitionedTables(w, reltypes, pattern, verbose)
+	})
+}
+
+// QueryPartitionedTablesContext is the same as QueryPartitionedTables, but with a context.
+func (d *PgDesc) QueryPartitionedTablesContext(ctx context.Context, reltypes, pattern string, verbose bool) (*Result, error) {
+	return d.withContext(ctx).QueryPartitionedTables(reltypes, pattern, verbose)
+}
+
+// QueryPermissions executes \z (or \dp), returning the result.
+func (d *PgDesc) QueryPermissions(pattern string) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.Permissions(w, pattern)
+	})
+}
+
+// QueryPermissionsContext is the same as QueryPermissions, but with a context.
+func (d *PgDesc) QueryPermissionsContext(ctx context.Context, pattern string) (*Result, error) {
+	return d.withContext(ctx).QueryPermissions(pattern)
+}
+
+// QueryPublicationDetails executes \dRp+, returning the result.
+func (d *PgDesc) QueryPublicationDetails(pattern string) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.PublicationDetails(w, pattern)
+	})
+}
+
+// QueryPublicationDetailsContext is the same as QueryPublicationDetails, but with a context.
+func (d *PgDesc) QueryPublicationDetailsContext(ctx context.Context, pattern string) (*Result, error) {
+	return d.withContext(ctx).QueryPublicationDetails(pattern)
+}
+
+// QueryPublications executes \dRp, returning the result.
+func (d *PgDesc) QueryPublications(pattern string) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.Publications(w, pattern)
+	})
+}
+
+// QueryPublicationsContext is the same as QueryPublications, but with a context.
+func (d *PgDesc) QueryPublicationsContext(ctx context.Context, pattern string) (*Result, error) {
+	return d.withContext(ctx).QueryPublications(pattern)
+}
+
+// QueryRoleGrants executes \drg, returning the result.
+func (d *PgDesc) QueryRoleGrants(pattern string, showSystem bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.RoleGrants(w, pattern, showSystem)
+	})
+}
+
+// QueryRoleGrantsContext is the same as QueryRoleGrants, but with a context.
+func (d *PgDesc) QueryRoleGrantsContext(ctx context.Context, pattern string, showSystem bool) (*Result, error) {
+	return d.withContext(ctx).QueryRoleGrants(pattern, showSystem)
+}
+
+// QueryRoles executes \du, \dg, returning the result.
+func (d *PgDesc) QueryRoles(pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.Roles(w, pattern, verbose, showSystem)
+	})
+}
+
+// QueryRolesContext is the same as QueryRoles, but with a context.
+func (d *PgDesc) QueryRolesContext(ctx context.Context, pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.withContext(ctx).QueryRoles(pattern, verbose, showSystem)
+}
+
+// QuerySchemas executes \dn, returning the result.
+func (d *PgDesc) QuerySchemas(pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.Schemas(w, pattern, verbose, showSystem)
+	})
+}
+
+// QuerySchemasContext is the same as QuerySchemas, but with a context.
+func (d *PgDesc) QuerySchemasContext(ctx context.Context, pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.withContext(ctx).QuerySchemas(pattern, verbose, showSystem)
+}
+
+// QuerySubscriptions executes \dRs, returning the result.
+func (d *PgDesc) QuerySubscriptions(pattern string, verbose bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.Subscriptions(w, pattern, verbose)
+	})
+}
+
+// QuerySubscriptionsContext is the same as QuerySubscriptions, but with a context.
+func (d *PgDesc) QuerySubscriptionsContext(ctx context.Context, pattern string, verbose bool) (*Result, error) {
+	return d.withContext(ctx).QuerySubscriptions(pattern, verbose)
+}
+
+// QueryTableDetails executes \d foo, returning the result.
+func (d *PgDesc) QueryTableDetails(pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.TableDetails(w, pattern, verbose, showSystem)
+	})
+}
+
+// QueryTableDetailsContext is the same as QueryTableDetails, but with a context.
+func (d *PgDesc) QueryTableDetailsContext(ctx context.Context, pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.withContext(ctx).QueryTableDetails(pattern, verbose, showSystem)
+}
+
+// QueryTables executes \dt, \di, \ds, \dS, etc, returning the result.
+func (d *PgDesc) QueryTables(tabtypes, pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.Tables(w, tabtypes, pattern, verbose, showSystem)
+	})
+}
+
+// QueryTablesContext is the same as QueryTables, but with a context.
+func (d *PgDesc) QueryTablesContext(ctx context.Context, tabtypes, pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.withContext(ctx).QueryTables(tabtypes, pattern, verbose, showSystem)
+}
+
+// QueryTablespaces executes \db, returning the result.
+func (d *PgDesc) QueryTablespaces(pattern string, verbose bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.Tablespaces(w, pattern, verbose)
+	})
+}
+
+// QueryTablespacesContext is the same as QueryTablespaces, but with a context.
+func (d *PgDesc) QueryTablespacesContext(ctx context.Context, pattern string, verbose bool) (*Result, error) {
+	return d.withContext(ctx).QueryTablespaces(pattern, verbose)
+}
+
+// QueryTextSearchConfigs executes \dF, returning the result.
+func (d *PgDesc) QueryTextSearchConfigs(pattern string, verbose bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.TextSearchConfigs(w, pattern, verbose)
+	})
+}
+
+// QueryTextSearchConfigsContext is the same as QueryTextSearchConfigs, but with a context.
+func (d *PgDesc) QueryTextSearchConfigsContext(ctx context.Context, pattern string, verbose bool) (*Result, error) {
+	return d.withContext(ctx).QueryTextSearchConfigs(pattern, verbose)
+}
+
+// QueryTextSearchConfigsVerbose executes the lookup query for \dF+, returning the result.
+func (d *PgDesc) QueryTextSearchConfigsVerbose(pattern string) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.TextSearchConfigsVerbose(w, pattern)
+	})
+}
+
+// QueryTextSearchConfigsVerboseContext is the same as QueryTextSearchConfigsVerbose, but with a context.
+func (d *PgDesc) QueryTextSearchConfigsVerboseContext(ctx context.Context, pattern string) (*Result, error) {
+	return d.withContext(ctx).QueryTextSearchConfigsVerbose(pattern)
+}
+
+// QueryTextSearchDictionaries executes \dFd, returning the result.
+func (d *PgDesc) QueryTextSearchDictionaries(pattern string, verbose bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.TextSearchDictionaries(w, pattern, verbose)
+	})
+}
+
+// QueryTextSearchDictionariesContext is the same as QueryTextSearchDictionaries, but with a context.
+func (d *PgDesc) QueryTextSearchDictionariesContext(ctx context.Context, pattern string, verbose bool) (*Result, error) {
+	return d.withContext(ctx).QueryTextSearchDictionaries(pattern, verbose)
+}
+
+// QueryTextSearchParsers executes \dFp, returning the result.
+func (d *PgDesc) QueryTextSearchParsers(pattern string, verbose bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.TextSearchParsers(w, pattern, verbose)
+	})
+}
+
+// QueryTextSearchParsersContext is the same as QueryTextSearchParsers, but with a context.
+func (d *PgDesc) QueryTextSearchParsersContext(ctx context.Context, pattern string, verbose bool) (*Result, error) {
+	return d.withContext(ctx).QueryTextSearchParsers(pattern, verbose)
+}
+
+// QueryTextSearchParsersVerbose executes the lookup query for \dFp+, returning the result.
+func (d *PgDesc) QueryTextSearchParsersVerbose(pattern string) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.TextSearchParsersVerbose(w, pattern)
+	})
+}
+
+// QueryTextSearchParsersVerboseContext is the same as QueryTextSearchParsersVerbose, but with a context.
+func (d *PgDesc) QueryTextSearchParsersVerboseContext(ctx context.Context, pattern string) (*Result, error) {
+	return d.withContext(ctx).QueryTextSearchParsersVerbose(pattern)
+}
+
+// QueryTextSearchTemplates executes \dFt, returning the result.
+func (d *PgDesc) QueryTextSearchTemplates(pattern string, verbose bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.TextSearchTemplates(w, pattern, verbose)
+	})
+}
+
+// QueryTextSearchTemplatesContext is the same as QueryTextSearchTemplates, but with a context.
+func (d *PgDesc) QueryTextSearchTemplatesContext(ctx context.Context, pattern string, verbose bool) (*Result, error) {
+	return d.withContext(ctx).QueryTextSearchTemplates(pattern, verbose)
+}
+
+// QueryTypes executes \dT, returning the result.
+func (d *PgDesc) QueryTypes(pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.Types(w, pattern, verbose, showSystem)
+	})
+}
+
+// QueryTypesContext is the same as QueryTypes, but with a context.
+func (d *PgDesc) QueryTypesContext(ctx context.Context, pattern string, verbose, showSystem bool) (*Result, error) {
+	return d.withContext(ctx).QueryTypes(pattern, verbose, showSystem)
+}
+
+// QueryUserMappings executes \deu, returning the result.
+func (d *PgDesc) QueryUserMappings(pattern string, verbose bool) (*Result, error) {
+	return d.query(func(w io.Writer) error {
+		return d.UserMappings(w, pattern, verbose)
+	})
+}
+
+// QueryUserMappingsContext is the same as QueryUserMappings, but with a context.
+func (d *PgDesc) QueryUserMappingsContext(ctx context.Context, pattern string, verbose bool) (*Result, error) {
+	return d.withContext(ctx).QueryUserMappings(pattern, verbose)
+}
@@ -0,0 +1,27 @@
+package pgdesc
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewPgDescDB(t *testing.T) {
+	tests := []struct {
+		db  interface{}
+		exp string
+	}{
+		{nil, ErrNoDB.Error()},
+		{"not a db", "database handle string does not implement Queryer"},
+	}
+	for _, test := range tests {
+		d := NewPgDesc(test.db, 170000)
+		_, err := d.QuerySchemas("", false, false)
+		if err == nil || !strings.Contains(err.Error(), test.exp) {
+			t.Errorf("%T: expected error %q, got: %v", test.db, test.exp, err)
+		}
+	}
+	db, _ := openFakeDB(t, "textsearchconfigs")
+	if d := NewPgDesc(db, 170000); d.db != db {
+		t.Errorf("expected *sql.DB to be used as the Queryer, got: %T", d.db)
+	}
+}
@@ -0,0 +1,562 @@
+package pgdesc
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Expanded is the expanded display mode, as set by psql's \x.
+type Expanded int
+
+// Expanded display modes.
+const (
+	// ExpandedOff disables expanded display.
+	ExpandedOff Expanded = iota
+
+	// ExpandedOn prints each record as a list of column name and value
+	// pairs. The json and markdown formats are not affected.
+	ExpandedOn
+
+	// ExpandedAuto uses expanded display for the aligned and wrapped formats
+	// only when a table is wider than the output width.
+	ExpandedAuto
+)
+
+// String satisfies the fmt.Stringer interface.
+func (x Expanded) String() string {
+	switch x {
+	case ExpandedOff:
+		return "off"
+	case ExpandedOn:
+		return "on"
+	case ExpandedAuto:
+		return "auto"
+	}
+	return fmt.Sprintf("Expanded(%d)", int(x))
+}
+
+// ParseExpanded parses an expanded display mode. Similar to psql's \x,
+// "auto" and any boolean value accepted by psql (ie, "on", "off", "true",
+// "0", or an unique prefix of those) are accepted.
+func ParseExpanded(s string) (Expanded, error) {
+	if strings.EqualFold(s, "auto") {
+		return ExpandedAuto, nil
+	}
+	if b, ok := parseVariableBool(s); ok {
+		if b {
+			return ExpandedOn, nil
+		}
+		return ExpandedOff, nil
+	}
+	return ExpandedOff, fmt.Errorf("unrecognized value \"%s\" for \"%s\"\nAvailable values are: %s.\n", s, "expanded", "on, off, auto")
+}
+
+// parseVariableBool parses a boolean in the same manner as psql's
+// ParseVariableBool, accepting any unique prefix of "true", "false", "yes",
+// "no", "on" or "off", and "1" or "0".
+func parseVariableBool(s string) (bool, bool) {
+	prefix := func(word string, n int) bool {
+		return len(s) >= n && len(s) <= len(word) && strings.EqualFold(word[:len(s)], s)
+	}
+	switch {
+	case prefix("true", 1), prefix("yes", 1):
+		return true, true
+	case prefix("false", 1), prefix("no", 1):
+		return false, true
+	// 'o' is not unique enough
+	case prefix("on", 2):
+		return true, true
+	case prefix("off", 2):
+		return false, true
+	case s == "1":
+		return true, true
+	case s == "0":
+		return false, true
+	}
+	return false, false
+}
+
+// WithExpanded is a postgres description option to set the expanded display
+// mode used by the Print methods.
+func WithExpanded(expanded Expanded) Option {
+	return func(d *PgDesc) {
+		setPrintOptions(d, func(opts *PrintOptions) {
+			opts.Expanded = expanded
+		})
+	}
+}
+
+// WithColumns is a postgres description option to set the output width used
+// by the wrapped format and by the auto expanded display mode. When not set,
+// the width of the terminal is used.
+func WithColumns(columns int) Option {
+	return func(d *PgDesc) {
+		setPrintOptions(d, func(opts *PrintOptions) {
+			opts.Columns = columns
+		})
+	}
+}
+
+// setPrintOptions applies f to the description's print options, replacing
+// any printer that is not a PrintOptions.
+func setPrintOptions(d *PgDesc, f func(*PrintOptions)) {
+	opts, _ := d.printer.(PrintOptions)
+	f(&opts)
+	d.printer = opts
+}
+
+// outputColumns returns the output width for w, similar to psql. When w is
+// a terminal, the COLUMNS environment variable is used when set, otherwise
+// the terminal's width. Returns 0 when w is not a terminal.
+func outputColumns(w io.Writer) int {
+	width, ok := termWidth(w)
+	if !ok {
+		return 0
+	}
+	if n, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && n > 0 {
+		return n
+	}
+	return width
+}
+
+// cell returns the value of column i in row, or the empty string.
+func cell(row []string, i int) string {
+	if i < len(row) {
+		return row[i]
+	}
+	return ""
+}
+
+// printAlignedVertical writes the table to w in psql's expanded aligned
+// format (with border 1). When wrapped is true, and columns is greater than
+// 0, the values are wrapped to fit the output width.
+func printAlignedVertical(w io.Writer, t *Table, wrapped bool, columns int) error {
+	bw := bufio.NewWriter(w)
+
+	if len(t.Rows) == 0 {
+		for _, f := range t.footers() {
+			fmt.Fprintf(bw, "%s\n", f)
+		}
+		bw.WriteString("\n")
+		return bw.Flush()
+	}
+
+	// find the maximum dimensions for the headers and data
+	var hwidth, dwidth int
+	var dmultiline bool
+	headers := make([][]string, len(t.Headers))
+	for i, h := range t.Headers {
+		headers[i] = formatLines(h)
+		for _, l := range headers[i] {
+			if n := displayWidth(l); n > hwidth {
+				hwidth = n
+			}
+		}
+	}
+	lines := make([][][]string, len(t.Rows))
+	for i, row := range t.Rows {
+		lines[i] = make([][]string, len(t.Headers))
+		for j := range t.Headers {
+			lines[i][j] = formatLines(cell(row, j))
+			if len(lines[i][j]) > 1 {
+				dmultiline = true
+			}
+			for _, l := range lines[i][j] {
+				if n := displayWidth(l); n > dwidth {
+					dwidth = n
+				}
+			}
+		}
+	}
+
+	// print title
+	if t.Title != "" {
+		fmt.Fprintf(bw, "%s\n", t.Title)
+	}
+
+	// calculate available width for data in wrapped mode
+	if wrapped {
+		// " | " between the header and data
+		swidth := 3
+		// reserve a column for data newline indicators, too, if needed
+		if dmultiline {
+			swidth++
+		}
+		// determine width required for record header lines
+		rwidth := len(strconv.Itoa(len(t.Rows))) + 12 // "-[ RECORD  ]"
+		var newdwidth int
+		for {
+			// total width required to not wrap data, and not the header
+			// lines, either
+			width := hwidth + swidth + dwidth
+			if width < rwidth {
+				width = rwidth
+			}
+			if columns > 0 {
+				// minimum acceptable width: room for just 3 columns of data,
+				// but not less than what the record header lines need
+				minWidth := hwidth + swidth + 3
+				if minWidth < rwidth {
+					minWidth = rwidth
+				}
+				switch {
+				case columns >= width:
+					// plenty of room, use native data width
+					newdwidth = width - hwidth - swidth
+				case columns < minWidth:
+					// set data width to match min width
+					newdwidth = minWidth - hwidth - swidth
+				default:
+					// set data width to match output columns
+					newdwidth = columns - hwidth - swidth
+				}
+			} else {
+				// don't know the wrap limit, so use native data width
+				newdwidth = width - hwidth - swidth
+			}
+			// if we will need to wrap data and didn't already allow a column
+			// for wrap indicators, we must do so
+			if newdwidth < dwidth && !dmultiline {
+				dmultiline = true
+				swidth++
+				continue
+			}
+			break
+		}
+		dwidth = newdwidth
+	}
+
+	// print records
+	for i, row := range lines {
+		printVerticalLine(bw, i+1, hwidth, dwidth)
+		for j := range t.Headers {
+			max := 0
+			if wrapped {
+				max = dwidth
+			}
+			hlines, segs := headers[j], segments(row[j], max)
+			for l := 0; l < len(hlines) || l < len(segs); l++ {
+				// header (never wrapped so just need to deal with newlines)
+				if l < len(hlines) {
+					bw.WriteString(hlines[l])
+					bw.WriteString(spaces(hwidth - displayWidth(hlines[l])))
+					if l < len(hlines)-1 {
+						bw.WriteString("+")
+					} else {
+						bw.WriteString(" ")
+					}
+				} else {
+					bw.WriteString(spaces(hwidth + 1))
+				}
+
+				// separator
+				bw.WriteString("|")
+
+				// data
+				if l >= len(segs) {
+					// data exhausted (this can occur if header is longer than
+					// the data due to newlines in the header)
+					bw.WriteString("\n")
+					continue
+				}
+				seg := segs[l]
+				if l > 0 && segs[l-1].mark == 'w' {
+					bw.WriteString(".")
+				} else {
+					bw.WriteString(" ")
+				}
+				bw.WriteString(seg.s)
+				if seg.mark != 0 && dmultiline {
+					bw.WriteString(spaces(dwidth - seg.width))
+					if seg.mark == 'w' {
+						bw.WriteString(".")
+					} else {
+						bw.WriteString("+")
+					}
+				}
+				bw.WriteString("\n")
+			}
+		}
+	}
+
+	// print footers
+	if len(t.Footers) != 0 {
+		bw.WriteString("\n")
+		for _, f := range t.Footers {
+			fmt.Fprintf(bw, "%s\n", f)
+		}
+	}
+	bw.WriteString("\n")
+
+	return bw.Flush()
+}
+
+// printVerticalLine prints an expanded record header line, similar to psql's
+// print_aligned_vertical_line.
+func printVerticalLine(w *bufio.Writer, record, hwidth, dwidth int) {
+	s := "-[ RECORD " + strconv.Itoa(record) + " ]"
+	w.WriteString(s)
+	reclen := len(s)
+	if reclen < hwidth {
+		w.WriteString(strings.Repeat("-", hwidth-reclen))
+	}
+	reclen -= hwidth
+	for _, c := range "-+-" {
+		if reclen--; reclen < 0 {
+			w.WriteRune(c)
+		}
+	}
+	if reclen < 0 {
+		reclen = 0
+	}
+	if reclen < dwidth {
+		w.WriteString(strings.Repeat("-", dwidth-reclen))
+	}
+	w.WriteString("\n")
+}
+
+// printUnalignedVertical prints the table in psql's expanded unaligned
+// format.
+func printUnalignedVertical(w *bufio.Writer, t *Table, fieldSep, recordSep string) {
+	var needRecordSep bool
+
+	// print title
+	if t.Title != "" {
+		w.WriteString(t.Title)
+		needRecordSep = true
+	}
+
+	// print records
+	for _, row := range t.Rows {
+		for j, h := range t.Headers {
+			if needRecordSep {
+				// record separator is 2 occurrences of recordsep in this mode
+				w.WriteString(recordSep)
+				w.WriteString(recordSep)
+				needRecordSep = false
+			}
+			w.WriteString(h)
+			w.WriteString(fieldSep)
+			w.WriteString(cell(row, j))
+			if j < len(t.Headers)-1 {
+				w.WriteString(recordSep)
+			} else {
+				needRecordSep = true
+			}
+		}
+	}
+
+	// print footers
+	if len(t.Footers) != 0 {
+		w.WriteString(recordSep)
+		for _, f := range t.Footers {
+			w.WriteString(recordSep)
+			w.WriteString(f)
+		}
+	}
+
+	// the last record needs to be concluded with a newline
+	if needRecordSep {
+		w.WriteString("\n")
+	}
+}
+
+// printCSVVertical prints the table in psql's expanded csv format.
+func printCSVVertical(w *bufio.Writer, t *Table, sep byte) {
+	for _, row := range t.Rows {
+		for j, h := range t.Headers {
+			w.WriteString(csvField(h, sep))
+			w.WriteByte(sep)
+			w.WriteString(csvField(cell(row, j), sep))
+			w.WriteString("\n")
+		}
+	}
+}
+
+// printHTMLVertical prints the table in psql's expanded html format.
+func printHTMLVertical(w *bufio.Writer, t *Table) {
+	w.WriteString("<table border=\"1\">\n")
+
+	// print title
+	if t.Title != "" {
+		w.WriteString("  <caption>")
+		w.WriteString(htmlEscape(t.Title))
+		w.WriteString("</caption>\n")
+	}
+
+	// print records
+	for i, row := range t.Rows {
+		fmt.Fprintf(w, "\n  <tr><td colspan=\"2\" align=\"center\">Record %d</td></tr>\n", i+1)
+		for j, h := range t.Headers {
+			w.WriteString("  <tr valign=\"top\">\n    <th>")
+			w.WriteString(htmlEscape(h))
+			w.WriteString("</th>\n")
+			align := "left"
+			if t.align(j) == 'r' {
+				align = "right"
+			}
+			fmt.Fprintf(w, "    <td align=\"%s\">", align)
+			// is string only whitespace?
+			if s := cell(row, j); strings.Trim(s, " \t") == "" {
+				w.WriteString("&nbsp; ")
+			} else {
+				w.WriteString(htmlEscape(s))
+			}
+			w.WriteString("</td>\n  </tr>\n")
+		}
+	}
+	w.WriteString("</table>\n")
+
+	// print footers
+	if len(t.Footers) != 0 {
+		w.WriteString("<p>")
+		for _, f := range t.Footers {
+			w.WriteString(htmlEscape(f))
+			w.WriteString("<br />\n")
+		}
+		w.WriteString("</p>")
+	}
+	w.WriteString("\n")
+}
+
+// printLaTeXVertical prints the table in psql's expanded latex format. It
+// is used for both the latex and latex-longtable formats.
+func printLaTeXVertical(w *bufio.Writer, t *Table) {
+	// print title
+	if t.Title != "" {
+		w.WriteString("\\begin{center}\n")
+		w.WriteString(latexEscape(t.Title))
+		w.WriteString("\n\\end{center}\n\n")
+	}
+
+	// begin environment and set alignments and borders
+	w.WriteString("\\begin{tabular}{c|l}\n")
+
+	// print records
+	for i, row := range t.Rows {
+		fmt.Fprintf(w, "\\multicolumn{2}{c}{\\textit{Record %d}} \\\\\n", i+1)
+		w.WriteString("\\hline\n")
+		for j, h := range t.Headers {
+			w.WriteString(latexEscape(h))
+			w.WriteString(" & ")
+			w.WriteString(latexEscape(cell(row, j)))
+			w.WriteString(" \\\\\n")
+		}
+	}
+	w.WriteString("\\end{tabular}\n\n\\noindent ")
+
+	// print footers
+	for _, f := range t.Footers {
+		w.WriteString(latexEscape(f))
+		w.WriteString(" \\\\\n")
+	}
+	w.WriteString("\n")
+}
+
+// printTroffMSVertical prints the table in psql's expanded troff-ms format.
+func printTroffMSVertical(w *bufio.Writer, t *Table) {
+	escape := func(s string) string {
+		return strings.Replace(s, "\\", "\\(rs", -1)
+	}
+
+	// print title
+	if t.Title != "" {
+		w.WriteString(".LP\n.DS C\n")
+		w.WriteString(escape(t.Title))
+		w.WriteString("\n.DE\n")
+	}
+
+	// begin environment and set alignments and borders
+	w.WriteString(".LP\n.TS\n")
+	w.WriteString("center;\n")
+
+	// print records
+	var currentFormat int
+	for i, row := range t.Rows {
+		if currentFormat != 1 {
+			if currentFormat != 0 {
+				w.WriteString(".T&\n")
+			}
+			w.WriteString("c s.\n")
+			currentFormat = 1
+		}
+		fmt.Fprintf(w, "\\fIRecord %d\\fP\n", i+1)
+		w.WriteString("_\n")
+		for j, h := range t.Headers {
+			if currentFormat != 2 {
+				w.WriteString(".T&\n")
+				w.WriteString("c | l.\n")
+				currentFormat = 2
+			}
+			w.WriteString(escape(h))
+			w.WriteString("\t")
+			w.WriteString(escape(cell(row, j)))
+			w.WriteString("\n")
+		}
+	}
+	w.WriteString(".TE\n.DS L\n")
+
+	// print footers
+	for _, f := range t.Footers {
+		w.WriteString(escape(f))
+		w.WriteString("\n")
+	}
+	w.WriteString(".DE\n")
+}
+
+// printAsciidocVertical prints the table in psql's expanded asciidoc format.
+func printAsciidocVertical(w *bufio.Writer, t *Table) {
+	escape := func(s string) string {
+		return strings.Replace(s, "|", "\\|", -1)
+	}
+
+	// print table in new paragraph - enforce preliminary new line
+	w.WriteString("\n")
+
+	// print title
+	if t.Title != "" {
+		w.WriteString(".")
+		w.WriteString(t.Title)
+		w.WriteString("\n")
+	}
+
+	// print table [] header definition
+	w.WriteString("[cols=\"h,l\",frame=\"none\"]\n")
+	w.WriteString("|====\n")
+
+	// print records
+	for i, row := range t.Rows {
+		fmt.Fprintf(w, "2+^|Record %d\n", i+1)
+		for j, h := range t.Headers {
+			w.WriteString("<l|")
+			w.WriteString(escape(h))
+			if t.align(j) == 'r' {
+				w.WriteString(" >l|")
+			} else {
+				w.WriteString(" <l|")
+			}
+			// is string only whitespace?
+			if s := cell(row, j); strings.Trim(s, " \t") == "" {
+				w.WriteString(" ")
+			} else {
+				w.WriteString(escape(s))
+			}
+			w.WriteString("\n")
+		}
+	}
+	w.WriteString("|====\n")
+
+	// print footers
+	if len(t.Footers) != 0 {
+		w.WriteString("\n....\n")
+		for _, f := range t.Footers {
+			w.WriteString(f)
+			w.WriteString("\n")
+		}
+		w.WriteString("....\n")
+	}
+}
@@ -0,0 +1,85 @@
+package pgdesc
+
+import (
+	"testing"
+)
+
+func TestPrintExpanded(t *testing.T) {
+	tests := []printTest{
+		{"expanded_objects", "objects", PrintOptions{Expanded: ExpandedOn}},
+		{"expanded_one", "one", PrintOptions{Expanded: ExpandedOn}},
+		{"expanded_empty", "empty", PrintOptions{Expanded: ExpandedOn}},
+		{"expanded_headers", "headers", PrintOptions{Expanded: ExpandedOn}},
+		{"expanded_footers", "footers", PrintOptions{Expanded: ExpandedOn}},
+		{"expanded_unicode", "unicode", PrintOptions{Expanded: ExpandedOn}},
+		{"expanded_noexpanded", "noexpanded", PrintOptions{Expanded: ExpandedOn}},
+		// the aligned format ignores the output width
+		{"expanded_wrap", "wrap", PrintOptions{Expanded: ExpandedOn, Columns: 20}},
+		{"expanded_wrapped_wrap_30", "wrap", PrintOptions{Format: FormatWrapped, Expanded: ExpandedOn, Columns: 30}},
+		{"expanded_wrapped_wrap_20", "wrap", PrintOptions{Format: FormatWrapped, Expanded: ExpandedOn, Columns: 20}},
+		// narrower than the record header lines
+		{"expanded_wrapped_wrap_10", "wrap", PrintOptions{Format: FormatWrapped, Expanded: ExpandedOn, Columns: 10}},
+		{"expanded_wrapped_headers_12", "headers", PrintOptions{Format: FormatWrapped, Expanded: ExpandedOn, Columns: 12}},
+		// auto switches to expanded only when the table is too wide
+		{"auto_wide_80", "wide", PrintOptions{Expanded: ExpandedAuto, Columns: 80}},
+		{"auto_wide_40", "wide", PrintOptions{Expanded: ExpandedAuto, Columns: 40}},
+		{"auto_wrapped_wide_40", "wide", PrintOptions{Format: FormatWrapped, Expanded: ExpandedAuto, Columns: 40}},
+		{"auto_wrapped_wrap_30", "wrap", PrintOptions{Format: FormatWrapped, Expanded: ExpandedAuto, Columns: 30}},
+		// narrower than the headers
+		{"auto_objects_20", "objects", PrintOptions{Expanded: ExpandedAuto, Columns: 20}},
+		// a single column is never expanded
+		{"auto_single_10", "single", PrintOptions{Expanded: ExpandedAuto, Columns: 10}},
+		{"auto_noexpanded_20", "noexpanded", PrintOptions{Expanded: ExpandedAuto, Columns: 20}},
+		// output width is unknown
+		{"auto_wide", "wide", PrintOptions{Expanded: ExpandedAuto}},
+	}
+	for _, format := range formats {
+		switch format {
+		case FormatAligned, FormatWrapped:
+			continue
+		}
+		for _, table := range []string{"objects", "empty", "footers"} {
+			tests = append(tests, printTest{"expanded_" + string(format) + "_" + table, table, PrintOptions{Format: format, Expanded: ExpandedOn}})
+		}
+	}
+	testPrint(t, tests)
+}
+
+func TestParseExpanded(t *testing.T) {
+	tests := []struct {
+		s   string
+		exp Expanded
+		err bool
+	}{
+		{"on", ExpandedOn, false},
+		{"ON", ExpandedOn, false},
+		{"off", ExpandedOff, false},
+		{"of", ExpandedOff, false},
+		{"auto", ExpandedAuto, false},
+		{"Auto", ExpandedAuto, false},
+		{"t", ExpandedOn, false},
+		{"true", ExpandedOn, false},
+		{"y", ExpandedOn, false},
+		{"yes", ExpandedOn, false},
+		{"1", ExpandedOn, false},
+		{"f", ExpandedOff, false},
+		{"no", ExpandedOff, false},
+		{"0", ExpandedOff, false},
+		{"o", ExpandedOff, true},
+		{"", ExpandedOff, true},
+		{"au", ExpandedOff, true},
+		{"yess", ExpandedOff, true},
+		{"2", ExpandedOff, true},
+	}
+	for _, test := range tests {
+		x, err := ParseExpanded(test.s)
+		switch {
+		case test.err && err == nil:
+			t.Errorf("ParseExpanded(%q) expected error, got: %v", test.s, x)
+		case !test.err && err != nil:
+			t.Errorf("ParseExpanded(%q) expected no error, got: %v", test.s, err)
+		case x != test.exp:
+			t.Errorf("ParseExpanded(%q) expected %v, got: %v", test.s, test.exp, x)
+		}
+	}
+}
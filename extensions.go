@@ -0,0 +1,111 @@
+package pgdesc
+
+// Manually translated describe.c funcs for \dx+, which describes each
+// matching extension with a separate query. gen.go skips these funcs (see
+// manualFuncs), so that regenerating does not duplicate them.
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// ExtensionContents handles \dx+.
+//
+// Manually translated from listExtensionContents in psql's describe.c.
+//
+// \dx+
+//
+// List contents of installed extensions.
+func (d *PgDesc) ExtensionContents(w io.Writer, pattern string) error {
+	var err error
+
+	var res *Result
+	var i int
+
+	if d.version < 90100 {
+		return fmt.Errorf("The server (version %s) does not support extensions.\n",
+			d.sversion)
+	}
+
+	fmt.Fprintf(w,
+		"SELECT e.extname, e.oid\n"+
+			"FROM pg_catalog.pg_extension e\n")
+
+	if err := d.validateSQLNamePattern(w, pattern,
+		false, false,
+		NULL, "e.extname", NULL,
+		NULL, nil, 1); err != nil {
+		return err
+	}
+
+	fmt.Fprint(w, "ORDER BY 1;")
+
+	res, err = d.psqlExec(w)
+	if res == nil {
+		return err
+	}
+
+	if res.Len() == 0 {
+		if pattern != NULL {
+			return fmt.Errorf("Did not find any extension named \"%s\".\n",
+				pattern)
+		} else {
+			return fmt.Errorf("Did not find any extensions.\n")
+		}
+	}
+
+	for i = 0; i < res.Len(); i++ {
+		var extname string
+		var oid string
+
+		extname = res.Value(i, 0)
+		oid = res.Value(i, 1)
+
+		if err := d.OneExtensionContents(w, extname, oid); err != nil {
+			return err
+		}
+
+		if err := d.context().Err(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OneExtensionContents lists the objects in one extension, for \dx+.
+//
+// Manually translated from listOneExtensionContents in psql's describe.c.
+func (d *PgDesc) OneExtensionContents(w io.Writer, extname string, oid string) error {
+	var err error
+
+	var res *Result
+	title := new(bytes.Buffer)
+	myopt := d.popt()
+
+	fmt.Fprintf(w,
+		"SELECT pg_catalog.pg_describe_object(classid, objid, 0) AS \"%s\"\n"+
+			"FROM pg_catalog.pg_depend\n"+
+			"WHERE refclassid = 'pg_catalog.pg_extension'::pg_catalog.regclass AND refobjid = %s AND deptype = 'e'\n"+
+			"ORDER BY 1;",
+		GettextNoop("Object description"),
+		literal(w, oid))
+
+	res, err = d.psqlExec(w)
+	if res == nil {
+		return err
+	}
+
+	myopt.nullPrint = NULL
+	title.Reset()
+	fmt.Fprint(title, Gettext("Objects in extension \"%s\"", extname))
+	myopt.title = title.String()
+	myopt.translateHeader = true
+
+	if err = d.psqlPrintQuery(w, res, &myopt); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,556 @@
+package pgdesc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeDB is a database/sql driver connector that answers queries with canned
+// result sets, recording each query executed.
+//
+// Result sets are loaded from testdata/fakedb/<name>.txt, where each result
+// set is a block of lines separated by blank lines:
+//
+//	-- name: columns
+//	-- match: FROM pg_catalog.pg_attribute a
+//	-- arg: 16385
+//	attname|format_type:TEXT
+//	id|integer
+//	name|\N
+//
+// A query is answered by the first result set whose match lines are all
+// contained in the query, and whose arg lines are all equal to one of the
+// query's arguments. The first other line holds the column names, optionally
+// followed by ':' and the column's type name, and the remaining lines the rows,
+// with \N for NULL.
+type fakeDB struct {
+	results []*fakeResult
+
+	mu      sync.Mutex
+	queries []fakeQuery
+}
+
+// fakeResult is a canned result set.
+type fakeResult struct {
+	name    string
+	match   []string
+	args    []string
+	columns []string
+	types   []string
+	rows    [][]driver.Value
+}
+
+// fakeQuery is a query executed against a fakeDB.
+type fakeQuery struct {
+	// name is the name of the result set answering the query.
+	name  string
+	query string
+	args  []interface{}
+}
+
+// openFakeDB loads the named result sets, returning a database handle that
+// answers queries using them.
+func openFakeDB(t *testing.T, name string) (*sql.DB, *fakeDB) {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", "fakedb", name+".txt"))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	defer f.Close()
+	fdb, err := parseFakeResults(f)
+	if err != nil {
+		t.Fatalf("%s: expected no error, got: %v", name, err)
+	}
+	db := sql.OpenDB(fdb)
+	t.Cleanup(func() { db.Close() })
+	return db, fdb
+}
+
+// parseFakeResults parses the result sets read from r.
+func parseFakeResults(r io.Reader) (*fakeDB, error) {
+	fdb := new(fakeDB)
+	var res *fakeResult
+	s := bufio.NewScanner(r)
+	for i := 1; s.Scan(); i++ {
+		line := s.Text()
+		switch {
+		case strings.TrimSpace(line) == "":
+			res = nil
+			continue
+		case res == nil:
+			res = new(fakeResult)
+			fdb.results = append(fdb.results, res)
+		}
+		switch {
+		case strings.HasPrefix(line, "-- name: "):
+			res.name = strings.TrimPrefix(line, "-- name: ")
+		case strings.HasPrefix(line, "-- match: "):
+			res.match = append(res.match, strings.TrimPrefix(line, "-- match: "))
+		case strings.HasPrefix(line, "-- arg: "):
+			res.args = append(res.args, strings.TrimPrefix(line, "-- arg: "))
+		case strings.HasPrefix(line, "--"):
+			// comment
+		case res.columns == nil:
+			for _, col := range strings.Split(line, "|") {
+				name, typ, _ := strings.Cut(col, ":")
+				res.columns, res.types = append(res.columns, name), append(res.types, typ)
+			}
+		default:
+			fields := strings.Split(line, "|")
+			if len(fields) != len(res.columns) {
+				return nil, fmt.Errorf("line %d: expected %d values, got: %d", i, len(res.columns), len(fields))
+			}
+			row := make([]driver.Value, len(fields))
+			for j, v := range fields {
+				if v != `\N` {
+					row[j] = v
+				}
+			}
+			res.rows = append(res.rows, row)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	// drop comment only blocks
+	results := fdb.results[:0]
+	for _, res := range fdb.results {
+		switch {
+		case res.name == "" && res.match == nil && res.columns == nil:
+			continue
+		case res.name == "" || res.match == nil || res.columns == nil:
+			return nil, fmt.Errorf("result set %q: expected name, match and column lines", res.name)
+		}
+		results = append(results, res)
+	}
+	fdb.results = results
+	return fdb, nil
+}
+
+// names returns the names of the result sets answering the executed queries,
+// in order.
+func (fdb *fakeDB) names() []string {
+	fdb.mu.Lock()
+	defer fdb.mu.Unlock()
+	names := make([]string, len(fdb.queries))
+	for i, q := range fdb.queries {
+		names[i] = q.name
+	}
+	return names
+}
+
+// query answers the query, recording it.
+func (fdb *fakeDB) query(query string, args []driver.NamedValue) (*fakeResult, error) {
+	fdb.mu.Lock()
+	defer fdb.mu.Unlock()
+	q := fakeQuery{query: query}
+	for _, arg := range args {
+		q.args = append(q.args, arg.Value)
+	}
+	for _, res := range fdb.results {
+		if res.matches(q) {
+			q.name = res.name
+			fdb.queries = append(fdb.queries, q)
+			return res, nil
+		}
+	}
+	fdb.queries = append(fdb.queries, q)
+	return nil, fmt.Errorf("no result set matching query %q (args: %v)", query, q.args)
+}
+
+// matches returns whether or not res answers q.
+func (res *fakeResult) matches(q fakeQuery) bool {
+	for _, s := range res.match {
+		if !strings.Contains(q.query, s) {
+			return false
+		}
+	}
+loop:
+	for _, s := range res.args {
+		for _, arg := range q.args {
+			if fmt.Sprint(arg) == s {
+				continue loop
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// Connect satisfies the driver.Connector interface.
+func (fdb *fakeDB) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{fdb: fdb}, nil
+}
+
+// Driver satisfies the driver.Connector interface.
+func (fdb *fakeDB) Driver() driver.Driver {
+	return fakeDriver{fdb: fdb}
+}
+
+// fakeDriver is the driver for a fakeDB.
+type fakeDriver struct {
+	fdb *fakeDB
+}
+
+// Open satisfies the driver.Driver interface.
+func (drv fakeDriver) Open(string) (driver.Conn, error) {
+	return &fakeConn{fdb: drv.fdb}, nil
+}
+
+// fakeConn is a connection to a fakeDB.
+type fakeConn struct {
+	fdb *fakeDB
+}
+
+// QueryContext satisfies the driver.QueryerContext interface.
+func (conn *fakeConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	res, err := conn.fdb.query(query, args)
+	if err != nil {
+		return nil, err
+	}
+	return &fakeRows{res: res}, nil
+}
+
+// Prepare satisfies the driver.Conn interface.
+func (conn *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("fakedb: prepared statements are not supported")
+}
+
+// Close satisfies the driver.Conn interface.
+func (conn *fakeConn) Close() error {
+	return nil
+}
+
+// Begin satisfies the driver.Conn interface.
+func (conn *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("fakedb: transactions are not supported")
+}
+
+// fakeRows are the rows of a canned result set.
+type fakeRows struct {
+	res *fakeResult
+	i   int
+}
+
+// Columns satisfies the driver.Rows interface.
+func (rows *fakeRows) Columns() []string {
+	return rows.res.columns
+}
+
+// ColumnTypeDatabaseTypeName satisfies the driver.RowsColumnTypeDatabaseTypeName
+// interface.
+func (rows *fakeRows) ColumnTypeDatabaseTypeName(i int) string {
+	return rows.res.types[i]
+}
+
+// Close satisfies the driver.Rows interface.
+func (rows *fakeRows) Close() error {
+	return nil
+}
+
+// Next satisfies the driver.Rows interface.
+func (rows *fakeRows) Next(dest []driver.Value) error {
+	if rows.i >= len(rows.res.rows) {
+		return io.EOF
+	}
+	copy(dest, rows.res.rows[rows.i])
+	rows.i++
+	return nil
+}
+
+func TestFakeDetectVersion(t *testing.T) {
+	db, fdb := openFakeDB(t, "textsearchconfigs")
+	d, err := NewPgDescFromServer(db)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if d.version != 170002 || d.sversion != "17" {
+		t.Errorf("expected version 170002 (17), got: %d (%s)", d.version, d.sversion)
+	}
+	if names, exp := fdb.names(), []string{"version"}; !reflect.DeepEqual(names, exp) {
+		t.Errorf("expected queries %q, got: %q", exp, names)
+	}
+}
+
+func TestFakeTableDetails(t *testing.T) {
+	db, fdb := openFakeDB(t, "tabledetails")
+	d := NewPgDesc(db, 170000)
+	tables, err := d.GetTableDetails("pgdesc_t", false, false)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	exp := []string{
+		"lookup",
+		"tableinfo",
+		"columns",
+		"partition",
+		"indexes",
+		"checks",
+		"policies",
+		"statistics",
+		"publications",
+		"parents",
+		"children",
+	}
+	if names := fdb.names(); !reflect.DeepEqual(names, exp) {
+		t.Errorf("expected queries %q, got: %q", exp, names)
+	}
+	if len(tables) != 1 {
+		t.Fatalf("expected 1 table, got: %d", len(tables))
+	}
+	if s, exp := tables[0].Title, `Table "public.pgdesc_t"`; s != exp {
+		t.Errorf("expected title %q, got: %q", exp, s)
+	}
+	footers := []string{
+		"Indexes:",
+		`    "pgdesc_i" btree (name)`,
+		"Check constraints:",
+		`    "pgdesc_t_id_check" CHECK (id > 0)`,
+		"Statistics objects:",
+		`    "public.pgdesc_stat" ON id, name FROM pgdesc_t`,
+		"Publications:",
+		`    "pgdesc_pub"`,
+	}
+	if !reflect.DeepEqual(tables[0].Footers, footers) {
+		t.Errorf("expected footers %q, got: %q", footers, tables[0].Footers)
+	}
+}
+
+func TestFakeTextSearchConfigs(t *testing.T) {
+	tests := []struct {
+		verbose bool
+		exp     []string
+	}{
+		{false, []string{"configs"}},
+		{true, []string{"configs verbose"}},
+	}
+	for _, test := range tests {
+		db, fdb := openFakeDB(t, "textsearchconfigs")
+		d := NewPgDesc(db, 170000)
+		if _, err := d.QueryTextSearchConfigs("english", test.verbose); err != nil {
+			t.Fatalf("verbose %t: expected no error, got: %v", test.verbose, err)
+		}
+		if names := fdb.names(); !reflect.DeepEqual(names, test.exp) {
+			t.Errorf("verbose %t: expected queries %q, got: %q", test.verbose, test.exp, names)
+		}
+	}
+}
+
+func TestFakeNilContext(t *testing.T) {
+	db, fdb := openFakeDB(t, "textsearchconfigs")
+	d := NewPgDesc(db, 170000)
+	if _, err := d.QueryTextSearchConfigsContext(nil, "english", false); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if names, exp := fdb.names(), []string{"configs"}; !reflect.DeepEqual(names, exp) {
+		t.Errorf("expected queries %q, got: %q", exp, names)
+	}
+}
+
+func TestFakeVerbose(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(*PgDesc, io.Writer) error
+		exp  []string
+	}{
+		{"textsearchconfigs", func(d *PgDesc, w io.Writer) error {
+			return d.PrintTextSearchConfigsVerbose(w, "english")
+		}, []string{"configs verbose", "config mappings"}},
+		{"textsearchparsers", func(d *PgDesc, w io.Writer) error {
+			return d.PrintTextSearchParsersVerbose(w, "default")
+		}, []string{"parsers verbose", "parser methods", "token types"}},
+		{"extensions", func(d *PgDesc, w io.Writer) error {
+			return d.PrintExtensionContents(w, "plpgsql")
+		}, []string{"extensions", "extension contents"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			db, fdb := openFakeDB(t, test.name)
+			d := NewPgDesc(db, 170000)
+			buf := new(bytes.Buffer)
+			if err := test.f(d, buf); err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if names := fdb.names(); !reflect.DeepEqual(names, test.exp) {
+				t.Errorf("expected queries %q, got: %q", test.exp, names)
+			}
+			name := filepath.Join("testdata", "fakedb", test.name+".out")
+			if *update {
+				if err := os.WriteFile(name, buf.Bytes(), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			exp, err := os.ReadFile(name)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v (run with -update to create)", err)
+			}
+			if !bytes.Equal(buf.Bytes(), exp) {
+				t.Errorf("output does not match %s (run with -update to refresh)\n%s", name, diffLine(exp, buf.Bytes()))
+			}
+		})
+	}
+}
+
+func TestFakePublicationDetails(t *testing.T) {
+	tests := []struct {
+		version int
+		exp     []string
+		tables  []PublicationTable
+		schemas []string
+	}{
+		{130000, []string{"publications", "tables", "tables"}, []PublicationTable{
+			{Schema: "public", Name: "pgdesc_p"},
+			{Schema: "public", Name: "pgdesc_t"},
+		}, nil},
+		{170000, []string{"publications", "tables", "schemas", "tables", "schemas"}, []PublicationTable{
+			{Schema: "public", Name: "pgdesc_p"},
+			{Schema: "public", Name: "pgdesc_t", Columns: "id, name", RowFilter: "(id > 0)"},
+		}, []string{"pgdesc_s"}},
+	}
+	for _, test := range tests {
+		t.Run(strconv.Itoa(test.version), func(t *testing.T) {
+			db, fdb := openFakeDB(t, "publications")
+			d := NewPgDesc(db, test.version)
+			v, err := d.GetPublicationDetails("pgdesc_*")
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if names := fdb.names(); !reflect.DeepEqual(names, test.exp) {
+				t.Errorf("expected queries %q, got: %q", test.exp, names)
+			}
+			if len(v) != 3 {
+				t.Fatalf("expected 3 publications, got: %d", len(v))
+			}
+			if p := v[0]; !p.AllTables || p.Tables != nil || p.Footers != nil {
+				t.Errorf("expected %s to be for all tables without footers, got: %+v", p.Name, p)
+			}
+			if p := v[1]; p.AllTables || !p.Inserts || p.Deletes || !p.Truncates || !p.ViaRoot {
+				t.Errorf("expected %s flags to be set from the result, got: %+v", p.Name, p)
+			}
+			if tables := v[1].Tables; !reflect.DeepEqual(tables, test.tables) {
+				t.Errorf("expected tables %+v, got: %+v", test.tables, tables)
+			}
+			if schemas := v[1].Schemas; !reflect.DeepEqual(schemas, test.schemas) {
+				t.Errorf("expected schemas %q, got: %q", test.schemas, schemas)
+			}
+
+			buf := new(bytes.Buffer)
+			for _, p := range v {
+				if err := d.print(buf, p.Table()); err != nil {
+					t.Fatalf("expected no error, got: %v", err)
+				}
+			}
+			name := filepath.Join("testdata", "fakedb", fmt.Sprintf("publications_%d.out", test.version))
+			if *update {
+				if err := os.WriteFile(name, buf.Bytes(), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			exp, err := os.ReadFile(name)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v (run with -update to create)", err)
+			}
+			if !bytes.Equal(buf.Bytes(), exp) {
+				t.Errorf("output does not match %s (run with -update to refresh)\n%s", name, diffLine(exp, buf.Bytes()))
+			}
+		})
+	}
+}
+
+func TestFakeUnmatched(t *testing.T) {
+	db, fdb := openFakeDB(t, "textsearchconfigs")
+	d := NewPgDesc(db, 170000)
+	if _, err := d.QueryTextSearchConfigs("german", false); err == nil {
+		t.Fatal("expected an error for a query without a result set, got nil")
+	}
+	if names, exp := fdb.names(), []string{""}; !reflect.DeepEqual(names, exp) {
+		t.Errorf("expected queries %q, got: %q", exp, names)
+	}
+}
+
+func TestFakeRoles(t *testing.T) {
+	tests := []struct {
+		version int
+		verbose bool
+		exp     string
+	}{
+		{170000, true, "List of roles\n" +
+			"Role name|Attributes|Member of|Description\n" +
+			"pgdesc_g|Create DB, Cannot login\n1 connection|{}|a group\n" +
+			"pgdesc_r|No inheritance, Cannot login, Replication\n2 connections\nPassword valid until 2030-01-01 00:00:00+00|{pgdesc_g}|\n" +
+			"postgres|Superuser, Create role, Create DB, Replication, Bypass RLS|{}|\n"},
+		{90000, false, "List of roles\n" +
+			"Role name|Attributes|Member of\n" +
+			"pgdesc_r|No inheritance, Cannot login\nNo connections|{pgdesc_g}\n" +
+			"postgres|Superuser, Create role, Create DB|{}\n"},
+	}
+	for _, test := range tests {
+		t.Run(strconv.Itoa(test.version), func(t *testing.T) {
+			db, _ := openFakeDB(t, "roles")
+			d := NewPgDesc(db, test.version, WithFormat(FormatUnaligned))
+			buf := new(bytes.Buffer)
+			if err := d.PrintRoles(buf, NULL, test.verbose, false); err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if s := buf.String(); s != test.exp {
+				t.Errorf("expected:\n%s\ngot:\n%s", test.exp, s)
+			}
+		})
+	}
+}
+
+func TestFakeNotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(d *PgDesc, w io.Writer) error
+		exp  string
+	}{
+		{"tables", func(d *PgDesc, w io.Writer) error {
+			return d.PrintTables(w, "t", NULL, false, false)
+		}, "Did not find any relations.\n"},
+		{"tables pattern", func(d *PgDesc, w io.Writer) error {
+			return d.PrintTables(w, "t", "foo", false, false)
+		}, "Did not find any relation named \"foo\".\n"},
+		{"settings", func(d *PgDesc, w io.Writer) error {
+			return d.PrintDatabaseRoleSettings(w, NULL, NULL)
+		}, "Did not find any settings.\n"},
+		{"settings role", func(d *PgDesc, w io.Writer) error {
+			return d.PrintDatabaseRoleSettings(w, "bob", NULL)
+		}, "Did not find any settings for role \"bob\".\n"},
+		{"settings role database", func(d *PgDesc, w io.Writer) error {
+			return d.PrintDatabaseRoleSettings(w, "bob", "db")
+		}, "Did not find any settings for role \"bob\" and database \"db\".\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			db, _ := openFakeDB(t, "roles")
+			errw := new(bytes.Buffer)
+			d := NewPgDesc(db, 170000, WithErrorWriter(errw), WithDatabase("db"))
+			buf := new(bytes.Buffer)
+			if err := test.f(d, buf); err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if buf.Len() != 0 {
+				t.Errorf("expected no output, got: %q", buf.String())
+			}
+			if s := errw.String(); s != test.exp {
+				t.Errorf("expected message %q, got: %q", test.exp, s)
+			}
+		})
+	}
+}
@@ -2,12 +2,11 @@ package pgdesc
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"strconv"
 	"strings"
-	"unicode"
-	"unicode/utf8"
 )
 
 // processSQLNamePattern is manually translated func from the postgres source.
@@ -39,163 +38,315 @@ import (
 //
 // Formatting note: the text already present in buf should end with a newline.
 // The appended text, if any, will end with one too.
-func processSQLNamePattern(
+//
+// Unlike psql, the dbnamebuf and dotcnt outputs are not returned. Use
+// validateSQLNamePattern to check the number of dotted parts of pattern.
+func (d *PgDesc) processSQLNamePattern(
+	w io.Writer,
+	pattern string,
+	haveWhere, forceEscape bool,
+	schemavar, namevar string,
+	altnamevar, visibilityrule string,
+) (bool, error) {
+	added, _, _ := d.sqlNamePattern(w, pattern, haveWhere, forceEscape, schemavar, namevar, altnamevar, visibilityrule)
+	return added, nil
+}
+
+// validateSQLNamePattern is manually translated func from the postgres source.
+//
+// It is used by the generated code for psql's validateSQLNamePattern.
+//
+// See: postgres/src/bin/psql/describe.c
+//
+// validateSQLNamePattern
+//
+// Wrapper around processSQLNamePattern, which also checks that the pattern
+// has at most maxparts dotted parts. The number of dotted parts must be
+// checked, as the WHERE clauses generated otherwise silently ignore any
+// leading parts. When the pattern has exactly maxparts dotted parts, the
+// leading part must be the name of the current database.
+//
+// addedClause, when not nil, is set to whether any clause was added.
+func (d *PgDesc) validateSQLNamePattern(
+	w io.Writer,
+	pattern string,
+	haveWhere, forceEscape bool,
+	schemavar, namevar string,
+	altnamevar, visibilityrule string,
+	addedClause *bool,
+	maxparts int,
+) error {
+	added, dbnamebuf, dotcnt := d.sqlNamePattern(w, pattern, haveWhere, forceEscape, schemavar, namevar, altnamevar, visibilityrule)
+	if addedClause != nil {
+		*addedClause = added
+	}
+	if dotcnt >= maxparts {
+		return fmt.Errorf("improper qualified name (too many dotted names): %s", pattern)
+	}
+	if maxparts > 1 && dotcnt == maxparts-1 {
+		dbname, err := d.currentDatabase()
+		switch {
+		case err != nil:
+			return err
+		case dbname == "":
+			return errors.New("You are currently not connected to a database.")
+		case dbname != dbnamebuf:
+			return fmt.Errorf("cross-database references are not implemented: %s", pattern)
+		}
+	}
+	return nil
+}
+
+// sqlNamePattern generates the WHERE clauses for pattern, as psql's
+// processSQLNamePattern, returning whether any clause was added, the
+// database name portion of pattern, and the number of dotted parts parsed
+// from pattern.
+//
+// The database name portion is only split from pattern when schemavar is not
+// empty, and is otherwise always empty.
+func (d *PgDesc) sqlNamePattern(
 	w io.Writer,
 	pattern string,
 	haveWhere, forceEscape bool,
 	schemavar, namevar string,
 	altnamevar, visibilityrule string,
-) bool {
+) (bool, string, int) {
 	var addedClause bool
 
+	// WHEREAND
+	whereAnd := func() {
+		if haveWhere {
+			fmt.Fprint(w, "  AND ")
+		} else {
+			fmt.Fprint(w, "WHERE ")
+		}
+		haveWhere, addedClause = true, true
+	}
+
 	if pattern == "" {
 		// Default: select all visible objects
 		if visibilityrule != "" {
-			// WHEREAND
-			if haveWhere {
-				fmt.Fprint(w, "  AND ")
-			} else {
-				fmt.Fprint(w, "WHERE ")
-			}
-			haveWhere, addedClause = true, true
-			// END WHEREAND
-
+			whereAnd()
 			fmt.Fprintf(w, "%s\n", visibilityrule)
 		}
-		return addedClause
+		return addedClause, "", 0
 	}
 
-	schemabuf, namebuf := new(bytes.Buffer), new(bytes.Buffer)
-
-	fmt.Fprint(namebuf, "^(")
-
-	parsePattern(schemabuf, namebuf, pattern, forceEscape)
+	/*
+	 * Convert shell-style 'pattern' into the regular expression(s) we want to
+	 * execute.  Quoting/escaping into SQL literal format will be done below
+	 * using appendStringLiteralConn().
+	 *
+	 * If the caller provided a schemavar, we want to split the pattern on
+	 * ".", otherwise not.
+	 */
+	var dbnamebuf, schemabuf *bytes.Buffer
+	namebuf := new(bytes.Buffer)
+	if schemavar != "" {
+		dbnamebuf, schemabuf = new(bytes.Buffer), new(bytes.Buffer)
+	}
+	dotcnt := parsePattern(dbnamebuf, schemabuf, namebuf, pattern, forceEscape, true)
 
 	/*
 	 * Now decide what we need to emit.  We may run under a hostile
 	 * search_path, so qualify EVERY name.  Note there will be a leading "^("
 	 * in the patterns in any case.
+	 *
+	 * We want the regex matches to use the database's default collation where
+	 * collation-sensitive behavior is required (for example, which characters
+	 * match '\w').  That happened by default before PG v12, but if the server
+	 * is >= v12 then we need to force it through explicit COLLATE clauses,
+	 * otherwise the "C" collation attached to "name" catalog columns wins.
 	 */
-	if namebuf.Len() > 2 {
+	var collate string
+	if d.version >= 120000 {
+		collate = " COLLATE pg_catalog.default"
+	}
+	if namevar != "" && namebuf.Len() > 2 {
 		/* We have a name pattern, so constrain the namevar(s) */
-		fmt.Fprint(namebuf, ")$")
+
 		/* Optimize away a "*" pattern */
 		if namebuf.String() != "^(.*)$" {
-			// WHEREAND
-			if haveWhere {
-				fmt.Fprint(w, "  AND ")
-			} else {
-				fmt.Fprint(w, "WHERE ")
-			}
-			haveWhere, addedClause = true, true
-			// END WHEREAND
-
+			whereAnd()
 			if altnamevar != "" {
 				fmt.Fprintf(w, "(%s OPERATOR(pg_catalog.~) ", namevar)
-				fmt.Fprint(w, stringLiteral(namebuf.String()))
+				fmt.Fprint(w, literal(w, namebuf.String())+collate)
 				fmt.Fprintf(w, "\n        OR %s OPERATOR(pg_catalog.~) ", altnamevar)
-				fmt.Fprint(w, stringLiteral(namebuf.String()))
+				fmt.Fprint(w, literal(w, namebuf.String())+collate)
 				fmt.Fprint(w, ")\n")
 			} else {
 				fmt.Fprintf(w, "%s OPERATOR(pg_catalog.~) ", namevar)
-				fmt.Fprint(w, stringLiteral(namebuf.String()))
+				fmt.Fprint(w, literal(w, namebuf.String())+collate)
 				fmt.Fprint(w, "\n")
 			}
 		}
 	}
 
-	if schemabuf.Len() > 2 {
+	if schemavar != "" && schemabuf.Len() > 2 {
 		/* We have a schema pattern, so constrain the schemavar */
-		fmt.Fprint(schemabuf, ")$")
-		/* Optimize away a "*" pattern */
-		if schemabuf.String() != "^(.*)$" && schemavar != "" {
-			// WHEREAND
-			if haveWhere {
-				fmt.Fprint(w, "  AND ")
-			} else {
-				fmt.Fprint(w, "WHERE ")
-			}
-			haveWhere, addedClause = true, true
-			// END WHEREAND
 
+		/* Optimize away a "*" pattern */
+		if schemabuf.String() != "^(.*)$" {
+			whereAnd()
 			fmt.Fprintf(w, "%s OPERATOR(pg_catalog.~) ", schemavar)
-			fmt.Fprint(w, stringLiteral(schemabuf.String()))
+			fmt.Fprint(w, literal(w, schemabuf.String())+collate)
 			fmt.Fprint(w, "\n")
 		}
 	} else {
 		/* No schema pattern given, so select only visible objects */
 		if visibilityrule != "" {
-			// WHEREAND
-			if haveWhere {
-				fmt.Fprint(w, "  AND ")
-			} else {
-				fmt.Fprint(w, "WHERE ")
-			}
-			haveWhere, addedClause = true, true
-			// END WHEREAND
-
+			whereAnd()
 			fmt.Fprintf(w, "%s\n", visibilityrule)
 		}
 	}
 
-	return addedClause
+	var dbname string
+	if dbnamebuf != nil {
+		dbname = dbnamebuf.String()
+	}
+	return addedClause, dbname, dotcnt
+}
+
+// currentDatabase returns the name of the current database, similar to
+// libpq's PQdb.
+//
+// The name set by WithDatabase is used, when set. Otherwise, the current
+// database is queried using the database handle, if any.
+func (d *PgDesc) currentDatabase() (string, error) {
+	if d.dbname != "" || d.db == nil {
+		return d.dbname, nil
+	}
+	res, err := d.exec("SELECT pg_catalog.current_database()")
+	if err != nil {
+		return "", err
+	}
+	if res.Len() == 0 {
+		return "", nil
+	}
+	return res.Value(0, 0), nil
 }
 
-// parsePattern parses the passed pattern to namebuf, schemabuf.
+// parsePattern is manually translated patternToSQLRegex from the postgres
+// source.
 //
-// This is a manual rewrite of a section of processSQLNamePattern.
+// It parses the pattern to the database, schema, and name regexps, returning
+// the number of dots in the pattern (outside of double quotes).
 //
 // See: postgres/src/fe_utils/string_utils.c
 //
-// Parse the pattern, converting quotes and lower-casing unquoted letters.
-// Also, adjust shell-style wildcard characters into regexp notation.
+// patternToSQLRegex
+//
+// Transform a possibly qualified shell-style object name pattern into up to
+// three SQL-style regular expressions, converting quotes, lower-casing
+// unquoted letters, and adjusting shell-style wildcard characters into regexp
+// notation.
 //
-// We surround the pattern with "^(...)$" to force it to match the whole
-// string, as per SQL practice.  We have to have parens in case the string
-// contains "|", else the "^" and "$" will be bound into the first and
-// last alternatives which is not what we want.
+// If the dbnamebuf and schemabuf arguments are non-NULL, and the pattern
+// contains two or more dbname/schema/name separators, we parse the portions of
+// the pattern prior to the first and second separators into dbnamebuf and
+// schemabuf, and the rest into namebuf.
 //
-// Note: the result of this pass is the actual regexp pattern(s) we want
-// to execute.  Quoting/escaping into SQL literal format will be done
-// below using appendStringLiteralConn().
-func parsePattern(schemabuf, namebuf *bytes.Buffer, pattern string, forceEscape bool) {
+// If dbnamebuf is NULL and schemabuf is non-NULL, and the pattern contains at
+// least one separator, we parse the first portion into schemabuf and the rest
+// into namebuf.
+//
+// Otherwise, we parse all the pattern into namebuf.
+//
+// If the pattern contains more dotted parts than buffers to parse into, the
+// extra dots will be treated as literal characters and written into the
+// namebuf, though they will be counted.  Callers should always check the value
+// returned by reference in dotcnt and handle this error case appropriately.
+//
+// We surround the regexps with "^(...)$" to force them to match whole strings,
+// as per SQL practice.  We have to have parens in case strings contain "|",
+// else the "^" and "$" will be bound into the first and last alternatives
+// which is not what we want.  Whether this is done for dbnamebuf is controlled
+// by the want_literal_dbname parameter.
+//
+// The regexps we parse into the buffers are appended to the data (if any)
+// already present.  If we parse fewer fields than the number of buffers we
+// were given, the extra buffers are unaltered.
+//
+// encoding: the character encoding for the given pattern
+// dbnamebuf: output parameter receiving the database name portion of the
+// pattern, if any.  Can be NULL.
+// schemabuf: output parameter receiving the schema name portion of the
+// pattern, if any.  Can be NULL.
+// namebuf: output parameter receiving the database name portion of the
+// pattern, if any.  Can be NULL.
+// pattern: user-specified pattern option, or NULL if none ("*" is implied).
+// force_escape: always quote regexp special characters, even outside
+// double quotes (else they are quoted only between double quotes).
+// want_literal_dbname: if true, regexp special characters within the database
+// name portion of the pattern will not be escaped, nor will the dbname be
+// converted into a regular expression.
+// dotcnt: output parameter receiving the number of separators parsed from the
+// pattern.
+func parsePattern(dbnamebuf, schemabuf, namebuf *bytes.Buffer, pattern string, forceEscape, wantLiteralDbname bool) int {
+	var bufs [3]*bytes.Buffer
+	var leftLiteral bytes.Buffer
+	var dotcnt int
 	var inquotes bool
 
-	var i int
-	for ; i < len(pattern); i++ {
-		var ch, next byte = pattern[i], 0
-		if i < len(pattern)-1 {
-			next = pattern[i+1]
-		}
+	maxbuf := 0
+	if dbnamebuf != nil {
+		maxbuf = 2
+	} else if schemabuf != nil {
+		maxbuf = 1
+	}
+
+	curbuf := 0
+	left := wantLiteralDbname
+	bufs[curbuf] = new(bytes.Buffer)
+	bufs[curbuf].WriteString("^(")
+	for i := 0; i < len(pattern); {
+		ch := pattern[i]
 		switch {
 		case ch == '"':
-			if inquotes && next == '"' {
+			if inquotes && i+1 < len(pattern) && pattern[i+1] == '"' {
 				/* emit one quote, stay in inquotes mode */
-				fmt.Fprint(namebuf, '"')
+				bufs[curbuf].WriteByte('"')
+				if left {
+					leftLiteral.WriteByte('"')
+				}
 				i++
 			} else {
 				inquotes = !inquotes
 			}
 			i++
 
-		case !inquotes && unicode.IsUpper(rune(ch)):
-			fmt.Fprint(namebuf, strings.ToLower(string(ch)))
+		case !inquotes && 'A' <= ch && ch <= 'Z':
+			bufs[curbuf].WriteByte(ch + 'a' - 'A')
+			if left {
+				leftLiteral.WriteByte(ch + 'a' - 'A')
+			}
 			i++
 
 		case !inquotes && ch == '*':
-			fmt.Fprint(namebuf, ".*")
+			bufs[curbuf].WriteString(".*")
+			if left {
+				leftLiteral.WriteByte('*')
+			}
 			i++
 
 		case !inquotes && ch == '?':
-			fmt.Fprint(namebuf, ".")
+			bufs[curbuf].WriteByte('.')
+			if left {
+				leftLiteral.WriteByte('?')
+			}
 			i++
 
 		case !inquotes && ch == '.':
-			/* Found schema/name separator, move current pattern to schema */
-			schemabuf.Reset()
-			schemabuf.Write(namebuf.Bytes())
-			namebuf.Reset()
-			fmt.Fprint(namebuf, "^(")
+			left = false
+			dotcnt++
+			if curbuf < maxbuf {
+				bufs[curbuf].WriteString(")$")
+				curbuf++
+				bufs[curbuf] = new(bytes.Buffer)
+				bufs[curbuf].WriteString("^(")
+			} else {
+				bufs[curbuf].WriteByte(ch)
+			}
 			i++
 
 		case ch == '$':
@@ -206,7 +357,10 @@ func parsePattern(schemabuf, namebuf *bytes.Buffer, pattern string, forceEscape
 			 * we anchor the pattern automatically there is no use-case for
 			 * having it possess its regexp meaning.
 			 */
-			fmt.Fprint(namebuf, "\\$")
+			bufs[curbuf].WriteString("\\$")
+			if left {
+				leftLiteral.WriteByte('$')
+			}
 			i++
 
 		default:
@@ -218,15 +372,45 @@ func parsePattern(schemabuf, namebuf *bytes.Buffer, pattern string, forceEscape
 			 * regexp errors.  Outside quotes, however, let them pass through
 			 * as-is; this lets knowledgeable users build regexp expressions
 			 * that are more powerful than shell-style patterns.
+			 *
+			 * As an exception to that, though, always quote "[]", as that's
+			 * much more likely to be an attempt to write an array type name
+			 * than it is to be the start of a regexp bracket expression.
 			 */
-			if inquotes || forceEscape && strings.ContainsRune("|*+?()[]{}.^$\\", rune(ch)) {
-				fmt.Fprint(namebuf, "\\")
+			if (inquotes || forceEscape) && strings.IndexByte("|*+?()[]{}.^$\\", ch) != -1 {
+				bufs[curbuf].WriteByte('\\')
+			} else if ch == '[' && i+1 < len(pattern) && pattern[i+1] == ']' {
+				bufs[curbuf].WriteByte('\\')
+			}
+			n := pqmblenBounded(pattern[i:])
+			if left {
+				leftLiteral.WriteString(pattern[i : i+n])
 			}
-			r, n := utf8.DecodeRuneInString(pattern[i:])
-			fmt.Fprint(namebuf, string(r))
+			bufs[curbuf].WriteString(pattern[i : i+n])
 			i += n
 		}
 	}
+	bufs[curbuf].WriteString(")$")
+
+	if namebuf != nil {
+		namebuf.Write(bufs[curbuf].Bytes())
+		curbuf--
+	}
+
+	if schemabuf != nil && curbuf >= 0 {
+		schemabuf.Write(bufs[curbuf].Bytes())
+		curbuf--
+	}
+
+	if dbnamebuf != nil && curbuf >= 0 {
+		if wantLiteralDbname {
+			dbnamebuf.Write(leftLiteral.Bytes())
+		} else {
+			dbnamebuf.Write(bufs[curbuf].Bytes())
+		}
+	}
+
+	return dotcnt
 }
 
 // printACLColumn
@@ -255,6 +439,62 @@ func stringLiteral(s string) string {
 	return "E'" + strings.Replace(s[1:len(s)-1], "'", "''", -1) + "'"
 }
 
+// QueryBuffer is a query buffer that collects query parameters.
+//
+// When a describe method writes to a QueryBuffer, caller supplied values
+// (patterns, oids) are written as $1..$n placeholders and added to Args,
+// instead of being inlined as string literals.
+type QueryBuffer struct {
+	bytes.Buffer
+
+	// Args are the query parameters.
+	Args []interface{}
+}
+
+// literal returns the SQL for the string value s written to w. When w is a
+// *QueryBuffer, s is added as a parameter and its placeholder is returned,
+// otherwise s is returned as an escaped string literal.
+func literal(w io.Writer, s string) string {
+	if p, ok := w.(*psqlBuffer); ok {
+		w = &p.QueryBuffer
+	}
+	if buf, ok := w.(*QueryBuffer); ok {
+		buf.Args = append(buf.Args, s)
+		return "$" + strconv.Itoa(len(buf.Args))
+	}
+	return stringLiteral(s)
+}
+
+// fmtId quotes s as a SQL identifier.
+//
+// Unlike psql's fmtId, which consults the server's keyword list, the
+// identifier is always quoted (as when quote_all_identifiers is set).
+func fmtId(s string) string {
+	return `"` + strings.Replace(s, `"`, `""`, -1) + `"`
+}
+
+// pqmblenBounded returns the length of the UTF-8 character at the start of s,
+// similar to libpq's PQmblenBounded.
+//
+// The length is determined by the first byte only, so that invalid multibyte
+// sequences are consumed in the same manner as psql.
+func pqmblenBounded(s string) int {
+	var n int
+	switch c := s[0]; {
+	case c&0x80 == 0:
+		n = 1
+	case c&0xe0 == 0xc0:
+		n = 2
+	case c&0xf0 == 0xe0:
+		n = 3
+	case c&0xf8 == 0xf0:
+		n = 4
+	default:
+		n = 1
+	}
+	return min(n, len(s))
+}
+
 // strchr is a pseudo implementation of strchr.
 func strchr(s string, r rune) string {
 	if v := string(r); strings.Contains(s, v) {
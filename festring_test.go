@@ -0,0 +1,278 @@
+package pgdesc
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestParsePattern checks parsePattern against the regexps generated by
+// psql's patternToSQLRegex, both for a database.schema.name pattern, and for
+// a pattern without a schema, where dots are not separators, as used by
+// processSQLNamePattern.
+func TestParsePattern(t *testing.T) {
+	tests := []struct {
+		pattern     string
+		forceEscape bool
+		dbname      string
+		schema      string
+		name        string
+		nameOnly    string
+		dotcnt      int
+	}{
+		{``, false, ``, ``, `^()$`, `^()$`, 0},
+		{`foo`, false, ``, ``, `^(foo)$`, `^(foo)$`, 0},
+		{`FOO`, false, ``, ``, `^(foo)$`, `^(foo)$`, 0},
+		{`Foo`, false, ``, ``, `^(foo)$`, `^(foo)$`, 0},
+		{`foo_bar`, false, ``, ``, `^(foo_bar)$`, `^(foo_bar)$`, 0},
+		{`foo1`, false, ``, ``, `^(foo1)$`, `^(foo1)$`, 0},
+		{`_foo`, false, ``, ``, `^(_foo)$`, `^(_foo)$`, 0},
+		{`foo bar`, false, ``, ``, `^(foo bar)$`, `^(foo bar)$`, 0},
+		{`*`, false, ``, ``, `^(.*)$`, `^(.*)$`, 0},
+		{`**`, false, ``, ``, `^(.*.*)$`, `^(.*.*)$`, 0},
+		{`?`, false, ``, ``, `^(.)$`, `^(.)$`, 0},
+		{`??`, false, ``, ``, `^(..)$`, `^(..)$`, 0},
+		{`foo*`, false, ``, ``, `^(foo.*)$`, `^(foo.*)$`, 0},
+		{`*foo`, false, ``, ``, `^(.*foo)$`, `^(.*foo)$`, 0},
+		{`f*o`, false, ``, ``, `^(f.*o)$`, `^(f.*o)$`, 0},
+		{`foo?`, false, ``, ``, `^(foo.)$`, `^(foo.)$`, 0},
+		{`?oo`, false, ``, ``, `^(.oo)$`, `^(.oo)$`, 0},
+		{`f?o`, false, ``, ``, `^(f.o)$`, `^(f.o)$`, 0},
+		{`F*O?`, false, ``, ``, `^(f.*o.)$`, `^(f.*o.)$`, 0},
+		{`$`, false, ``, ``, `^(\$)$`, `^(\$)$`, 0},
+		{`$$`, false, ``, ``, `^(\$\$)$`, `^(\$\$)$`, 0},
+		{`foo$`, false, ``, ``, `^(foo\$)$`, `^(foo\$)$`, 0},
+		{`$foo`, false, ``, ``, `^(\$foo)$`, `^(\$foo)$`, 0},
+		{`f$o`, false, ``, ``, `^(f\$o)$`, `^(f\$o)$`, 0},
+		{`FOO$BAR`, false, ``, ``, `^(foo\$bar)$`, `^(foo\$bar)$`, 0},
+		{`foo|bar`, false, ``, ``, `^(foo|bar)$`, `^(foo|bar)$`, 0},
+		{`(foo|bar)`, false, ``, ``, `^((foo|bar))$`, `^((foo|bar))$`, 0},
+		{`fo+`, false, ``, ``, `^(fo+)$`, `^(fo+)$`, 0},
+		{`fo{2}`, false, ``, ``, `^(fo{2})$`, `^(fo{2})$`, 0},
+		{`[fb]oo`, false, ``, ``, `^([fb]oo)$`, `^([fb]oo)$`, 0},
+		{`^foo`, false, ``, ``, `^(^foo)$`, `^(^foo)$`, 0},
+		{`foo\d`, false, ``, ``, `^(foo\d)$`, `^(foo\d)$`, 0},
+		{`a\.b`, false, ``, `^(a\)$`, `^(b)$`, `^(a\.b)$`, 1},
+		{`int[]`, false, ``, ``, `^(int\[])$`, `^(int\[])$`, 0},
+		{`int4[]`, false, ``, ``, `^(int4\[])$`, `^(int4\[])$`, 0},
+		{`_int4[]`, false, ``, ``, `^(_int4\[])$`, `^(_int4\[])$`, 0},
+		{`[]`, false, ``, ``, `^(\[])$`, `^(\[])$`, 0},
+		{`[]x`, false, ``, ``, `^(\[]x)$`, `^(\[]x)$`, 0},
+		{`a[]b`, false, ``, ``, `^(a\[]b)$`, `^(a\[]b)$`, 0},
+		{`[x]`, false, ``, ``, `^([x])$`, `^([x])$`, 0},
+		{`int[][]`, false, ``, ``, `^(int\[]\[])$`, `^(int\[]\[])$`, 0},
+		{`[`, false, ``, ``, `^([)$`, `^([)$`, 0},
+		{`]`, false, ``, ``, `^(])$`, `^(])$`, 0},
+		{`][`, false, ``, ``, `^(][)$`, `^(][)$`, 0},
+		{`"foo"`, false, ``, ``, `^(foo)$`, `^(foo)$`, 0},
+		{`"FOO"`, false, ``, ``, `^(FOO)$`, `^(FOO)$`, 0},
+		{`"Foo"`, false, ``, ``, `^(Foo)$`, `^(Foo)$`, 0},
+		{`"foo bar"`, false, ``, ``, `^(foo bar)$`, `^(foo bar)$`, 0},
+		{`""`, false, ``, ``, `^()$`, `^()$`, 0},
+		{`""""`, false, ``, ``, `^(")$`, `^(")$`, 0},
+		{`"a""b"`, false, ``, ``, `^(a"b)$`, `^(a"b)$`, 0},
+		{`"""a"""`, false, ``, ``, `^("a")$`, `^("a")$`, 0},
+		{`"a""`, false, ``, ``, `^(a")$`, `^(a")$`, 0},
+		{`"`, false, ``, ``, `^()$`, `^()$`, 0},
+		{`"""`, false, ``, ``, `^(")$`, `^(")$`, 0},
+		{`"foo*"`, false, ``, ``, `^(foo\*)$`, `^(foo\*)$`, 0},
+		{`"foo?"`, false, ``, ``, `^(foo\?)$`, `^(foo\?)$`, 0},
+		{`"foo$"`, false, ``, ``, `^(foo\$)$`, `^(foo\$)$`, 0},
+		{`"foo|bar"`, false, ``, ``, `^(foo\|bar)$`, `^(foo\|bar)$`, 0},
+		{`"(x)"`, false, ``, ``, `^(\(x\))$`, `^(\(x\))$`, 0},
+		{`"[x]"`, false, ``, ``, `^(\[x\])$`, `^(\[x\])$`, 0},
+		{`"{x}"`, false, ``, ``, `^(\{x\})$`, `^(\{x\})$`, 0},
+		{`"^x"`, false, ``, ``, `^(\^x)$`, `^(\^x)$`, 0},
+		{`"x+"`, false, ``, ``, `^(x\+)$`, `^(x\+)$`, 0},
+		{`"a\b"`, false, ``, ``, `^(a\\b)$`, `^(a\\b)$`, 0},
+		{`"int[]"`, false, ``, ``, `^(int\[\])$`, `^(int\[\])$`, 0},
+		{`"a"b`, false, ``, ``, `^(ab)$`, `^(ab)$`, 0},
+		{`a"b"`, false, ``, ``, `^(ab)$`, `^(ab)$`, 0},
+		{`"A"B`, false, ``, ``, `^(Ab)$`, `^(Ab)$`, 0},
+		{`A"B"C`, false, ``, ``, `^(aBc)$`, `^(aBc)$`, 0},
+		{`"a"*`, false, ``, ``, `^(a.*)$`, `^(a.*)$`, 0},
+		{`*"a"`, false, ``, ``, `^(.*a)$`, `^(.*a)$`, 0},
+		{`"A"?"B"`, false, ``, ``, `^(A.B)$`, `^(A.B)$`, 0},
+		{`ñandú`, false, ``, ``, `^(ñandú)$`, `^(ñandú)$`, 0},
+		{`Ñandú`, false, ``, ``, `^(Ñandú)$`, `^(Ñandú)$`, 0},
+		{`ÄBC`, false, ``, ``, `^(Äbc)$`, `^(Äbc)$`, 0},
+		{`Straße`, false, ``, ``, `^(straße)$`, `^(straße)$`, 0},
+		{`"ÄBC"`, false, ``, ``, `^(ÄBC)$`, `^(ÄBC)$`, 0},
+		{`日本語`, false, ``, ``, `^(日本語)$`, `^(日本語)$`, 0},
+		{`日本*`, false, ``, ``, `^(日本.*)$`, `^(日本.*)$`, 0},
+		{`表?`, false, ``, ``, `^(表.)$`, `^(表.)$`, 0},
+		{`"表$"`, false, ``, ``, `^(表\$)$`, `^(表\$)$`, 0},
+		{`é|è`, false, ``, ``, `^(é|è)$`, `^(é|è)$`, 0},
+		{`"é|è"`, false, ``, ``, `^(é\|è)$`, `^(é\|è)$`, 0},
+		{"\"\xc3(\"", false, ``, ``, "^(\xc3()$", "^(\xc3()$", 0},
+		{"\xc3(", false, ``, ``, "^(\xc3()$", "^(\xc3()$", 0},
+		{"\"\xe6\x97(\"", false, ``, ``, "^(\xe6\x97()$", "^(\xe6\x97()$", 0},
+		{"\xff*", false, ``, ``, "^(\xff.*)$", "^(\xff.*)$", 0},
+		{"\"\xff*\"", false, ``, ``, "^(\xff\\*)$", "^(\xff\\*)$", 0},
+		{`.`, false, ``, `^()$`, `^()$`, `^(.)$`, 1},
+		{`..`, false, ``, `^()$`, `^()$`, `^(..)$`, 2},
+		{`...`, false, ``, `^()$`, `^(.)$`, `^(...)$`, 3},
+		{`....`, false, ``, `^()$`, `^(..)$`, `^(....)$`, 4},
+		{`a.`, false, ``, `^(a)$`, `^()$`, `^(a.)$`, 1},
+		{`.a`, false, ``, `^()$`, `^(a)$`, `^(.a)$`, 1},
+		{`a..`, false, `a`, `^()$`, `^()$`, `^(a..)$`, 2},
+		{`..a`, false, ``, `^()$`, `^(a)$`, `^(..a)$`, 2},
+		{`.a.`, false, ``, `^(a)$`, `^()$`, `^(.a.)$`, 2},
+		{`a..b`, false, `a`, `^()$`, `^(b)$`, `^(a..b)$`, 2},
+		{`public.foo`, false, ``, `^(public)$`, `^(foo)$`, `^(public.foo)$`, 1},
+		{`PUBLIC.FOO`, false, ``, `^(public)$`, `^(foo)$`, `^(public.foo)$`, 1},
+		{`public.*`, false, ``, `^(public)$`, `^(.*)$`, `^(public..*)$`, 1},
+		{`*.foo`, false, ``, `^(.*)$`, `^(foo)$`, `^(.*.foo)$`, 1},
+		{`*.*`, false, ``, `^(.*)$`, `^(.*)$`, `^(.*..*)$`, 1},
+		{`*.*.*`, false, `*`, `^(.*)$`, `^(.*)$`, `^(.*..*..*)$`, 2},
+		{`pub*.t?b`, false, ``, `^(pub.*)$`, `^(t.b)$`, `^(pub.*.t.b)$`, 1},
+		{`pg_catalog.int4`, false, ``, `^(pg_catalog)$`, `^(int4)$`, `^(pg_catalog.int4)$`, 1},
+		{`pg_catalog.int4[]`, false, ``, `^(pg_catalog)$`, `^(int4\[])$`, `^(pg_catalog.int4\[])$`, 1},
+		{`pg_catalog.pg_class`, false, ``, `^(pg_catalog)$`, `^(pg_class)$`, `^(pg_catalog.pg_class)$`, 1},
+		{`Pg_Catalog.Pg_Class`, false, ``, `^(pg_catalog)$`, `^(pg_class)$`, `^(pg_catalog.pg_class)$`, 1},
+		{`information_schema.*`, false, ``, `^(information_schema)$`, `^(.*)$`, `^(information_schema..*)$`, 1},
+		{`"public"."foo"`, false, ``, `^(public)$`, `^(foo)$`, `^(public.foo)$`, 1},
+		{`"PUBLIC"."FOO"`, false, ``, `^(PUBLIC)$`, `^(FOO)$`, `^(PUBLIC.FOO)$`, 1},
+		{`"public".foo`, false, ``, `^(public)$`, `^(foo)$`, `^(public.foo)$`, 1},
+		{`public."foo"`, false, ``, `^(public)$`, `^(foo)$`, `^(public.foo)$`, 1},
+		{`"pub.lic".foo`, false, ``, `^(pub\.lic)$`, `^(foo)$`, `^(pub\.lic.foo)$`, 1},
+		{`public."f.oo"`, false, ``, `^(public)$`, `^(f\.oo)$`, `^(public.f\.oo)$`, 1},
+		{`"a.b.c"`, false, ``, ``, `^(a\.b\.c)$`, `^(a\.b\.c)$`, 0},
+		{`"a.b".c`, false, ``, `^(a\.b)$`, `^(c)$`, `^(a\.b.c)$`, 1},
+		{`a."b.c"`, false, ``, `^(a)$`, `^(b\.c)$`, `^(a.b\.c)$`, 1},
+		{`"a"."b"."c"`, false, `a`, `^(b)$`, `^(c)$`, `^(a.b.c)$`, 2},
+		{`"a.b"."c.d"."e.f"`, false, `a.b`, `^(c\.d)$`, `^(e\.f)$`, `^(a\.b.c\.d.e\.f)$`, 2},
+		{`"no.such.access.method"`, false, ``, ``, `^(no\.such\.access\.method)$`, `^(no\.such\.access\.method)$`, 0},
+		{`"no.such.schema"."no.such.table.relation"`, false, ``, `^(no\.such\.schema)$`, `^(no\.such\.table\.relation)$`, `^(no\.such\.schema.no\.such\.table\.relation)$`, 1},
+		{`regression."no.such.schema"."no.such.table.relation"`, false, `regression`, `^(no\.such\.schema)$`, `^(no\.such\.table\.relation)$`, `^(regression.no\.such\.schema.no\.such\.table\.relation)$`, 2},
+		{`nonesuch."no.such.schema"."no.such.table.relation"`, false, `nonesuch`, `^(no\.such\.schema)$`, `^(no\.such\.table\.relation)$`, `^(nonesuch.no\.such\.schema.no\.such\.table\.relation)$`, 2},
+		{`"no.such.database"."no.such.schema"."no.such.table.relation"`, false, `no.such.database`, `^(no\.such\.schema)$`, `^(no\.such\.table\.relation)$`, `^(no\.such\.database.no\.such\.schema.no\.such\.table\.relation)$`, 2},
+		{`regression.pg_catalog.pg_class`, false, `regression`, `^(pg_catalog)$`, `^(pg_class)$`, `^(regression.pg_catalog.pg_class)$`, 2},
+		{`Regression.Pg_Catalog.Pg_Class`, false, `regression`, `^(pg_catalog)$`, `^(pg_class)$`, `^(regression.pg_catalog.pg_class)$`, 2},
+		{`"Regression".pg_catalog.pg_class`, false, `Regression`, `^(pg_catalog)$`, `^(pg_class)$`, `^(Regression.pg_catalog.pg_class)$`, 2},
+		{`reg*.pg_catalog.pg_class`, false, `reg*`, `^(pg_catalog)$`, `^(pg_class)$`, `^(reg.*.pg_catalog.pg_class)$`, 2},
+		{`reg?ession.public.foo`, false, `reg?ession`, `^(public)$`, `^(foo)$`, `^(reg.ession.public.foo)$`, 2},
+		{`reg$ion.public.foo`, false, `reg$ion`, `^(public)$`, `^(foo)$`, `^(reg\$ion.public.foo)$`, 2},
+		{`"reg""ression".public.foo`, false, `reg"ression`, `^(public)$`, `^(foo)$`, `^(reg"ression.public.foo)$`, 2},
+		{`r|x.public.foo`, false, `r|x`, `^(public)$`, `^(foo)$`, `^(r|x.public.foo)$`, 2},
+		{`"r.x".public.foo`, false, `r.x`, `^(public)$`, `^(foo)$`, `^(r\.x.public.foo)$`, 2},
+		{`db.schema.table.column`, false, `db`, `^(schema)$`, `^(table.column)$`, `^(db.schema.table.column)$`, 3},
+		{`a.b.c.d.e`, false, `a`, `^(b)$`, `^(c.d.e)$`, `^(a.b.c.d.e)$`, 4},
+		{`db..t`, false, `db`, `^()$`, `^(t)$`, `^(db..t)$`, 2},
+		{`.schema.t`, false, ``, `^(schema)$`, `^(t)$`, `^(.schema.t)$`, 2},
+		{`..t`, false, ``, `^()$`, `^(t)$`, `^(..t)$`, 2},
+		{`db.s.`, false, `db`, `^(s)$`, `^()$`, `^(db.s.)$`, 2},
+		{`"".s.t`, false, ``, `^(s)$`, `^(t)$`, `^(.s.t)$`, 2},
+		{`""."".""`, false, ``, `^()$`, `^()$`, `^(..)$`, 2},
+		{`public.foo$`, false, ``, `^(public)$`, `^(foo\$)$`, `^(public.foo\$)$`, 1},
+		{`$a.$b`, false, ``, `^(\$a)$`, `^(\$b)$`, `^(\$a.\$b)$`, 1},
+		{`"$a"."$b"`, false, ``, `^(\$a)$`, `^(\$b)$`, `^(\$a.\$b)$`, 1},
+		{`a$.b$.c$`, false, `a$`, `^(b\$)$`, `^(c\$)$`, `^(a\$.b\$.c\$)$`, 2},
+		{`public.foo|bar`, false, ``, `^(public)$`, `^(foo|bar)$`, `^(public.foo|bar)$`, 1},
+		{`public.(foo|bar)`, false, ``, `^(public)$`, `^((foo|bar))$`, `^(public.(foo|bar))$`, 1},
+		{`(a|b).c`, false, ``, `^((a|b))$`, `^(c)$`, `^((a|b).c)$`, 1},
+		{`a|b.c|d`, false, ``, `^(a|b)$`, `^(c|d)$`, `^(a|b.c|d)$`, 1},
+		{`x+.y+`, false, ``, `^(x+)$`, `^(y+)$`, `^(x+.y+)$`, 1},
+		{`public.int[]`, false, ``, `^(public)$`, `^(int\[])$`, `^(public.int\[])$`, 1},
+		{`pg_catalog._int4[]`, false, ``, `^(pg_catalog)$`, `^(_int4\[])$`, `^(pg_catalog._int4\[])$`, 1},
+		{`a[].b[]`, false, ``, `^(a\[])$`, `^(b\[])$`, `^(a\[].b\[])$`, 1},
+		{`ñ.ü`, false, ``, `^(ñ)$`, `^(ü)$`, `^(ñ.ü)$`, 1},
+		{`ÄÖ.ÜÉ`, false, ``, `^(ÄÖ)$`, `^(ÜÉ)$`, `^(ÄÖ.ÜÉ)$`, 1},
+		{`"ÄÖ"."ÜÉ"`, false, ``, `^(ÄÖ)$`, `^(ÜÉ)$`, `^(ÄÖ.ÜÉ)$`, 1},
+		{`データ.テーブル`, false, ``, `^(データ)$`, `^(テーブル)$`, `^(データ.テーブル)$`, 1},
+		{`дб.схема.таблица`, false, `дб`, `^(схема)$`, `^(таблица)$`, `^(дб.схема.таблица)$`, 2},
+		{`ДБ.Схема.Таблица`, false, `ДБ`, `^(Схема)$`, `^(Таблица)$`, `^(ДБ.Схема.Таблица)$`, 2},
+		{`foo`, true, ``, ``, `^(foo)$`, `^(foo)$`, 0},
+		{`foo*`, true, ``, ``, `^(foo.*)$`, `^(foo.*)$`, 0},
+		{`foo?`, true, ``, ``, `^(foo.)$`, `^(foo.)$`, 0},
+		{`foo$`, true, ``, ``, `^(foo\$)$`, `^(foo\$)$`, 0},
+		{`foo|bar`, true, ``, ``, `^(foo\|bar)$`, `^(foo\|bar)$`, 0},
+		{`(foo|bar)`, true, ``, ``, `^(\(foo\|bar\))$`, `^(\(foo\|bar\))$`, 0},
+		{`fo+`, true, ``, ``, `^(fo\+)$`, `^(fo\+)$`, 0},
+		{`fo{2}`, true, ``, ``, `^(fo\{2\})$`, `^(fo\{2\})$`, 0},
+		{`[fb]oo`, true, ``, ``, `^(\[fb\]oo)$`, `^(\[fb\]oo)$`, 0},
+		{`^foo`, true, ``, ``, `^(\^foo)$`, `^(\^foo)$`, 0},
+		{`foo\d`, true, ``, ``, `^(foo\\d)$`, `^(foo\\d)$`, 0},
+		{`a\.b`, true, ``, `^(a\\)$`, `^(b)$`, `^(a\\.b)$`, 1},
+		{`int[]`, true, ``, ``, `^(int\[\])$`, `^(int\[\])$`, 0},
+		{`[x]`, true, ``, ``, `^(\[x\])$`, `^(\[x\])$`, 0},
+		{`"foo|bar"`, true, ``, ``, `^(foo\|bar)$`, `^(foo\|bar)$`, 0},
+		{`"x+"`, true, ``, ``, `^(x\+)$`, `^(x\+)$`, 0},
+		{`FOO+BAR`, true, ``, ``, `^(foo\+bar)$`, `^(foo\+bar)$`, 0},
+		{`a.b|c`, true, ``, `^(a)$`, `^(b\|c)$`, `^(a.b\|c)$`, 1},
+		{`public.f(o)o`, true, ``, `^(public)$`, `^(f\(o\)o)$`, `^(public.f\(o\)o)$`, 1},
+		{`db.s{1}.t[2]`, true, `db`, `^(s\{1\})$`, `^(t\[2\])$`, `^(db.s\{1\}.t\[2\])$`, 2},
+		{`"a.b".c^d`, true, ``, `^(a\.b)$`, `^(c\^d)$`, `^(a\.b.c\^d)$`, 1},
+		{`é+`, true, ``, ``, `^(é\+)$`, `^(é\+)$`, 0},
+		{`"ü*"`, true, ``, ``, `^(ü\*)$`, `^(ü\*)$`, 0},
+	}
+	for i, test := range tests {
+		dbnamebuf, schemabuf, namebuf := new(bytes.Buffer), new(bytes.Buffer), new(bytes.Buffer)
+		dotcnt := parsePattern(dbnamebuf, schemabuf, namebuf, test.pattern, test.forceEscape, true)
+		if s := dbnamebuf.String(); s != test.dbname {
+			t.Errorf("test %d %q expected dbname %q, got: %q", i, test.pattern, test.dbname, s)
+		}
+		if s := schemabuf.String(); s != test.schema {
+			t.Errorf("test %d %q expected schema %q, got: %q", i, test.pattern, test.schema, s)
+		}
+		if s := namebuf.String(); s != test.name {
+			t.Errorf("test %d %q expected name %q, got: %q", i, test.pattern, test.name, s)
+		}
+		if dotcnt != test.dotcnt {
+			t.Errorf("test %d %q expected dotcnt %d, got: %d", i, test.pattern, test.dotcnt, dotcnt)
+		}
+		namebuf = new(bytes.Buffer)
+		dotcnt = parsePattern(nil, nil, namebuf, test.pattern, test.forceEscape, true)
+		if s := namebuf.String(); s != test.nameOnly {
+			t.Errorf("test %d %q expected name only %q, got: %q", i, test.pattern, test.nameOnly, s)
+		}
+		if dotcnt != test.dotcnt {
+			t.Errorf("test %d %q expected name only dotcnt %d, got: %d", i, test.pattern, test.dotcnt, dotcnt)
+		}
+	}
+}
+
+// TestValidateSQLNamePattern checks the errors for patterns with too many
+// dotted names, and for cross-database references, which are not checked by
+// processSQLNamePattern.
+func TestValidateSQLNamePattern(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		schema   bool
+		maxparts int
+		err      string
+	}{
+		{`"no.such.access.method"`, false, 1, ``},
+		{`"no.such.schema"."no.such.access.method"`, false, 1, `improper qualified name (too many dotted names): "no.such.schema"."no.such.access.method"`},
+		{`no.such.access.method`, false, 1, `improper qualified name (too many dotted names): no.such.access.method`},
+		{`"no.such.table.relation"`, true, 3, ``},
+		{`"no.such.schema"."no.such.table.relation"`, true, 3, ``},
+		{`regression."no.such.schema"."no.such.table.relation"`, true, 3, ``},
+		{`Regression.pg_catalog.pg_class`, true, 3, ``},
+		{`"Regression".pg_catalog.pg_class`, true, 3, `cross-database references are not implemented: "Regression".pg_catalog.pg_class`},
+		{`nonesuch."no.such.schema"."no.such.table.relation"`, true, 3, `cross-database references are not implemented: nonesuch."no.such.schema"."no.such.table.relation"`},
+		{`"no.such.database"."no.such.schema"."no.such.table.relation"`, true, 3, `cross-database references are not implemented: "no.such.database"."no.such.schema"."no.such.table.relation"`},
+		{`reg*.pg_catalog.pg_class`, true, 3, `cross-database references are not implemented: reg*.pg_catalog.pg_class`},
+		{`regression.pg_catalog.pg_class.relname`, true, 3, `improper qualified name (too many dotted names): regression.pg_catalog.pg_class.relname`},
+		{`"no.such.schema"`, false, 2, ``},
+		{`a.b`, false, 2, `cross-database references are not implemented: a.b`},
+		{`regression.pg_catalog.pg_class`, false, 2, `improper qualified name (too many dotted names): regression.pg_catalog.pg_class`},
+	}
+	d := NewPgDesc(nil, 150000, WithDatabase("regression"))
+	for i, test := range tests {
+		var schemavar string
+		if test.schema {
+			schemavar = "n.nspname"
+		}
+		err := d.validateSQLNamePattern(new(QueryBuffer), test.pattern, false, false, schemavar, "c.relname", "", "", nil, test.maxparts)
+		switch {
+		case err == nil && test.err != "":
+			t.Errorf("test %d %q expected error %q", i, test.pattern, test.err)
+		case err != nil && err.Error() != test.err:
+			t.Errorf("test %d %q expected error %q, got: %v", i, test.pattern, test.err, err)
+		}
+		// processSQLNamePattern does not check the dotted names
+		if _, err := d.processSQLNamePattern(new(QueryBuffer), test.pattern, false, false, schemavar, "c.relname", "", ""); err != nil {
+			t.Errorf("test %d %q expected no processSQLNamePattern error, got: %v", i, test.pattern, err)
+		}
+	}
+}
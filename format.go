@@ -0,0 +1,759 @@
+package pgdesc
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Format is a table output format, as set by psql's \pset format.
+type Format string
+
+// Formats.
+const (
+	FormatAligned        Format = "aligned"
+	FormatUnaligned      Format = "unaligned"
+	FormatWrapped        Format = "wrapped"
+	FormatCSV            Format = "csv"
+	FormatHTML           Format = "html"
+	FormatLaTeX          Format = "latex"
+	FormatLaTeXLongtable Format = "latex-longtable"
+	FormatTroffMS        Format = "troff-ms"
+	FormatAsciidoc       Format = "asciidoc"
+	FormatJSON           Format = "json"
+	FormatMarkdown       Format = "markdown"
+)
+
+// formats are the known formats.
+var formats = []Format{
+	FormatAligned,
+	FormatAsciidoc,
+	FormatCSV,
+	FormatHTML,
+	FormatJSON,
+	FormatLaTeX,
+	FormatLaTeXLongtable,
+	FormatMarkdown,
+	FormatTroffMS,
+	FormatUnaligned,
+	FormatWrapped,
+}
+
+// ParseFormat parses a format name. Similar to psql, any unique prefix of a
+// format name is accepted.
+func ParseFormat(s string) (Format, error) {
+	var match []Format
+	for _, f := range formats {
+		switch {
+		case string(f) == s:
+			return f, nil
+		case strings.HasPrefix(string(f), s) && s != "":
+			match = append(match, f)
+		}
+	}
+	switch len(match) {
+	case 0:
+		return "", fmt.Errorf("\\pset: allowed formats are aligned, asciidoc, csv, html, json, latex, latex-longtable, markdown, troff-ms, unaligned, wrapped\n")
+	case 1:
+		return match[0], nil
+	}
+	return "", fmt.Errorf("\\pset: ambiguous abbreviation \"%s\" matches both \"%s\" and \"%s\"\n", s, match[0], match[1])
+}
+
+// Printer is the interface for table printers.
+type Printer interface {
+	Print(w io.Writer, t *Table) error
+}
+
+// PrintOptions are table printing options, similar to psql's printTableOpt.
+//
+// PrintOptions is a Printer.
+type PrintOptions struct {
+	// Format is the output format. Defaults to aligned.
+	Format Format
+
+	// Expanded is the expanded display mode.
+	Expanded Expanded
+
+	// Columns is the target width for the wrapped format and the auto
+	// expanded display mode. When 0, and the output is a terminal, the
+	// COLUMNS environment variable or the terminal's width is used.
+	Columns int
+
+	// FieldSep is the field separator for the unaligned format. Defaults to
+	// "|".
+	FieldSep string
+
+	// RecordSep is the record separator for the unaligned format. Defaults
+	// to "\n".
+	RecordSep string
+
+	// CSVFieldSep is the field separator for the csv format. Defaults to
+	// ",".
+	CSVFieldSep byte
+}
+
+// Print satisfies the Printer interface.
+func (opts PrintOptions) Print(w io.Writer, t *Table) error {
+	expanded := opts.Expanded == ExpandedOn && !t.NoExpanded
+	auto := opts.Expanded == ExpandedAuto && !t.NoExpanded
+	switch opts.Format {
+	case FormatAligned, "", FormatWrapped:
+		wrapped, columns := opts.Format == FormatWrapped, opts.Columns
+		if columns == 0 && (wrapped || auto) {
+			columns = outputColumns(w)
+		}
+		if expanded {
+			return printAlignedVertical(w, t, wrapped, columns)
+		}
+		return printAligned(w, t, wrapped, columns, auto)
+	}
+
+	bw := bufio.NewWriter(w)
+	switch opts.Format {
+	case FormatUnaligned:
+		fieldSep, recordSep := opts.FieldSep, opts.RecordSep
+		if fieldSep == "" {
+			fieldSep = "|"
+		}
+		if recordSep == "" {
+			recordSep = "\n"
+		}
+		if expanded {
+			printUnalignedVertical(bw, t, fieldSep, recordSep)
+		} else {
+			printUnaligned(bw, t, fieldSep, recordSep)
+		}
+	case FormatCSV:
+		sep := opts.CSVFieldSep
+		if sep == 0 {
+			sep = ','
+		}
+		if expanded {
+			printCSVVertical(bw, t, sep)
+		} else {
+			printCSV(bw, t, sep)
+		}
+	case FormatHTML:
+		if expanded {
+			printHTMLVertical(bw, t)
+		} else {
+			printHTML(bw, t)
+		}
+	case FormatLaTeX, FormatLaTeXLongtable:
+		switch {
+		case expanded:
+			printLaTeXVertical(bw, t)
+		case opts.Format == FormatLaTeX:
+			printLaTeX(bw, t)
+		default:
+			printLaTeXLongtable(bw, t)
+		}
+	case FormatTroffMS:
+		if expanded {
+			printTroffMSVertical(bw, t)
+		} else {
+			printTroffMS(bw, t)
+		}
+	case FormatAsciidoc:
+		if expanded {
+			printAsciidocVertical(bw, t)
+		} else {
+			printAsciidoc(bw, t)
+		}
+	case FormatJSON:
+		// rows are always printed as objects, so there is no expanded form
+		if err := printJSON(bw, t); err != nil {
+			return err
+		}
+	case FormatMarkdown:
+		printMarkdown(bw, t)
+	default:
+		return fmt.Errorf("invalid output format (internal error): %s", opts.Format)
+	}
+	return bw.Flush()
+}
+
+// WithFormat is a postgres description option to set the output format used
+// by the Print methods.
+func WithFormat(format Format) Option {
+	return func(d *PgDesc) {
+		setPrintOptions(d, func(opts *PrintOptions) {
+			opts.Format = format
+		})
+	}
+}
+
+// WithPrinter is a postgres description option to set the printer used by
+// the Print methods.
+func WithPrinter(printer Printer) Option {
+	return func(d *PgDesc) {
+		d.printer = printer
+	}
+}
+
+// print prints the table to w using the printer.
+func (d *PgDesc) print(w io.Writer, t *Table) error {
+	if d.printer == nil {
+		return t.Print(w)
+	}
+	return d.printer.Print(w, t)
+}
+
+// printUnaligned prints the table in psql's unaligned format.
+func printUnaligned(w *bufio.Writer, t *Table, fieldSep, recordSep string) {
+	var needRecordSep bool
+
+	// print title
+	if t.Title != "" {
+		w.WriteString(t.Title)
+		needRecordSep = true
+	}
+
+	// print headers
+	for i, h := range t.Headers {
+		if needRecordSep {
+			w.WriteString(recordSep)
+			needRecordSep = false
+		}
+		if i != 0 {
+			w.WriteString(fieldSep)
+		}
+		w.WriteString(h)
+	}
+	needRecordSep = needRecordSep || len(t.Headers) != 0
+
+	// print cells
+	for _, row := range t.Rows {
+		if needRecordSep {
+			w.WriteString(recordSep)
+		}
+		w.WriteString(strings.Join(row, fieldSep))
+		needRecordSep = true
+	}
+
+	// print footers
+	for _, f := range t.footers() {
+		if needRecordSep {
+			w.WriteString(recordSep)
+		}
+		w.WriteString(f)
+		needRecordSep = true
+	}
+
+	// the last record needs to be concluded with a newline
+	if needRecordSep {
+		w.WriteString("\n")
+	}
+}
+
+// printCSV prints the table in psql's csv format.
+//
+// The title and footers are never printed in csv format.
+func printCSV(w *bufio.Writer, t *Table, sep byte) {
+	for _, row := range append([][]string{t.Headers}, t.Rows...) {
+		for i, s := range row {
+			if i != 0 {
+				w.WriteByte(sep)
+			}
+			w.WriteString(csvField(s, sep))
+		}
+		w.WriteString("\n")
+	}
+}
+
+// csvField returns s quoted as a csv field, when necessary.
+//
+// Similar to psql, fields equal to "\." and all fields when the separator is
+// '\' or '.' are quoted, so that no line can be mistaken for COPY's
+// end-of-data marker.
+func csvField(s string, sep byte) string {
+	if strings.IndexByte(s, sep) == -1 && !strings.ContainsAny(s, "\r\n\"") &&
+		s != `\.` && sep != '\\' && sep != '.' {
+		return s
+	}
+	return `"` + strings.Replace(s, `"`, `""`, -1) + `"`
+}
+
+// printHTML prints the table in psql's html format.
+func printHTML(w *bufio.Writer, t *Table) {
+	w.WriteString("<table border=\"1\">\n")
+
+	// print title
+	if t.Title != "" {
+		w.WriteString("  <caption>")
+		w.WriteString(htmlEscape(t.Title))
+		w.WriteString("</caption>\n")
+	}
+
+	// print headers
+	w.WriteString("  <tr>\n")
+	for _, h := range t.Headers {
+		w.WriteString("    <th align=\"center\">")
+		w.WriteString(htmlEscape(h))
+		w.WriteString("</th>\n")
+	}
+	w.WriteString("  </tr>\n")
+
+	// print cells
+	for _, row := range t.Rows {
+		w.WriteString("  <tr valign=\"top\">\n")
+		for j, s := range row {
+			align := "left"
+			if t.align(j) == 'r' {
+				align = "right"
+			}
+			fmt.Fprintf(w, "    <td align=\"%s\">", align)
+			// is string only whitespace?
+			if strings.Trim(s, " \t") == "" {
+				w.WriteString("&nbsp; ")
+			} else {
+				w.WriteString(htmlEscape(s))
+			}
+			w.WriteString("</td>\n")
+		}
+		w.WriteString("  </tr>\n")
+	}
+	w.WriteString("</table>\n")
+
+	// print footers
+	if footers := t.footers(); len(footers) != 0 {
+		w.WriteString("<p>")
+		for _, f := range footers {
+			w.WriteString(htmlEscape(f))
+			w.WriteString("<br />\n")
+		}
+		w.WriteString("</p>")
+	}
+	w.WriteString("\n")
+}
+
+// htmlEscape escapes s in the same manner as psql's html_escaped_print.
+func htmlEscape(s string) string {
+	var b strings.Builder
+	leadingSpace := true
+	for _, r := range s {
+		switch r {
+		case '&':
+			b.WriteString("&amp;")
+		case '<':
+			b.WriteString("&lt;")
+		case '>':
+			b.WriteString("&gt;")
+		case '\n':
+			b.WriteString("<br />\n")
+		case '"':
+			b.WriteString("&quot;")
+		case ' ':
+			// protect leading space, for EXPLAIN output
+			if leadingSpace {
+				b.WriteString("&nbsp;")
+			} else {
+				b.WriteString(" ")
+			}
+		default:
+			b.WriteRune(r)
+		}
+		if r != ' ' {
+			leadingSpace = false
+		}
+	}
+	return b.String()
+}
+
+// printLaTeX prints the table in psql's latex format.
+func printLaTeX(w *bufio.Writer, t *Table) {
+	// print title
+	if t.Title != "" {
+		w.WriteString("\\begin{center}\n")
+		w.WriteString(latexEscape(t.Title))
+		w.WriteString("\n\\end{center}\n\n")
+	}
+
+	// begin environment and set alignments and borders
+	w.WriteString("\\begin{tabular}{")
+	for i := range t.Headers {
+		w.WriteByte(t.align(i))
+		if i < len(t.Headers)-1 {
+			w.WriteString(" | ")
+		}
+	}
+	w.WriteString("}\n")
+
+	// print headers
+	for i, h := range t.Headers {
+		if i != 0 {
+			w.WriteString(" & ")
+		}
+		w.WriteString("\\textit{")
+		w.WriteString(latexEscape(h))
+		w.WriteString("}")
+	}
+	w.WriteString(" \\\\\n")
+	w.WriteString("\\hline\n")
+
+	// print cells
+	for _, row := range t.Rows {
+		for j, s := range row {
+			if j != 0 {
+				w.WriteString(" & ")
+			}
+			w.WriteString(latexEscape(s))
+		}
+		w.WriteString(" \\\\\n")
+	}
+
+	w.WriteString("\\end{tabular}\n\n\\noindent ")
+
+	// print footers
+	for _, f := range t.footers() {
+		w.WriteString(latexEscape(f))
+		w.WriteString(" \\\\\n")
+	}
+	w.WriteString("\n")
+}
+
+// printLaTeXLongtable prints the table in psql's latex-longtable format.
+func printLaTeXLongtable(w *bufio.Writer, t *Table) {
+	// begin environment and set alignments and borders
+	w.WriteString("\\begin{longtable}{")
+	for i := range t.Headers {
+		w.WriteByte(t.align(i))
+		if i < len(t.Headers)-1 {
+			w.WriteString(" | ")
+		}
+	}
+	w.WriteString("}\n")
+
+	// print headers, first for the first page, then for secondary pages
+	for _, end := range []string{"\\midrule\n\\endfirsthead\n", "\\midrule\n\\endhead\n"} {
+		for i, h := range t.Headers {
+			if i != 0 {
+				w.WriteString(" & ")
+			}
+			w.WriteString("\\small\\textbf{\\textit{")
+			w.WriteString(latexEscape(h))
+			w.WriteString("}}")
+		}
+		w.WriteString(" \\\\\n")
+		w.WriteString(end)
+	}
+
+	// table name, caption?
+	if t.Title != "" {
+		title := latexEscape(t.Title)
+		fmt.Fprintf(w, "\\caption[%s (Continued)]{%s}\n\\endfoot\n", title, title)
+		fmt.Fprintf(w, "\\caption[%s]{%s}\n\\endlastfoot\n", title, title)
+	}
+
+	// print cells
+	for _, row := range t.Rows {
+		for j, s := range row {
+			if j != 0 {
+				w.WriteString("\n&\n")
+			}
+			w.WriteString("\\raggedright{")
+			w.WriteString(latexEscape(s))
+			w.WriteString("}")
+		}
+		w.WriteString(" \\tabularnewline\n")
+	}
+
+	w.WriteString("\\end{longtable}\n")
+}
+
+// latexEscape escapes s in the same manner as psql's latex_escaped_print.
+func latexEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch r {
+		case '#':
+			b.WriteString("\\#")
+		case '$':
+			b.WriteString("\\$")
+		case '%':
+			b.WriteString("\\%")
+		case '&':
+			b.WriteString("\\&")
+		case '<':
+			b.WriteString("\\textless{}")
+		case '>':
+			b.WriteString("\\textgreater{}")
+		case '\\':
+			b.WriteString("\\textbackslash{}")
+		case '^':
+			b.WriteString("\\^{}")
+		case '_':
+			b.WriteString("\\_")
+		case '{':
+			b.WriteString("\\{")
+		case '|':
+			b.WriteString("\\textbar{}")
+		case '}':
+			b.WriteString("\\}")
+		case '~':
+			b.WriteString("\\~{}")
+		case '\n':
+			// This is not right, but doing it right seems too hard
+			b.WriteString("\\\\")
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// printTroffMS prints the table in psql's troff-ms format.
+func printTroffMS(w *bufio.Writer, t *Table) {
+	escape := func(s string) string {
+		return strings.Replace(s, "\\", "\\(rs", -1)
+	}
+
+	// print title
+	if t.Title != "" {
+		w.WriteString(".LP\n.DS C\n")
+		w.WriteString(escape(t.Title))
+		w.WriteString("\n.DE\n")
+	}
+
+	// begin environment and set alignments and borders
+	w.WriteString(".LP\n.TS\n")
+	w.WriteString("center;\n")
+	for i := range t.Headers {
+		w.WriteByte(t.align(i))
+		if i < len(t.Headers)-1 {
+			w.WriteString(" | ")
+		}
+	}
+	w.WriteString(".\n")
+
+	// print headers
+	for i, h := range t.Headers {
+		if i != 0 {
+			w.WriteString("\t")
+		}
+		w.WriteString("\\fI")
+		w.WriteString(escape(h))
+		w.WriteString("\\fP")
+	}
+	w.WriteString("\n_\n")
+
+	// print cells
+	for _, row := range t.Rows {
+		for j, s := range row {
+			if j != 0 {
+				w.WriteString("\t")
+			}
+			w.WriteString(escape(s))
+		}
+		w.WriteString("\n")
+	}
+
+	w.WriteString(".TE\n.DS L\n")
+
+	// print footers
+	for _, f := range t.footers() {
+		w.WriteString(escape(f))
+		w.WriteString("\n")
+	}
+	w.WriteString(".DE\n")
+}
+
+// printAsciidoc prints the table in psql's asciidoc format.
+func printAsciidoc(w *bufio.Writer, t *Table) {
+	escape := func(s string) string {
+		return strings.Replace(s, "|", "\\|", -1)
+	}
+
+	// print table in new paragraph - enforce preliminary new line
+	w.WriteString("\n")
+
+	// print title
+	if t.Title != "" {
+		w.WriteString(".")
+		w.WriteString(t.Title)
+		w.WriteString("\n")
+	}
+
+	// print table [] header definition
+	w.WriteString("[options=\"header\",cols=\"")
+	for i := range t.Headers {
+		if i != 0 {
+			w.WriteString(",")
+		}
+		if t.align(i) == 'r' {
+			w.WriteString(">l")
+		} else {
+			w.WriteString("<l")
+		}
+	}
+	w.WriteString("\",frame=\"none\"]\n")
+	w.WriteString("|====\n")
+
+	// print headers
+	for i, h := range t.Headers {
+		if i != 0 {
+			w.WriteString(" ")
+		}
+		w.WriteString("^l|")
+		w.WriteString(escape(h))
+	}
+	w.WriteString("\n")
+
+	// print cells
+	for _, row := range t.Rows {
+		for j, s := range row {
+			if j != 0 {
+				w.WriteString(" ")
+			}
+			w.WriteString("|")
+			// protect against needless spaces
+			if strings.Trim(s, " \t") == "" {
+				if j != len(row)-1 {
+					w.WriteString(" ")
+				}
+			} else {
+				w.WriteString(escape(s))
+			}
+		}
+		w.WriteString("\n")
+	}
+	w.WriteString("|====\n")
+
+	// print footers
+	if footers := t.footers(); len(footers) != 0 {
+		w.WriteString("\n....\n")
+		for _, f := range footers {
+			w.WriteString(f)
+			w.WriteString("\n")
+		}
+		w.WriteString("....\n")
+	}
+}
+
+// printJSON prints the table as a JSON object, with the rows as objects
+// keyed by column header, in column order.
+func printJSON(w *bufio.Writer, t *Table) error {
+	str := func(s string) error {
+		buf, err := json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(buf)
+		return err
+	}
+	list := func(v []string) error {
+		w.WriteString("[")
+		for i, s := range v {
+			if i != 0 {
+				w.WriteString(",")
+			}
+			if err := str(s); err != nil {
+				return err
+			}
+		}
+		w.WriteString("]")
+		return nil
+	}
+
+	w.WriteString(`{"title":`)
+	if err := str(t.Title); err != nil {
+		return err
+	}
+	w.WriteString(`,"columns":`)
+	if err := list(t.Headers); err != nil {
+		return err
+	}
+	w.WriteString(`,"rows":[`)
+	for i, row := range t.Rows {
+		if i != 0 {
+			w.WriteString(",")
+		}
+		w.WriteString("{")
+		for j, h := range t.Headers {
+			if j != 0 {
+				w.WriteString(",")
+			}
+			var s string
+			if j < len(row) {
+				s = row[j]
+			}
+			if err := str(h); err != nil {
+				return err
+			}
+			w.WriteString(":")
+			if err := str(s); err != nil {
+				return err
+			}
+		}
+		w.WriteString("}")
+	}
+	w.WriteString(`],"footers":`)
+	footers := t.footers()
+	if footers == nil {
+		footers = []string{}
+	}
+	if err := list(footers); err != nil {
+		return err
+	}
+	w.WriteString("}\n")
+	return nil
+}
+
+// printMarkdown prints the table as a GitHub flavored Markdown table. The
+// title is printed as a bold paragraph, and the footers are printed in a
+// fenced block.
+func printMarkdown(w *bufio.Writer, t *Table) {
+	escape := func(s string) string {
+		s = strings.Replace(s, "|", "\\|", -1)
+		return strings.Replace(s, "\n", "<br>", -1)
+	}
+
+	// print title
+	if t.Title != "" {
+		w.WriteString("**")
+		w.WriteString(escape(t.Title))
+		w.WriteString("**\n\n")
+	}
+
+	// print headers
+	w.WriteString("|")
+	for _, h := range t.Headers {
+		w.WriteString(" ")
+		w.WriteString(escape(h))
+		w.WriteString(" |")
+	}
+	w.WriteString("\n|")
+	for i := range t.Headers {
+		if t.align(i) == 'r' {
+			w.WriteString(" ---: |")
+		} else {
+			w.WriteString(" --- |")
+		}
+	}
+	w.WriteString("\n")
+
+	// print cells
+	for _, row := range t.Rows {
+		w.WriteString("|")
+		for _, s := range row {
+			w.WriteString(" ")
+			w.WriteString(escape(s))
+			w.WriteString(" |")
+		}
+		w.WriteString("\n")
+	}
+
+	// print footers
+	if footers := t.footers(); len(footers) != 0 {
+		w.WriteString("\n```\n")
+		for _, f := range footers {
+			w.WriteString(f)
+			w.WriteString("\n")
+		}
+		w.WriteString("```\n")
+	}
+	w.WriteString("\n")
+}
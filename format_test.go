@@ -0,0 +1,87 @@
+package pgdesc
+
+import (
+	"testing"
+)
+
+func TestPrintFormats(t *testing.T) {
+	var tests []printTest
+	for _, format := range formats {
+		if format == FormatAligned || format == FormatWrapped {
+			continue
+		}
+		for _, table := range []string{"objects", "empty", "escapes"} {
+			tests = append(tests, printTest{string(format) + "_" + table, table, PrintOptions{Format: format}})
+		}
+	}
+	tests = append(tests, []printTest{
+		{"unaligned_separators", "objects", PrintOptions{Format: FormatUnaligned, FieldSep: ",", RecordSep: ";"}},
+		{"unaligned_no_footers", "title", PrintOptions{Format: FormatUnaligned}},
+		{"csv_tab", "escapes", PrintOptions{Format: FormatCSV, CSVFieldSep: '\t'}},
+		{"csv_dot", "objects", PrintOptions{Format: FormatCSV, CSVFieldSep: '.'}},
+		{"csv_backslash", "objects", PrintOptions{Format: FormatCSV, CSVFieldSep: '\\'}},
+		{"html_footers", "footers", PrintOptions{Format: FormatHTML}},
+		{"latex_footers", "footers", PrintOptions{Format: FormatLaTeX}},
+		{"asciidoc_footers", "footers", PrintOptions{Format: FormatAsciidoc}},
+	}...)
+	testPrint(t, tests)
+}
+
+func TestCSVField(t *testing.T) {
+	tests := []struct {
+		s   string
+		sep byte
+		exp string
+	}{
+		{``, ',', ``},
+		{`abc`, ',', `abc`},
+		{`a,b`, ',', `"a,b"`},
+		{`a,b`, ';', `a,b`},
+		{`a;b`, ';', `"a;b"`},
+		{`a"b`, ',', `"a""b"`},
+		{`"`, ',', `""""`},
+		{"a\nb", ',', "\"a\nb\""},
+		{"a\rb", ',', "\"a\rb\""},
+		{`\.`, ',', `"\."`},
+		{`\.x`, ',', `\.x`},
+		{`abc`, '.', `"abc"`},
+		{`abc`, '\\', `"abc"`},
+		{"a\tb", '\t', "\"a\tb\""},
+	}
+	for _, test := range tests {
+		if s := csvField(test.s, test.sep); s != test.exp {
+			t.Errorf("csvField(%q, %q) expected %q, got: %q", test.s, test.sep, test.exp, s)
+		}
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		s   string
+		exp Format
+		err string
+	}{
+		{"aligned", FormatAligned, ""},
+		{"u", FormatUnaligned, ""},
+		{"html", FormatHTML, ""},
+		{"latex", FormatLaTeX, ""},
+		{"latex-l", FormatLaTeXLongtable, ""},
+		{"w", FormatWrapped, ""},
+		{"t", FormatTroffMS, ""},
+		{"a", "", "\\pset: ambiguous abbreviation \"a\" matches both \"aligned\" and \"asciidoc\"\n"},
+		{"l", "", "\\pset: ambiguous abbreviation \"l\" matches both \"latex\" and \"latex-longtable\"\n"},
+		{"", "", "\\pset: allowed formats are aligned, asciidoc, csv, html, json, latex, latex-longtable, markdown, troff-ms, unaligned, wrapped\n"},
+		{"xml", "", "\\pset: allowed formats are aligned, asciidoc, csv, html, json, latex, latex-longtable, markdown, troff-ms, unaligned, wrapped\n"},
+	}
+	for _, test := range tests {
+		f, err := ParseFormat(test.s)
+		switch {
+		case test.err != "" && (err == nil || err.Error() != test.err):
+			t.Errorf("ParseFormat(%q) expected error %q, got: %v", test.s, test.err, err)
+		case test.err == "" && err != nil:
+			t.Errorf("ParseFormat(%q) expected no error, got: %v", test.s, err)
+		case f != test.exp:
+			t.Errorf("ParseFormat(%q) expected %q, got: %q", test.s, test.exp, f)
+		}
+	}
+}
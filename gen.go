@@ -1,14 +1,27 @@
+//go:build ignore
 // +build ignore
 
 // Command gen handles automatically generating code (pgdesc.go) from the postgres source.
 //
 // It works by downloading (and caching) files in the source, extracting the
-// appropriate code blocks and applying simple text replacement and regexp
-// replacements, before formatting the code.
+// appropriate funcs from describe.c, and translating them to Go, before
+// formatting the code. The funcs are tokenized and parsed into a syntax tree
+// for the subset of C used by describe.c, which is then translated statement
+// by statement. Constructs that cannot be translated are reported as errors
+// with their position in describe.c, instead of producing broken Go code.
+//
+// The source is retrieved for the tag or branch given by -ref (ie,
+// REL_16_STABLE), which is first resolved to a commit, so that regenerating
+// is reproducible. Alternately, -src can be used to regenerate offline from a
+// local postgres checkout or a source tarball. The source revision is
+// recorded in the header of the generated files.
 package main
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
 	"encoding/base64"
 	"errors"
 	"flag"
@@ -18,9 +31,11 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -29,22 +44,36 @@ import (
 )
 
 const (
-	postgresSrc = "https://raw.githubusercontent.com/postgres/postgres/master/"
-
-	pgcasthURL       = postgresSrc + "src/include/catalog/pg_cast.h"
-	pgclasshURL      = postgresSrc + "src/include/catalog/pg_class.h"
-	pgdefaultaclhURL = postgresSrc + "src/include/catalog/pg_default_acl.h"
-	helpcURL         = postgresSrc + "src/bin/psql/help.c"
-	describehURL     = postgresSrc + "src/bin/psql/describe.h"
-	describecURL     = postgresSrc + "src/bin/psql/describe.c"
-	//chURL        = postgresSrc + "src/include/c.h"
+	postgresRaw     = "https://raw.githubusercontent.com/postgres/postgres/"
+	postgresCommits = "https://api.github.com/repos/postgres/postgres/commits/"
+
+	pgamh           = "src/include/catalog/pg_am.h"
+	pgattributeh    = "src/include/catalog/pg_attribute.h"
+	pgcasth         = "src/include/catalog/pg_cast.h"
+	pgclassh        = "src/include/catalog/pg_class.h"
+	pgcollationh    = "src/include/catalog/pg_collation.h"
+	pgconstrainth   = "src/include/catalog/pg_constraint.h"
+	pgdefaultaclh   = "src/include/catalog/pg_default_acl.h"
+	pgproch         = "src/include/catalog/pg_proc.h"
+	pgstatisticexth = "src/include/catalog/pg_statistic_ext.h"
+	pgtriggerh      = "src/include/catalog/pg_trigger.h"
+	pgtypeh         = "src/include/catalog/pg_type.h"
+	helpc           = "src/bin/psql/help.c"
+	describeh       = "src/bin/psql/describe.h"
+	describec       = "src/bin/psql/describe.c"
+	configureac     = "configure.ac"
+	//ch        = "src/include/c.h"
 )
 
 var (
+	flagRef   = flag.String("ref", "REL_17_STABLE", "postgres tag or branch to retrieve (ie, REL_16_STABLE)")
+	flagSrc   = flag.String("src", "", "local postgres checkout or source tarball to use instead of retrieving")
 	flagTTL   = flag.Duration("ttl", 24*time.Hour, "file cache time")
 	flagCache = flag.String("cache", "", "cache path")
-	flagOut   = flag.String("o", filepath.Join(os.Getenv("GOPATH"), "src/github.com/xo/pgdesc/pgdesc.go"), "out")
+	flagOut   = flag.String("o", "pgdesc.go", "out")
 	flagDebug = flag.Bool("debug", false, "enable debugging")
+
+	flagHelpOut = flag.String("help-out", "pghelp.go", "help out")
 )
 
 func main() {
@@ -66,60 +95,32 @@ func run() error {
 		*flagCache = filepath.Join(cacheDir, "pgdesc")
 	}
 
-	consts := make(map[string][2]string)
-
-	// load R* constants in pg_class.h
-	buf, err := get(cache{
-		url:  pgclasshURL,
-		path: filepath.Join(*flagCache, "pg_class.h"),
-		ttl:  *flagTTL,
-	})
-	if err != nil {
-		return err
-	}
-	if err = loadConsts(buf, "R", consts); err != nil {
-		return err
-	}
-
-	// load R* constants in pg_default_acl.h
-	buf, err = get(cache{
-		url:  pgdefaultaclhURL,
-		path: filepath.Join(*flagCache, "pg_default_acl.h"),
-		ttl:  *flagTTL,
-	})
-	if err != nil {
-		return err
-	}
-	if err = loadConsts(buf, "D", consts); err != nil {
-		return err
-	}
-
-	// load COERCION_* consts in pg_cast.h
-	buf, err = get(cache{
-		url:  pgcasthURL,
-		path: filepath.Join(*flagCache, "pg_cast.h"),
-		ttl:  *flagTTL,
-	})
+	// open source
+	src, err := openSource()
 	if err != nil {
 		return err
 	}
-	if err = loadCharConsts(buf, "COERCION", consts); err != nil {
-		return err
-	}
+	logf("SOURCE: %s", src.revision())
 
-	// clean up consts
-	for k := range consts {
-		if strings.HasPrefix(k, "REPLICA_") {
-			delete(consts, k)
+	// load catalog constants
+	consts := make(map[string][2]string)
+	for _, c := range catalogConsts {
+		buf, err := src.read(c.path)
+		if err != nil {
+			return err
+		}
+		if c.enum {
+			err = loadCharConsts(buf, c.prefixes, consts)
+		} else {
+			err = loadConsts(buf, c.prefixes, consts)
+		}
+		if err != nil {
+			return err
 		}
 	}
 
 	// load \d* comments in describe.h
-	buf, err = get(cache{
-		url:  describehURL,
-		path: filepath.Join(*flagCache, "describe.h"),
-		ttl:  *flagTTL,
-	})
+	buf, err := src.read(describeh)
 	if err != nil {
 		return err
 	}
@@ -129,11 +130,7 @@ func run() error {
 	}
 
 	// load \d* help text in help.c
-	buf, err = get(cache{
-		url:  helpcURL,
-		path: filepath.Join(*flagCache, "help.c"),
-		ttl:  *flagTTL,
-	})
+	buf, err = src.read(helpc)
 	if err != nil {
 		return err
 	}
@@ -141,19 +138,18 @@ func run() error {
 	if err != nil {
 		return err
 	}
+	if err = writeHelp(help, src.revision()); err != nil {
+		return err
+	}
 
 	logf("consts: %d, comments: %d, help: %d", len(consts), len(comments), len(help))
 
 	// convert describe.c
-	buf, err = get(cache{
-		url:  describecURL,
-		path: filepath.Join(*flagCache, "describe.c"),
-		ttl:  *flagTTL,
-	})
+	buf, err = src.read(describec)
 	if err != nil {
 		return err
 	}
-	err = convertDescribe(buf, consts, comments, help)
+	err = convertDescribe(buf, consts, comments, src.revision())
 	if err != nil {
 		return err
 	}
@@ -161,36 +157,106 @@ func run() error {
 	return nil
 }
 
-// loadConsts extracts the <typ>_* constants in buf.
-func loadConsts(buf []byte, typ string, consts map[string][2]string) error {
-	defineRE := regexp.MustCompile(`#define\s+(` + typ + `[A-Z_]+)\s+(.*)`)
-	for _, m := range defineRE.FindAllStringSubmatch(string(buf), -1) {
+// catalogConsts are the catalog headers, and the prefixes of the constants
+// to load from them. Constants in enums (ie, COERCION_*) are loaded with
+// loadCharConsts.
+var catalogConsts = []struct {
+	path     string
+	prefixes []string
+	enum     bool
+}{
+	{pgamh, []string{"AMTYPE_"}, false},
+	{pgattributeh, []string{"ATTRIBUTE_IDENTITY_", "ATTRIBUTE_GENERATED_"}, false},
+	{pgcasth, []string{"COERCION_"}, true},
+	{pgclassh, []string{"RELKIND_", "RELPERSISTENCE_"}, false},
+	{pgcollationh, []string{"COLLPROVIDER_"}, false},
+	{pgconstrainth, []string{"CONSTRAINT_"}, false},
+	{pgdefaultaclh, []string{"DEFACLOBJ_"}, false},
+	{pgproch, []string{"PROKIND_", "PROVOLATILE_", "PROPARALLEL_", "PROARGMODE_"}, false},
+	{pgstatisticexth, []string{"STATS_EXT_"}, false},
+	{pgtriggerh, []string{"TRIGGER_TYPE_"}, false},
+	{pgtypeh, []string{"TYPTYPE_"}, false},
+}
+
+// enumTypes are the named types generated for the catalog constants with
+// the prefix, and the catalog column holding the values.
+var enumTypes = []struct {
+	prefix string
+	typ    string
+	column string
+	desc   string
+}{
+	{"COERCION_CODE_", "CoercionContext", "pg_cast.castcontext", "a cast's coercion context"},
+	{"COERCION_METHOD_", "CoercionMethod", "pg_cast.castmethod", "a cast's coercion method"},
+	{"DEFACLOBJ_", "DefACLObjType", "pg_default_acl.defaclobjtype", "a default ACL's object type"},
+	{"RELKIND_", "RelKind", "pg_class.relkind", "a relation's kind"},
+	{"RELPERSISTENCE_", "RelPersistence", "pg_class.relpersistence", "a relation's persistence"},
+}
+
+// enumNames overrides the names of the enum constants, which are otherwise
+// derived from the constant (ie, RELKIND_PARTITIONED_TABLE is "partitioned
+// table"). The names match those displayed by psql.
+var enumNames = map[string]string{
+	"DEFACLOBJ_LARGEOBJECT": "large object",
+	"DEFACLOBJ_NAMESPACE":   "schema",
+	"DEFACLOBJ_RELATION":    "table",
+	"RELKIND_MATVIEW":       "materialized view",
+	"RELKIND_RELATION":      "table",
+	"RELKIND_TOASTVALUE":    "TOAST table",
+	"RELPERSISTENCE_TEMP":   "temporary",
+}
+
+// constValueRE is a regexp matching the values of the constants that can be
+// loaded: a char, or an integer expression of integers and other
+// constants (ie, (1 << 2), (TRIGGER_TYPE_BEFORE | TRIGGER_TYPE_INSTEAD)).
+var constValueRE = regexp.MustCompile(`^(?:'.'|[()0-9A-Z_<|& \t]+)$`)
+
+// loadConsts extracts the #define constants with the prefixes in buf.
+//
+// Char values are stored quoted (ie, 'r'), and integer expressions as is.
+func loadConsts(buf []byte, prefixes []string, consts map[string][2]string) error {
+	// join continued lines
+	src := strings.Replace(string(buf), "\\\n", " ", -1)
+	defineRE := regexp.MustCompile(`(?m)^#define\s+((?:` + strings.Join(prefixes, "|") + `)[A-Z0-9_]+)\s+(.*)$`)
+	for _, m := range defineRE.FindAllStringSubmatch(src, -1) {
 		v, comment := m[2], ""
-		if i := strings.IndexAny(v, " \t"); i != -1 {
-			comment, v = strings.TrimSpace(v[i:]), v[:i]
+		if i := strings.Index(v, "/*"); i != -1 {
+			comment, v = v[i:], v[:i]
 		}
-		v = strings.Replace(v, "'", "", -1)
-		comment = strings.TrimSpace(strings.TrimPrefix(strings.TrimSuffix(comment, "*/"), "/*"))
+		v = strings.Join(strings.Fields(v), " ")
+		if !constValueRE.MatchString(v) {
+			logf("SKIPPING: %s (unsupported value %q)", m[1], v)
+			continue
+		}
+		comment = strings.TrimSpace(strings.TrimPrefix(strings.TrimSuffix(strings.TrimSpace(comment), "*/"), "/*"))
 		consts[m[1]] = [2]string{v, comment}
 	}
 	return nil
 }
 
-// loadCharConsts extracts the <typ>_* constants in buf.
-func loadCharConsts(buf []byte, typ string, consts map[string][2]string) error {
-	defineRE := regexp.MustCompile(`\s+(` + typ + `[A-Z_]+)\s+=\s+(.*)`)
+// loadCharConsts extracts the enum constants with the prefixes in buf.
+func loadCharConsts(buf []byte, prefixes []string, consts map[string][2]string) error {
+	defineRE := regexp.MustCompile(`\s+((?:` + strings.Join(prefixes, "|") + `)[A-Z0-9_]+)\s+=\s+(.*)`)
 	for _, m := range defineRE.FindAllStringSubmatch(string(buf), -1) {
 		v, comment := strings.Replace(m[2], ",", "", -1), ""
 		if i := strings.IndexAny(v, " \t"); i != -1 {
 			comment, v = strings.TrimSpace(v[i:]), v[:i]
 		}
-		v = strings.Replace(v, "'", "", -1)
+		if !constValueRE.MatchString(v) {
+			logf("SKIPPING: %s (unsupported value %q)", m[1], v)
+			continue
+		}
 		comment = strings.TrimSpace(strings.TrimPrefix(strings.TrimSuffix(comment, "*/"), "/*"))
 		consts[m[1]] = [2]string{v, comment}
 	}
 	return nil
 }
 
+// isCharConst returns whether or not the constant value v is a char.
+func isCharConst(v [2]string) bool {
+	return strings.HasPrefix(v[0], "'")
+}
+
 // describeCommentRE is a regexp matching the declarations in describe.h.
 var describeCommentRE = regexp.MustCompile(`/\*(.*)\*/\n([a-zA-Z \t]+)`)
 
@@ -205,59 +271,178 @@ func loadDescribeComments(buf []byte) (map[string]string, error) {
 	return comments, nil
 }
 
-// helpTextRE is a regexp matching help text in help.c.
-var helpTextRE = regexp.MustCompile(`_\(([^)]+)\)`)
+// helpPrintRE is a regexp matching the translated string literals printed in
+// help.c.
+var helpPrintRE = regexp.MustCompile(`(?s)fprintf\(output,\s*_\(((?:"(?:[^"\\]|\\.)*"\s*)+)\)`)
+
+// helpStringRE is a regexp matching a C string literal.
+var helpStringRE = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"`)
+
+// helpSection is a section of the meta-command help text in help.c.
+type helpSection struct {
+	title    string
+	commands []helpCommand
+}
+
+// helpCommand is a meta-command's help text in help.c.
+type helpCommand struct {
+	name, syntax, description, text string
+}
 
-// loadHelp extracts the command help text in help.c.
-func loadHelp(buf []byte) (map[string]string, error) {
-	// trim buf to
-	start := bytes.Index(buf, []byte("Informational\\n"))
+// loadHelp extracts the meta-command help text in help.c's slashUsage.
+func loadHelp(buf []byte) ([]helpSection, error) {
+	// trim buf to slashUsage
+	start := bytes.Index(buf, []byte("\nslashUsage("))
 	if start == -1 {
-		return nil, errors.New("could not find start of \"Informational\" section in help text")
+		return nil, errors.New("could not find start of slashUsage in help text")
 	}
 	buf = buf[start:]
-	end := bytes.Index(buf, []byte("\n\n"))
+	end := bytes.Index(buf, []byte("\n}\n"))
 	if end == -1 {
-		return nil, errors.New("could not find end of Informational section in help text")
+		return nil, errors.New("could not find end of slashUsage in help text")
 	}
 	buf = buf[:end]
 
-	return nil, nil
+	var sections []helpSection
+	for _, m := range helpPrintRE.FindAllSubmatch(buf, -1) {
+		// concatenate and unescape the string literals
+		var text string
+		for _, lit := range helpStringRE.FindAllSubmatch(m[1], -1) {
+			text += unescapeC(string(lit[1]))
+		}
+
+		// section title
+		if !strings.HasPrefix(text, " ") {
+			sections = append(sections, helpSection{
+				title: strings.TrimSuffix(text, "\n"),
+			})
+			continue
+		}
+		if len(sections) == 0 {
+			return nil, fmt.Errorf("help text %q is not in a section", text)
+		}
+
+		section := &sections[len(sections)-1]
+		for _, cmd := range parseHelpText(text) {
+			// skip alternatives (ie, \c without a connection)
+			var dupe bool
+			for _, c := range section.commands {
+				dupe = dupe || (c.syntax == cmd.syntax && c.name != "")
+			}
+			if !dupe {
+				section.commands = append(section.commands, cmd)
+			}
+		}
+	}
+	if len(sections) == 0 {
+		return nil, errors.New("could not find any sections in help text")
+	}
+
+	return sections, nil
+}
+
+// parseHelpText parses the lines of a help text into commands.
+//
+// Command lines are formatted as "  SYNTAX  DESCRIPTION", with the
+// description starting at column 25 (or 26, when the syntax is 23
+// characters wide). Longer syntax lines have no description. Description
+// continuation lines are indented 25 spaces.
+// Other lines (ie, "  (options: ...)") are notes.
+func parseHelpText(text string) []helpCommand {
+	var cmds []helpCommand
+	for _, line := range strings.SplitAfter(text, "\n") {
+		if line == "" {
+			continue
+		}
+		l := strings.TrimSuffix(line, "\n")
+		switch {
+		case strings.HasPrefix(l, strings.Repeat(" ", 25)) && len(cmds) != 0:
+			// description continuation
+			c := &cmds[len(cmds)-1]
+			if c.description != "" {
+				c.description += "\n"
+			}
+			c.description += strings.TrimSpace(l)
+			c.text += line
+		case strings.HasPrefix(l, "  \\"):
+			i := len(l)
+			switch {
+			case len(l) > 25 && l[24] == ' ':
+				i = 25
+			case len(l) > 26 && l[25] == ' ':
+				i = 26
+			}
+			syntax := strings.TrimSpace(l[2:i])
+			name := syntax[1:]
+			if j := strings.IndexAny(name, " [{"); j > 0 {
+				name = name[:j]
+			}
+			cmds = append(cmds, helpCommand{
+				name:        name,
+				syntax:      syntax,
+				description: strings.TrimSpace(l[i:]),
+				text:        line,
+			})
+		default:
+			// note
+			cmds = append(cmds, helpCommand{
+				description: strings.TrimSpace(l),
+				text:        line,
+			})
+		}
+	}
+	return cmds
+}
+
+// unescapeC unescapes a C string literal.
+func unescapeC(s string) string {
+	return strings.NewReplacer(`\\`, `\`, `\"`, `"`, `\n`, "\n", `\t`, "\t").Replace(s)
+}
+
+// writeHelp writes the help sections as Go code to the help out file.
+func writeHelp(sections []helpSection, rev string) error {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, helpStart, rev)
+	for _, section := range sections {
+		fmt.Fprintf(buf, "\t{\n\t\tTitle: %q,\n\t\tCommands: []HelpCommand{\n", section.title)
+		for _, c := range section.commands {
+			fmt.Fprintf(buf, "\t\t\t{Name: %q, Syntax: %q, Description: %q, Text: %q},\n", c.name, c.syntax, c.description, c.text)
+		}
+		buf.WriteString("\t\t},\n\t},\n")
+	}
+	buf.WriteString("}\n")
+
+	// write to disk and bail
+	if *flagDebug {
+		return ioutil.WriteFile(*flagHelpOut, buf.Bytes(), 0644)
+	}
+
+	// format via imports
+	dst, err := imports.Process(*flagHelpOut, buf.Bytes(), nil)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(*flagHelpOut, dst, 0644)
 }
 
 // convertDescribe converts describe.c into a Go equivalent.
-func convertDescribe(src []byte, consts map[string][2]string, funcs map[string]string, help map[string]string) error {
+func convertDescribe(src []byte, consts map[string][2]string, funcs map[string]string, rev string) error {
 	var err error
 
 	// setup file
 	buf := new(bytes.Buffer)
-	if err = addHeader(buf, consts); err != nil {
+	if err = addHeader(buf, consts, rev); err != nil {
 		return err
 	}
 
-	// add additional funcs
-	funcs["describeOneTSConfig"] = "[none]"
-	funcs["describeOneTSParser"] = "[none]"
-	funcs["listOneExtensionContents"] = "[none]"
-	funcs["listTSConfigsVerbose"] = "[none]"
-	funcs["listTSParsersVerbose"] = "[none]"
-
-	// add comments to additional funcs
-	src = bytes.Replace(src,
-		[]byte("static bool\ndescribeOneTSConfig"),
-		[]byte("/*\n * show one description of text search config\n */\nstatic bool\ndescribeOneTSConfig"), -1)
-	src = bytes.Replace(src,
-		[]byte("static bool\ndescribeOneTSParser"),
-		[]byte("/*\n * show one description of text search parser\n */\nstatic bool\ndescribeOneTSParser"), -1)
-	src = bytes.Replace(src,
-		[]byte("static bool\nlistOneExtensionContents"),
-		[]byte("/*\n * show one extension contents\n */\nstatic bool\nlistOneExtensionContents"), -1)
-	src = bytes.Replace(src,
-		[]byte("static bool\nlistTSConfigsVerbose"),
-		[]byte("/*\n * full description of configs\n */\nstatic bool\nlistTSConfigsVerbose"), -1)
+	// remove manually translated funcs
+	for n := range manualFuncs {
+		delete(funcs, n)
+	}
 
 	// generate funcs
-	if err = generateFuncs(buf, src, funcs, help); err != nil {
+	if err = generateFuncs(buf, src, consts, funcs); err != nil {
 		return err
 	}
 
@@ -275,8 +460,33 @@ func convertDescribe(src []byte, consts map[string][2]string, funcs map[string]s
 	return ioutil.WriteFile(*flagOut, dst, 0644)
 }
 
+// manualFuncs are the funcs in describe.c that are translated by hand, and
+// the file containing the hand written func. These funcs are not generated.
+var manualFuncs = map[string]string{
+	"describeConfigurationParameters": "manual.go",
+	"describeOneTSConfig":             "textsearch.go",
+	"describeOneTSParser":             "textsearch.go",
+	"describeRoleGrants":              "manual.go",
+	"listExtendedStats":               "manual.go",
+	"listExtensionContents":           "extensions.go",
+	"listOneExtensionContents":        "extensions.go",
+	"listPartitionedTables":           "manual.go",
+	"listTSConfigsVerbose":            "textsearch.go",
+	"listTSParsersVerbose":            "textsearch.go",
+}
+
+// queryOnlyFuncs are the funcs whose results are processed by hand written
+// code (using psql's printTable API, which is not translated), and the hand
+// written func. Only the first query of these funcs is generated.
+var queryOnlyFuncs = map[string]string{
+	"describeRoles":        "PrintRoles",
+	"describePublications": "GetPublicationDetails",
+	"describeTableDetails": "GetTableDetails",
+	"permissionsList":      "PrintPermissions",
+}
+
 // addHeader adds the beginning of the Go file.
-func addHeader(w io.Writer, consts map[string][2]string) error {
+func addHeader(w io.Writer, consts map[string][2]string, rev string) error {
 	keys := make([]string, len(consts))
 	var i int
 	for k := range consts {
@@ -285,462 +495,2852 @@ func addHeader(w io.Writer, consts map[string][2]string) error {
 	}
 	sort.Strings(keys)
 
-	var str string
+	// split the enum constants
+	enums := make([][]string, len(enumTypes))
+	var names []string
+loop:
 	for _, n := range keys {
+		for i, typ := range enumTypes {
+			if strings.HasPrefix(n, typ.prefix) && isCharConst(consts[n]) {
+				enums[i] = append(enums[i], n)
+				continue loop
+			}
+		}
+		names = append(names, n)
+	}
+
+	str := constBlock(names, "", consts)
+	var enumstr string
+	for i, typ := range enumTypes {
+		if len(enums[i]) != 0 {
+			enumstr += genEnum(typ.typ, typ.prefix, typ.column, typ.desc, enums[i], consts)
+		}
+	}
+
+	_, err := fmt.Fprintf(w, start, rev, str, enumstr)
+	return err
+}
+
+// constBlock generates the aligned declarations of the constants names, with
+// the type typ.
+func constBlock(names []string, typ string, consts map[string][2]string) string {
+	var width int
+	for _, n := range names {
+		width = max(width, len(n))
+	}
+	if typ != "" {
+		typ = " " + typ
+	}
+	var str string
+	for _, n := range names {
 		c := consts[n]
 		var comment string
 		if c[1] != "" {
 			comment = " // " + c[1]
 		}
-		str += fmt.Sprintf("\n\t%s = '%s'%s", n, c[0], comment)
+		str += fmt.Sprintf("\n\t%-*s%s = %s%s", width, n, typ, c[0], comment)
 	}
+	return str
+}
 
-	_, err := fmt.Fprintf(w, start, str)
-	return err
+// enumName returns the name of the enum constant n, with the prefix trimmed.
+func enumName(n, prefix string) string {
+	if name, ok := enumNames[n]; ok {
+		return name
+	}
+	return strings.ToLower(strings.Replace(strings.TrimPrefix(n, prefix), "_", " ", -1))
+}
+
+// genEnum generates the named type typ for the enum constants names, and
+// its String, Valid, and Parse funcs.
+func genEnum(typ, prefix, column, desc string, names []string, consts map[string][2]string) string {
+	var cases, strs, parses string
+	for i, n := range names {
+		name := enumName(n, prefix)
+		v := consts[n][0]
+		cases += n
+		if i != len(names)-1 {
+			cases += ",\n\t\t"
+		}
+		strs += fmt.Sprintf("\n\tcase %s:\n\t\treturn %q", n, name)
+		parses += fmt.Sprintf("\n\tcase %q, %q:\n\t\treturn %s, nil", v[1:len(v)-1], name, n)
+	}
+	return fmt.Sprintf(enumTemplate,
+		typ, desc, column, typ,
+		typ, constBlock(names, typ, consts),
+		typ, strs, typ,
+		typ, typ, cases,
+		typ, typ, column, typ, typ, parses, column[strings.Index(column, ".")+1:],
+	)
 }
 
 // generateFuncs generates the func bodies for the converted funcs.
-func generateFuncs(w io.Writer, src []byte, funcs, help map[string]string) error {
+//
+// All funcs are translated before returning, so that every unsupported
+// construct is reported.
+func generateFuncs(w io.Writer, src []byte, consts map[string][2]string, funcs map[string]string) error {
 	var keys []string
-	funcMap := make(map[string]string)
+	funcMap, names := make(map[string]string), make(map[string]string)
 	var maxnamelen, maxnlen int
 	for n := range funcs {
-		// chop list, describe, All prefixes + List suffix
-		// TS => TextSearch
-		// Db => Database
-		// force to Go identifier
-		name := n
-		if n == "describePublications" {
-			name = "PublicationDetails"
-		} else {
-			for _, s := range []string{"list", "describe", "All"} {
-				name = strings.TrimPrefix(name, s)
-			}
-			for _, s := range []string{"List"} {
-				name = strings.TrimSuffix(name, s)
-			}
-		}
-		name = strings.Replace(name, "TS", "TextSearch", -1)
-		name = strings.Replace(name, "Db", "Database", -1)
-		name = snaker.ForceCamelIdentifier(name)
+		name := goFuncName(n)
 
 		maxnamelen = max(maxnamelen, len(name))
 		maxnlen = max(maxnlen, len(n))
 
-		if _, ok := funcMap[name]; ok {
-			panic(fmt.Sprintf("%s already present in func map", name))
+		if orig, ok := funcMap[name]; ok {
+			return fmt.Errorf("%s and %s both generate %s", orig, n, name)
 		}
 
-		funcMap[name] = n
+		funcMap[name], names[n] = n, name
 		keys = append(keys, name)
 	}
 	sort.Strings(keys)
 
-	// safety check
-	if len(funcs) != len(keys) {
-		panic("number of funcs must equal number of keys")
+	// the manually translated funcs can be called by the generated funcs
+	for n := range manualFuncs {
+		names[n] = goFuncName(n)
 	}
 
 	// generate
-	var err error
+	var errs []error
 	for _, name := range keys {
 		n := funcMap[name]
 		comment := funcs[n]
 
 		logf("GENERATING: %s => %s [%s]", pad(name, maxnamelen), pad(n, maxnlen), comment)
-		if err = genFunc(w, src, name, n, comment); err != nil {
-			return err
+		if err := genFunc(w, src, names, consts, name, n, comment); err != nil {
+			errs = append(errs, err)
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
-// lastBlockMap is a map of last block strings to search for, for specific funcs.
-var lastBlockMap = map[string]string{
-	"describeOneTSConfig":      "Dictionaries",
-	"describeOneTSParser":      "Get token types",
-	"describePublications":     `"ORDER BY 2;");`,
-	"describeRoles":            `/* END */`,
-	"listDefaultACLs":          "ORDER BY 1",
-	"listOneExtensionContents": "oid)",
-	"permissionsList":          "ORDER BY 1",
+// goFuncName returns the Go name of the describe.c func n.
+func goFuncName(n string) string {
+	// chop list, describe, All prefixes + List suffix
+	// TS => TextSearch
+	// Db => Database
+	// force to Go identifier
+	name := n
+	if n == "describePublications" {
+		name = "PublicationDetails"
+	} else {
+		for _, s := range []string{"list", "describe", "All"} {
+			name = strings.TrimPrefix(name, s)
+		}
+		for _, s := range []string{"List"} {
+			name = strings.TrimSuffix(name, s)
+		}
+	}
+	name = strings.Replace(name, "TS", "TextSearch", -1)
+	name = strings.Replace(name, "Db", "Database", -1)
+	return snaker.ForceCamelIdentifier(name)
 }
 
 // genFunc extracts func with name orig from src and writes a Go equivalent to
 // w.
-func genFunc(w io.Writer, src []byte, name, orig, comment string) error {
-	start := bytes.Index(src, []byte("\n"+orig+"("))
-	if start == -1 {
-		panic(fmt.Sprintf("cannot find start of block %s", orig))
+func genFunc(w io.Writer, src []byte, names map[string]string, consts map[string][2]string, name, orig, comment string) error {
+	funcComment, body, line, err := findFunc(src, orig)
+	if err != nil {
+		return err
 	}
 
-	returnTypeStart := bytes.LastIndex(src[:start], []byte("\n"))
-	if returnTypeStart == -1 {
-		panic(fmt.Sprintf("cannot find return type start for %s", orig))
+	// parse
+	toks, err := tokenize(describec, body, line)
+	if err != nil {
+		return err
 	}
-
-	returnType := strings.TrimSpace(string(src[returnTypeStart:start]))
-	if returnType != "bool" && returnType != "static bool" {
-		panic(fmt.Sprintf("return type for %s is not bool, has: %q", orig, returnType))
+	f, err := parseFunc(describec, toks)
+	if err != nil {
+		return err
 	}
-
-	funcCommentStart := bytes.LastIndex(src[:returnTypeStart], []byte("\n\n"))
-	if funcCommentStart == -1 {
-		panic(fmt.Sprintf("cannot find comment start for %s", orig))
+	if f.name != orig {
+		return fmt.Errorf("%s:%d: expected func %s, found %s", describec, line, orig, f.name)
 	}
 
-	funcComment := strings.TrimSpace(string(src[funcCommentStart:returnTypeStart]))
-	if !strings.HasPrefix(funcComment, "/*") || !strings.HasSuffix(funcComment, "*/") {
-		panic(fmt.Sprintf("invalid comment block for %s", orig))
+	// translate
+	t := &translator{
+		file:   describec,
+		names:  names,
+		consts: consts,
+	}
+	t.queryOnly = queryOnlyFuncs[orig] != ""
+	code, err := t.translate(f)
+	if err != nil {
+		return err
 	}
 
-	funcComment = strings.Replace(funcComment, "/*", "//", -1)
-	funcComment = strings.Replace(funcComment, "*/", "", -1)
-	funcComment = strings.Replace(funcComment, "\n * ", "\n// ", -1)
-	funcComment = strings.Replace(funcComment, "\n *", "\n//", -1)
-	funcComment = strings.TrimSpace(funcComment)
-
-	src = src[start:]
-
-	// build params
-	paramStart := bytes.Index(src, []byte("("))
-	if paramStart == -1 {
-		panic(fmt.Sprintf("could not find start of parameter list for %s", orig))
+	// write func
+	doc := name + " handles " + strings.TrimSuffix(comment, ".") + ".\n//\n// Generated from " + orig + " in psql's describe.c."
+	if v := queryOnlyFuncs[orig]; v != "" {
+		doc += " Only the query is\n// generated, the result is processed by " + v + "."
 	}
-	paramEnd := bytes.Index(src, []byte(")"))
-	if paramEnd == -1 {
-		panic(fmt.Sprintf("could not find end of parameter list for %s", orig))
+	if funcComment != "" {
+		doc += "\n//\n" + goComment(funcComment)
 	}
-	paramList := spaceRE.ReplaceAllString(string(src[paramStart+1:paramEnd]), " ")
-	var params [][2]string
-	for _, p := range strings.Split(paramList, ", ") {
-		typ := p[:strings.LastIndex(p, " ")]
-		if typ == "const char" {
-			typ = "string"
-		}
-		n := strings.TrimPrefix(p[strings.LastIndex(p, " ")+1:], "*")
-		params = append(params, [2]string{n, typ})
+	fmt.Fprintf(w, "// %s\nfunc (d *PgDesc) %s(w io.Writer", doc, name)
+	for _, p := range t.params {
+		fmt.Fprintf(w, ", %s %s", p.name, p.typ)
+	}
+	fmt.Fprintf(w, ") error {\n%s}\n\n", code)
+
+	return nil
+}
+
+// findFunc returns the comment preceding the func orig in src, and the func's
+// source and starting line.
+func findFunc(src []byte, orig string) (string, []byte, int, error) {
+	i := bytes.Index(src, []byte("\n"+orig+"("))
+	if i == -1 {
+		return "", nil, 0, fmt.Errorf("%s: cannot find func %s", describec, orig)
 	}
-	// logf("PARAMS: %v", params)
 
-	end := bytes.Index(src, []byte("\n}\n"))
+	// the return type is on the preceding line
+	start := bytes.LastIndex(src[:i], []byte("\n")) + 1
+	end := bytes.Index(src[i:], []byte("\n}\n"))
 	if end == -1 {
-		panic(fmt.Sprintf("could not find end to code block for %s", orig))
+		return "", nil, 0, fmt.Errorf("%s: cannot find end of func %s", describec, orig)
 	}
-	start = bytes.Index(src, []byte("{"))
-	src = src[start+1 : end]
+	end += i + 3
 
-	// write start of func
-	fmt.Fprintf(w, "// %s\n%sfunc (d *PgDesc) %s(w io.Writer",
-		name+" handles "+strings.TrimSuffix(comment, ".")+".\n//\n// Generated from "+orig+" in psql's describe.c.",
-		funcComment+"\n", name,
-	)
-	for _, p := range params {
-		fmt.Fprintf(w, ", %s %s", p[0], p[1])
-	}
-	fmt.Fprint(w, ") error {\n")
-
-	// comment out first block (variable declarations)
-	declBlockEnd := bytes.Index(src, []byte("PQExpBufferData"))
-	if declBlockEnd == -1 {
-		panic(fmt.Sprintf("could not find PQExpBufferData for %s", orig))
-	}
-	declEndOffset := bytes.Index(src[declBlockEnd:], []byte("\n\n"))
-	if declEndOffset == -1 {
-		panic(fmt.Sprintf("could not find end of decl block for %s", orig))
-	}
-	declBlockEnd += declEndOffset
-	w.Write(fixDeclBlock(src[:declBlockEnd], orig))
-	src = src[declBlockEnd:]
-
-	// plain text replacements
-	src = bytes.Replace(src, []byte("gettext_noop"), []byte("GettextNoop"), -1)
-	src = bytes.Replace(src, []byte("&buf"), []byte("w"), -1)
-	src = bytes.Replace(src, []byte("appendPQExpBufferStr"), []byte("fmt.Fprint"), -1)
-	src = bytes.Replace(src, []byte("printfPQExpBuffer"), []byte("fmt.Fprintf"), -1)
-	src = bytes.Replace(src, []byte("appendPQExpBuffer"), []byte("fmt.Fprintf"), -1)
-	src = bytes.Replace(src, []byte("psql_error"), []byte("return fmt.Errorf"), -1)
-	src = bytes.Replace(src, []byte("pset.sversion"), []byte("d.version"), -1)
-	src = bytes.Replace(src, []byte("initPQExpBuffer"), []byte("// initPQExpBuffer"), -1)
-	src = bytes.Replace(src, []byte("myopt.default_footer = false"), []byte("// myopt.default_footer = false"), -1)
-
-	// replace additional func calls
-	src = bytes.Replace(src, []byte("describeOneTSConfig(oid"), []byte("d.OneTextSearchConfig(w, oid"), -1)
-	src = bytes.Replace(src, []byte("describeOneTSParser(oid"), []byte("d.OneTextSearchParser(w, oid"), -1)
-	src = bytes.Replace(src, []byte("listOneExtensionContents("), []byte("d.OneExtensionContents(w, "), -1)
-	src = bytes.Replace(src, []byte("listTSConfigsVerbose(pattern)"), []byte("d.TextSearchConfigsVerbose(w, pattern)"), -1)
-	src = bytes.Replace(src, []byte("listTSParsersVerbose(pattern)"), []byte("d.TextSearchParsersVerbose(w, pattern)"), -1)
-
-	// fix pattern
-	src = bytes.Replace(src, []byte("!pattern"), []byte(`pattern != NULL`), -1)
-	src = bytes.Replace(src, []byte("pattern && pattern2"), []byte("pattern != NULL && pattern2 != NULL"), -1)
-	src = bytes.Replace(src, []byte("(pattern)"), []byte("(pattern != NULL)"), -1)
-	src = bytes.Replace(src, []byte("|| pattern"), []byte("|| pattern != NULL"), -1)
-	src = bytes.Replace(src, []byte("pset.db, w, pattern"), []byte("w, pattern"), -1)
-
-	src = bytes.Replace(src, []byte("printACLColumn"), []byte("d.printACLColumn"), -1)
-	src = bytes.Replace(src, []byte("\tstatic const"), []byte("\t// static const"), -1)
-	src = bytes.Replace(src,
-		[]byte("showAggregate = showNormal = showTrigger = true"),
-		[]byte("showAggregate, showNormal, showTrigger = true, true, true"), -1)
-	src = bytes.Replace(src,
-		[]byte("showTables = showViews = showMatViews = showSeq = showForeign = true"),
-		[]byte("showTables, showViews, showMatViews, showSeq, showForeign = true, true, true, true, true"), -1)
-
-	// general regexp replacements
-	src = startParenRE.ReplaceAll(src, []byte(") {"))
-	src = charSverbufRE.ReplaceAll(src, []byte("// char sverbuf"))
-	src = formatPGVerRE.ReplaceAll(src, []byte("d.sversion"))
-	src = needsOrRE.ReplaceAll(src, []byte("var needs_or bool"))
-
-	// grouped replacements
-	src = returnBoolRE.ReplaceAll(src, []byte("// return $1"))
-	src = forRE.ReplaceAll(src, []byte("\tfor $1 := $2 {"))
-
-	// cpp string replacements (done before the fix for "\n below)
-	src = cppString1RE.ReplaceAll(src, []byte(`'"+string($1)+"'`))
-	src = cppString2RE.ReplaceAll(src, []byte(`"'"+string($1)+"'"`))
-	src = cppStringFixRE.ReplaceAll(src, []byte("$1"))
-
-	// fix missing + on strings at a line end
-	src = stringRE.ReplaceAll(src, []byte("\"+\n"))
-
-	// fix closing parens and strings
-	src = fixParensRE.ReplaceAll(src, []byte(`))`))
-	src = fixStringEndRE.ReplaceAll(src, []byte(`")`))
-
-	// listAllDbs is missing the standard blank line, so add one
-	if orig == "listAllDbs" {
-		src = bytes.Replace(src, []byte(`ORDER BY 1;");`), []byte("ORDER BY 1;\");\n\n"), -1)
-	}
-
-	// describeRoles has problems
-	if orig == "describeRoles" {
-		src = bytes.Replace(src, []byte(`ORDER BY 1;");`), []byte(`ORDER BY 1;");`+"\n\n/* END */\n\n"), 1)
-		src = bytes.Replace(src, []byte("ncols++"), []byte("// ncols++"), -1)
-	}
-
-	// find last block marker, comment out everything afterwards
-	lastBlockMarker := "fmt.Fprint"
-	if v, ok := lastBlockMap[orig]; ok {
-		lastBlockMarker = v
-	}
-	lastBlockEnd := bytes.LastIndex(src, []byte(lastBlockMarker))
-	if lastBlockEnd == -1 {
-		panic(fmt.Sprintf("cannot find last fmt.Fprint for %s", orig))
-	}
-	offset := bytes.Index(src[lastBlockEnd:], []byte("\n\n"))
-	if offset == -1 {
-		panic(fmt.Sprintf("cannot find empty line after last  fmt.Fprint for %s", orig))
-	}
-
-	// emit all lines to last block end
-	w.Write(fixCondBlocks(src[:lastBlockEnd+offset], orig))
-
-	// comment out remaining lines
-	src = src[lastBlockEnd+offset:]
-	src = bytes.Replace(src, []byte("\n\t"), []byte("\n\t// "), -1)
-	src = doubleCommentRE.ReplaceAll(src, []byte("// "))
-	w.Write(src)
-
-	fmt.Fprint(w, "\nreturn nil\n}\n\n")
+	var comment string
+	if j := bytes.LastIndex(src[:start], []byte("\n\n")); j != -1 {
+		if c := strings.TrimSpace(string(src[j:start])); strings.HasPrefix(c, "/*") && strings.HasSuffix(c, "*/") {
+			comment = c
+		}
+	}
 
-	return nil
+	return comment, src[start:end], bytes.Count(src[:start], []byte("\n")) + 1, nil
 }
 
-// various code regexps
-var (
-	spaceRE         = regexp.MustCompile(`\s+`)
-	startParenRE    = regexp.MustCompile(`\)\n\s*\{`)
-	charSverbufRE   = regexp.MustCompile(`char\s+sverbuf`)
-	formatPGVerRE   = regexp.MustCompile(`(?s)formatPGVersionNumber\(.*sizeof\([^\)]+\)\)`)
-	stringRE        = regexp.MustCompile(`"\n`)
-	doubleCommentRE = regexp.MustCompile(`//\s*//\s*`)
-	returnBoolRE    = regexp.MustCompile(`return\s+(true|false)`)
-	cppString1RE    = regexp.MustCompile(`" CppAsString2\(([^\)]+)\) "`)
-	cppString2RE    = regexp.MustCompile(`CppAsString2\(([^\)]+)\)`)
-	cppStringFixRE  = regexp.MustCompile(`([^\\])" "`)
-	needsOrRE       = regexp.MustCompile(`bool\s+needs_or\s+=\s+false`)
-	forRE           = regexp.MustCompile(`(?m)^\s+for\s+\(([a-z]+)\s+=\s+(.+?)\)\s+{$`)
-	fixParensRE     = regexp.MustCompile(`(?sm)\)\s*$\s*\)`)
-	fixStringEndRE  = regexp.MustCompile(`(?sm)"\s*\+\s*$\s*\)`)
-)
-
-// fixDeclBlock fixes the decl block.
-func fixDeclBlock(src []byte, orig string) []byte {
-	buf := new(bytes.Buffer)
-	for _, line := range bytes.Split(src, []byte("\n")) {
-		if m := boolVarRE.FindIndex(line); m != nil {
-			buf.Write(boolVarRE.ReplaceAll(line, []byte("$1 var $2 bool")))
-		} else if bytes.HasPrefix(line, []byte("\t")) && len(bytes.TrimSpace(line)) != 0 {
-			buf.Write([]byte("\t// "))
-			buf.Write(bytes.TrimPrefix(line, []byte("\t")))
+// goComment converts a C comment to a Go comment.
+func goComment(comment string) string {
+	comment = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(comment), "/*"), "*/")
+	lines := strings.Split(strings.TrimSpace(comment), "\n")
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "*" {
+			line = ""
+		} else if strings.HasPrefix(line, "* ") {
+			line = line[2:]
 		}
-		buf.Write([]byte("\n"))
+		lines[i] = strings.TrimRight("// "+line, " ")
 	}
-	return buf.Bytes()
+	return strings.Join(lines, "\n")
 }
 
-var (
-	boolVarRE = regexp.MustCompile(`^(\s+)bool\s+([a-zA-Z_]+)`)
+// posError is an error at a position in a C source file.
+type posError struct {
+	file      string
+	line, col int
+	msg       string
+}
+
+// Error satisfies the error interface.
+func (err *posError) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s", err.file, err.line, err.col, err.msg)
+}
+
+// tokenKind is the kind of a C token.
+type tokenKind int
+
+// C token kinds.
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokNumber
+	tokString
+	tokChar
+	tokPunct
 )
 
-// fixCondBlocks fixes conditional blocks in src.
-func fixCondBlocks(src []byte, orig string) []byte {
-	// fix dangling
-	src = ifFixRE.ReplaceAll(src, []byte("$1 {"))
-	src = elseFixRE.ReplaceAll(src, []byte("$1 {"))
-	src = fixElseLeftRE.ReplaceAll(src, []byte("} else"))
+// token is a C token.
+type token struct {
+	kind      tokenKind
+	text      string
+	line, col int
 
-	buf := new(bytes.Buffer)
-	for {
-		m := ifStartRE.FindIndex(src)
-		if m == nil {
-			buf.Write(src)
-			z := buf.Bytes()
-			z = fixElseLeftRE.ReplaceAll(z, []byte("} else"))
-			z = fixElseRightRE.ReplaceAll(z, []byte("else {"))
-			return fixDanglingElse(z, orig)
-		}
+	// comments are the comments preceding the token.
+	comments []string
+
+	// trailing are the comments following the preceding token on the same
+	// line.
+	trailing []string
 
-		// flush
-		buf.Write(src[:m[1]])
+	// blank is whether or not the token (or its comments) is preceded by a
+	// blank line.
+	blank bool
+}
 
-		src = src[m[1]:]
+// punctuators are the C punctuators, longest first.
+var punctuators = []string{
+	"<<=", ">>=", "...",
+	"->", "++", "--", "<<", ">>", "<=", ">=", "==", "!=", "&&", "||",
+	"+=", "-=", "*=", "/=", "%=", "&=", "|=", "^=",
+	"{", "}", "(", ")", "[", "]", ";", ",", ".", "?", ":",
+	"+", "-", "*", "/", "%", "&", "|", "^", "!", "~", "<", ">", "=",
+}
 
-		// find first terminating ;
-		m = ifSemicolonRE.FindIndex(src)
-		if m == nil {
-			panic(fmt.Sprintf("could not find end to if statement in %s", orig))
-		}
+// tokenize splits the C source src, starting at line, into tokens.
+func tokenize(file string, src []byte, line int) ([]token, error) {
+	s := string(src)
+	col := 1
+	errorf := func(format string, v ...interface{}) error {
+		return &posError{file: file, line: line, col: col, msg: fmt.Sprintf(format, v...)}
+	}
+	isIdent := func(c byte) bool {
+		return c == '_' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+	}
 
-		// add { and }
-		buf.Write([]byte(" {\n"))
-		buf.Write(bytes.TrimRight(bytes.TrimLeft(src[:m[1]], "\n"), "\n"))
-		buf.Write([]byte("\n}"))
-		src = src[m[1]:]
+	var toks []token
+	var comments, trailing []string
+	var newlines int
+	var blank, lineStart bool = false, true
+	for i := 0; ; {
+		if i >= len(s) {
+			return append(toks, token{kind: tokEOF, line: line, col: col, comments: comments, trailing: trailing, blank: blank}), nil
+		}
 
-		// check for else in src
-		m = elseRE.FindIndex(src)
-		if m == nil {
+		// whitespace
+		switch s[i] {
+		case '\n':
+			i, line, col, newlines, lineStart = i+1, line+1, 1, newlines+1, true
+			continue
+		case ' ', '\t', '\r', '\f', '\v':
+			i, col = i+1, col+1
 			continue
 		}
-
-		// flush
-		buf.Write(src[:m[1]])
-		src = src[m[1]:]
-
-		// find first terminating ;
-		m = ifSemicolonRE.FindIndex(src)
-		if m == nil {
-			panic(fmt.Sprintf("could not find end to else statement in %s", orig))
+		sameLine := newlines == 0 && len(toks) != 0 && len(comments) == 0
+		if newlines > 1 && len(comments) == 0 {
+			blank = true
+		}
+		newlines = 0
+
+		// comments
+		if strings.HasPrefix(s[i:], "/*") || strings.HasPrefix(s[i:], "//") {
+			n := strings.Index(s[i:], "\n")
+			if s[i+1] == '*' {
+				if n = strings.Index(s[i+2:], "*/"); n == -1 {
+					return nil, errorf("unterminated comment")
+				}
+				n += 4
+			} else if n == -1 {
+				n = len(s) - i
+			}
+			comment := s[i : i+n]
+			if sameLine {
+				trailing = append(trailing, comment)
+			} else {
+				comments = append(comments, comment)
+			}
+			if c := strings.Count(comment, "\n"); c != 0 {
+				line, col = line+c, len(comment)-strings.LastIndex(comment, "\n")
+			} else {
+				col += n
+			}
+			i += n
+			continue
 		}
 
-		// add { and }
-		buf.Write([]byte(" {\n"))
-		buf.Write(bytes.TrimRight(bytes.TrimLeft(src[:m[1]], "\n"), "\n"))
-		buf.Write([]byte("\n}"))
-		src = src[m[1]:]
+		// preprocessor directives
+		if s[i] == '#' && lineStart {
+			return nil, errorf("unsupported preprocessor directive")
+		}
+		lineStart = false
+
+		t := token{line: line, col: col, comments: comments, trailing: trailing, blank: blank}
+		comments, trailing, blank = nil, nil, false
+		n := 1
+		switch c := s[i]; {
+		case c == '"' || c == '\'':
+			for ; i+n < len(s) && s[i+n] != c; n++ {
+				switch s[i+n] {
+				case '\\':
+					n++
+				case '\n':
+					return nil, errorf("unterminated literal")
+				}
+			}
+			if i+n >= len(s) {
+				return nil, errorf("unterminated literal")
+			}
+			n++
+			t.kind = tokString
+			if c == '\'' {
+				t.kind = tokChar
+			}
+		case '0' <= c && c <= '9':
+			for ; i+n < len(s) && (isIdent(s[i+n]) || s[i+n] == '.'); n++ {
+			}
+			t.kind = tokNumber
+		case isIdent(c):
+			for ; i+n < len(s) && isIdent(s[i+n]); n++ {
+			}
+			t.kind = tokIdent
+		default:
+			n = 0
+			for _, p := range punctuators {
+				if strings.HasPrefix(s[i:], p) {
+					n = len(p)
+					break
+				}
+			}
+			if n == 0 {
+				return nil, errorf("unexpected character %q", c)
+			}
+			t.kind = tokPunct
+		}
+		t.text = s[i : i+n]
+		toks = append(toks, t)
+		i, col = i+n, col+n
 	}
 }
 
-// fixDanglingElse fixes the remaining "} else" case.
-func fixDanglingElse(src []byte, orig string) []byte {
-	m := danglingElseRE.FindIndex(src)
-	if m == nil {
-		return src
-	}
-
-	buf := new(bytes.Buffer)
+// cfunc is a parsed C func.
+type cfunc struct {
+	name   string
+	typ    ctype
+	params []*cdecl
+	body   *cblock
+}
 
-	// flush
-	buf.Write(src[:m[1]])
-	src = src[m[1]:]
+// ctype is a C type.
+type ctype struct {
+	// name is the type name (ie, bool, char, PQExpBufferData).
+	name string
 
-	// find first terminating ;
-	m = ifSemicolonRE.FindIndex(src)
-	if m == nil {
-		panic(fmt.Sprintf("could not find end to dangling else statement in %s", orig))
-	}
+	// ptr is the number of pointer indirections.
+	ptr int
 
-	// add { and }
-	buf.Write([]byte(" {\n"))
-	buf.Write(bytes.TrimRight(bytes.TrimLeft(src[:m[1]], "\n"), "\n"))
-	buf.Write([]byte("\n}\n"))
-	buf.Write(bytes.TrimRight(src[m[1]:], "\n"))
+	// array is whether or not the type is an array.
+	array bool
+}
 
-	return buf.Bytes()
+// String satisfies the fmt.Stringer interface.
+func (typ ctype) String() string {
+	s := typ.name + " " + strings.Repeat("*", typ.ptr)
+	if typ.array {
+		s += "[]"
+	}
+	return strings.TrimSpace(s)
 }
 
-var (
-	ifFixRE        = regexp.MustCompile(`(?m)^(\s+(else\s+)?if\s+\(.+?\))\s*$\s*\{`)
-	elseFixRE      = regexp.MustCompile(`(?m)^(\s+else)\s*$\s*\{`)
-	ifStartRE      = regexp.MustCompile(`(?m)^\s+(else\s+)?if\s+\(.+?\)\s*$`)
-	ifSemicolonRE  = regexp.MustCompile(`(?m);(\s*/\*[^*]+\*/\s*)?$`)
-	elseRE         = regexp.MustCompile(`^\s*else\s*\n`)
-	fixElseLeftRE  = regexp.MustCompile(`}\s+else`)
-	fixElseRightRE = regexp.MustCompile(`else\s+{`)
-	danglingElseRE = regexp.MustCompile(`(?m)^\s*}\s+else\s*$`)
-)
+// ctypeNames are the C type names that can start a declaration or cast.
+var ctypeNames = map[string]bool{
+	"bool": true, "char": true, "int": true, "long": true, "short": true,
+	"unsigned": true, "signed": true, "float": true, "double": true,
+	"void": true, "size_t": true, "Oid": true,
+}
 
-// cache holds information about a cached file.
-type cache struct {
-	path   string
-	ttl    time.Duration
-	decode bool
-	url    string
+// cstmt is a C statement.
+type cstmt interface {
+	// first returns the first token of the statement.
+	first() token
 }
 
-// get retrieves a file from disk or from the remote URL, optionally base64
-// decoding it and writing it to disk.
-func get(c cache) ([]byte, error) {
-	var err error
+// C statements.
+type (
+	// cblock is a compound statement.
+	cblock struct {
+		tok   token
+		stmts []cstmt
+	}
 
-	if err = os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
-		return nil, err
+	// cdeclStmt is a declaration statement.
+	cdeclStmt struct {
+		tok   token
+		decls []*cdecl
 	}
 
-	// check if exists on disk
-	fi, err := os.Stat(c.path)
-	if err == nil && c.ttl != 0 && !time.Now().After(fi.ModTime().Add(c.ttl)) {
-		return ioutil.ReadFile(c.path)
+	// cif is an if statement.
+	cif struct {
+		tok       token
+		cond      cexpr
+		then, els cstmt
 	}
 
-	logf("RETRIEVING: %s", c.url)
+	// cfor is a for (or while) statement.
+	cfor struct {
+		tok  token
+		init cstmt
+		cond cexpr
+		post cexpr
+		body cstmt
+	}
 
-	// retrieve
-	cl := &http.Client{}
-	req, err := http.NewRequest("GET", c.url, nil)
-	if err != nil {
-		return nil, err
+	// creturn is a return statement.
+	creturn struct {
+		tok token
+		x   cexpr
 	}
-	res, err := cl.Do(req)
-	if err != nil {
-		return nil, err
+
+	// cbranch is a break or continue statement.
+	cbranch struct {
+		tok token
 	}
-	defer res.Body.Close()
 
-	buf, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
+	// cexprStmt is an expression statement.
+	cexprStmt struct {
+		tok token
+		x   cexpr
 	}
 
-	// decode
-	if c.decode {
-		buf, err = base64.StdEncoding.DecodeString(string(buf))
-		if err != nil {
-			return nil, err
-		}
+	// cempty is an empty statement.
+	cempty struct {
+		tok token
 	}
 
-	logf("WRITING: %s", c.path)
-	if err = ioutil.WriteFile(c.path, buf, 0644); err != nil {
-		return nil, err
+	// cgoto is a goto statement.
+	cgoto struct {
+		tok   token
+		label token
 	}
 
-	return buf, nil
-}
+	// clabel is a label.
+	clabel struct {
+		tok token
+	}
+)
+
+// cdecl is a declared C variable or func parameter.
+type cdecl struct {
+	tok  token
+	typ  ctype
+	name token
+	init cexpr
+}
+
+func (s *cblock) first() token    { return s.tok }
+func (s *cdeclStmt) first() token { return s.tok }
+func (s *cif) first() token       { return s.tok }
+func (s *cfor) first() token      { return s.tok }
+func (s *creturn) first() token   { return s.tok }
+func (s *cbranch) first() token   { return s.tok }
+func (s *cexprStmt) first() token { return s.tok }
+func (s *cempty) first() token    { return s.tok }
+func (s *cgoto) first() token     { return s.tok }
+func (s *clabel) first() token    { return s.tok }
+
+// cexpr is a C expression.
+type cexpr interface {
+	// first returns the first token of the expression.
+	first() token
+}
+
+// C expressions.
+type (
+	// cident is an identifier.
+	cident struct {
+		tok token
+	}
+
+	// cbasic is a number or char literal.
+	cbasic struct {
+		tok token
+	}
+
+	// cstring is a concatenation of string literals and CppAsString2 macros.
+	cstring struct {
+		parts []token
+	}
+
+	// ccall is a func call.
+	ccall struct {
+		fn   cexpr
+		args []cexpr
+	}
+
+	// cunary is a prefix unary expression.
+	cunary struct {
+		op token
+		x  cexpr
+	}
+
+	// cpostfix is a postfix increment or decrement.
+	cpostfix struct {
+		x  cexpr
+		op token
+	}
+
+	// cbinary is a binary expression.
+	cbinary struct {
+		x  cexpr
+		op token
+		y  cexpr
+	}
+
+	// cassign is an assignment.
+	cassign struct {
+		x  cexpr
+		op token
+		y  cexpr
+	}
+
+	// ccond is a conditional (ternary) expression.
+	ccond struct {
+		cond, x, y cexpr
+	}
+
+	// cindex is an index expression.
+	cindex struct {
+		x, index cexpr
+	}
+
+	// cmember is a member (. or ->) expression.
+	cmember struct {
+		x   cexpr
+		op  token
+		sel token
+	}
+
+	// cparen is a parenthesized expression.
+	cparen struct {
+		tok token
+		x   cexpr
+	}
+
+	// ccast is a cast.
+	ccast struct {
+		tok token
+		typ ctype
+		x   cexpr
+	}
+
+	// csizeof is a sizeof expression.
+	csizeof struct {
+		tok token
+	}
+
+	// cinit is an initializer list.
+	cinit struct {
+		tok   token
+		elems []cexpr
+	}
+)
+
+func (x *cident) first() token   { return x.tok }
+func (x *cbasic) first() token   { return x.tok }
+func (x *cstring) first() token  { return x.parts[0] }
+func (x *ccall) first() token    { return x.fn.first() }
+func (x *cunary) first() token   { return x.op }
+func (x *cpostfix) first() token { return x.x.first() }
+func (x *cbinary) first() token  { return x.x.first() }
+func (x *cassign) first() token  { return x.x.first() }
+func (x *ccond) first() token    { return x.cond.first() }
+func (x *cindex) first() token   { return x.x.first() }
+func (x *cmember) first() token  { return x.x.first() }
+func (x *cparen) first() token   { return x.tok }
+func (x *ccast) first() token    { return x.tok }
+func (x *csizeof) first() token  { return x.tok }
+func (x *cinit) first() token    { return x.tok }
+
+// parser is a recursive descent parser for the subset of C used by the
+// describe.c funcs.
+type parser struct {
+	file string
+	toks []token
+	i    int
+}
+
+// bailout is used by the parser to abort parsing on the first error.
+type bailout struct {
+	err error
+}
+
+// parseFunc parses a func definition.
+func parseFunc(file string, toks []token) (f *cfunc, err error) {
+	p := &parser{file: file, toks: toks}
+	defer func() {
+		if e := recover(); e != nil {
+			b, ok := e.(bailout)
+			if !ok {
+				panic(e)
+			}
+			err = b.err
+		}
+	}()
+
+	f = new(cfunc)
+	p.accept("static")
+	f.typ = p.parseType()
+	f.name = p.expectIdent().text
+	p.expect("(")
+	for !p.is(")") {
+		if len(f.params) != 0 {
+			p.expect(",")
+		}
+		typ := p.parseType()
+		f.params = append(f.params, p.parseDeclarator(typ))
+	}
+	p.expect(")")
+	f.body = p.parseBlock()
+	if t := p.peek(); t.kind != tokEOF {
+		p.errorf(t, "unexpected %q after func", t.text)
+	}
+	return f, nil
+}
+
+// errorf aborts parsing with an error at the position of t.
+func (p *parser) errorf(t token, format string, v ...interface{}) {
+	panic(bailout{&posError{file: p.file, line: t.line, col: t.col, msg: fmt.Sprintf(format, v...)}})
+}
+
+// peek returns the current token.
+func (p *parser) peek() token {
+	return p.peekN(0)
+}
+
+// peekN returns the token n tokens after the current token.
+func (p *parser) peekN(n int) token {
+	if p.i+n >= len(p.toks) {
+		return p.toks[len(p.toks)-1]
+	}
+	return p.toks[p.i+n]
+}
+
+// next returns the current token and advances to the next token.
+func (p *parser) next() token {
+	t := p.peek()
+	if t.kind != tokEOF {
+		p.i++
+	}
+	return t
+}
+
+// is returns whether or not the current token is the punctuator or keyword s.
+func (p *parser) is(s string) bool {
+	t := p.peek()
+	return (t.kind == tokPunct || t.kind == tokIdent) && t.text == s
+}
+
+// accept advances past the current token when it is s.
+func (p *parser) accept(s string) bool {
+	if p.is(s) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+// expect advances past the current token, which must be s.
+func (p *parser) expect(s string) token {
+	if !p.is(s) {
+		t := p.peek()
+		p.errorf(t, "expected %q, found %q", s, t.text)
+	}
+	return p.next()
+}
+
+// expectIdent advances past the current token, which must be an identifier.
+func (p *parser) expectIdent() token {
+	t := p.next()
+	if t.kind != tokIdent {
+		p.errorf(t, "expected identifier, found %q", t.text)
+	}
+	return t
+}
+
+// isDecl returns whether or not the current token starts a declaration.
+func (p *parser) isDecl() bool {
+	t := p.peek()
+	if t.kind != tokIdent {
+		return false
+	}
+	if t.text == "static" || t.text == "const" || ctypeNames[t.text] {
+		return true
+	}
+
+	// typedef name followed by a declarator
+	n := 1
+	for p.peekN(n).text == "*" {
+		n++
+	}
+	if next := p.peekN(n); next.kind == tokIdent {
+		switch p.peekN(n + 1).text {
+		case ";", "=", ",", "[":
+			return true
+		}
+		return n == 1
+	}
+	return false
+}
+
+// parseType parses a type specifier, ignoring qualifiers.
+func (p *parser) parseType() ctype {
+	var typ ctype
+	for {
+		t := p.peek()
+		switch {
+		case t.text == "const" || t.text == "static":
+			p.next()
+		case t.kind == tokIdent && typ.name == "":
+			typ.name = p.next().text
+		case t.kind == tokIdent && ctypeNames[t.text] && ctypeNames[typ.name]:
+			// ie, unsigned int
+			typ.name += " " + p.next().text
+		case typ.name == "":
+			p.errorf(t, "expected type, found %q", t.text)
+		default:
+			return typ
+		}
+	}
+}
+
+// parseDeclarator parses a declarator of type typ.
+func (p *parser) parseDeclarator(typ ctype) *cdecl {
+	d := &cdecl{tok: p.peek()}
+	for p.accept("*") {
+		typ.ptr++
+		p.accept("const")
+	}
+	d.name = p.expectIdent()
+	if p.accept("[") {
+		// the size is not needed
+		for !p.is("]") {
+			if p.peek().kind == tokEOF {
+				p.errorf(p.peek(), "expected %q", "]")
+			}
+			p.next()
+		}
+		p.expect("]")
+		typ.array = true
+	}
+	d.typ = typ
+	return d
+}
+
+// parseDecl parses a declaration statement.
+func (p *parser) parseDecl() *cdeclStmt {
+	s := &cdeclStmt{tok: p.peek()}
+	typ := p.parseType()
+	for {
+		d := p.parseDeclarator(typ)
+		if p.accept("=") {
+			if p.is("{") {
+				d.init = p.parseInit()
+			} else {
+				d.init = p.parseAssign()
+			}
+		}
+		s.decls = append(s.decls, d)
+		if !p.accept(",") {
+			break
+		}
+	}
+	p.expect(";")
+	return s
+}
+
+// parseInit parses an initializer list.
+func (p *parser) parseInit() *cinit {
+	x := &cinit{tok: p.expect("{")}
+	for !p.is("}") {
+		x.elems = append(x.elems, p.parseAssign())
+		if !p.accept(",") {
+			break
+		}
+	}
+	p.expect("}")
+	return x
+}
+
+// parseBlock parses a compound statement.
+func (p *parser) parseBlock() *cblock {
+	s := &cblock{tok: p.expect("{")}
+	for !p.is("}") {
+		if p.peek().kind == tokEOF {
+			p.errorf(p.peek(), "expected %q", "}")
+		}
+		s.stmts = append(s.stmts, p.parseStmt())
+	}
+	// keep comments at the end of the block
+	if end := p.next(); len(end.comments) != 0 || len(end.trailing) != 0 {
+		s.stmts = append(s.stmts, &cempty{tok: end})
+	}
+	return s
+}
+
+// parseStmt parses a statement.
+func (p *parser) parseStmt() cstmt {
+	t := p.peek()
+	switch {
+	case p.is("{"):
+		return p.parseBlock()
+
+	case p.is("if"):
+		s := &cif{tok: p.next()}
+		p.expect("(")
+		s.cond = p.parseExpr()
+		p.expect(")")
+		s.then = p.parseStmt()
+		if p.accept("else") {
+			s.els = p.parseStmt()
+		}
+		return s
+
+	case p.is("for"):
+		s := &cfor{tok: p.next()}
+		p.expect("(")
+		switch {
+		case p.isDecl():
+			s.init = p.parseDecl()
+		case !p.is(";"):
+			s.init = &cexprStmt{tok: p.peek(), x: p.parseExpr()}
+			fallthrough
+		default:
+			p.expect(";")
+		}
+		if !p.is(";") {
+			s.cond = p.parseExpr()
+		}
+		p.expect(";")
+		if !p.is(")") {
+			s.post = p.parseExpr()
+		}
+		p.expect(")")
+		s.body = p.parseStmt()
+		return s
+
+	case p.is("while"):
+		s := &cfor{tok: p.next()}
+		p.expect("(")
+		s.cond = p.parseExpr()
+		p.expect(")")
+		s.body = p.parseStmt()
+		return s
+
+	case p.is("return"):
+		s := &creturn{tok: p.next()}
+		if !p.is(";") {
+			s.x = p.parseExpr()
+		}
+		p.expect(";")
+		return s
+
+	case p.is("break"), p.is("continue"):
+		s := &cbranch{tok: p.next()}
+		p.expect(";")
+		return s
+
+	case p.is(";"):
+		return &cempty{tok: p.next()}
+
+	case p.is("goto"):
+		s := &cgoto{tok: p.next(), label: p.expectIdent()}
+		p.expect(";")
+		return s
+
+	case p.is("switch"), p.is("do"), p.is("case"), p.is("default"):
+		p.errorf(t, "unsupported %s statement", t.text)
+
+	case t.kind == tokIdent && p.peekN(1).text == ":":
+		p.next()
+		p.next()
+		return &clabel{tok: t}
+
+	case p.isDecl():
+		return p.parseDecl()
+	}
+
+	s := &cexprStmt{tok: t, x: p.parseExpr()}
+	p.expect(";")
+	return s
+}
+
+// parseExpr parses an expression.
+func (p *parser) parseExpr() cexpr {
+	x := p.parseAssign()
+	if t := p.peek(); t.text == "," && t.kind == tokPunct {
+		p.errorf(t, "unsupported comma operator")
+	}
+	return x
+}
+
+// parseAssign parses an assignment expression.
+func (p *parser) parseAssign() cexpr {
+	x := p.parseCond()
+	switch t := p.peek(); t.text {
+	case "=", "+=", "-=", "*=", "/=", "%=", "&=", "|=", "^=", "<<=", ">>=":
+		if t.kind == tokPunct {
+			p.next()
+			return &cassign{x: x, op: t, y: p.parseAssign()}
+		}
+	}
+	return x
+}
+
+// parseCond parses a conditional expression.
+func (p *parser) parseCond() cexpr {
+	x := p.parseBinary(1)
+	if p.accept("?") {
+		y := p.parseExpr()
+		p.expect(":")
+		return &ccond{cond: x, x: y, y: p.parseCond()}
+	}
+	return x
+}
+
+// binaryPrec are the C binary operator precedences.
+var binaryPrec = map[string]int{
+	"||": 1,
+	"&&": 2,
+	"|":  3,
+	"^":  4,
+	"&":  5,
+	"==": 6, "!=": 6,
+	"<": 7, ">": 7, "<=": 7, ">=": 7,
+	"<<": 8, ">>": 8,
+	"+": 9, "-": 9,
+	"*": 10, "/": 10, "%": 10,
+}
+
+// parseBinary parses a binary expression with operators of at least
+// precedence prec.
+func (p *parser) parseBinary(prec int) cexpr {
+	x := p.parseUnary()
+	for {
+		op := p.peek()
+		n, ok := binaryPrec[op.text]
+		if !ok || op.kind != tokPunct || n < prec {
+			return x
+		}
+		p.next()
+		x = &cbinary{x: x, op: op, y: p.parseBinary(n + 1)}
+	}
+}
+
+// parseUnary parses a unary expression.
+func (p *parser) parseUnary() cexpr {
+	t := p.peek()
+	switch {
+	case t.kind == tokPunct && strings.Contains(" ! - + ~ & * ++ -- ", " "+t.text+" "):
+		p.next()
+		return &cunary{op: t, x: p.parseUnary()}
+
+	case p.is("sizeof"):
+		p.next()
+		p.expect("(")
+		for depth := 1; depth != 0; {
+			switch p.next().text {
+			case "(":
+				depth++
+			case ")":
+				depth--
+			case "":
+				p.errorf(t, "unterminated sizeof")
+			}
+		}
+		return &csizeof{tok: t}
+
+	case p.is("(") && p.peekN(1).kind == tokIdent && (ctypeNames[p.peekN(1).text] || p.peekN(1).text == "const"):
+		p.next()
+		x := &ccast{tok: t, typ: p.parseType()}
+		for p.accept("*") {
+			x.typ.ptr++
+		}
+		p.expect(")")
+		x.x = p.parseUnary()
+		return x
+	}
+	return p.parsePostfix()
+}
+
+// parsePostfix parses a postfix expression.
+func (p *parser) parsePostfix() cexpr {
+	x := p.parsePrimary()
+	for {
+		switch t := p.peek(); {
+		case p.is("("):
+			p.next()
+			c := &ccall{fn: x}
+			for !p.is(")") {
+				if len(c.args) != 0 {
+					p.expect(",")
+				}
+				c.args = append(c.args, p.parseAssign())
+			}
+			p.next()
+			x = c
+		case p.is("["):
+			p.next()
+			x = &cindex{x: x, index: p.parseExpr()}
+			p.expect("]")
+		case p.is("."), p.is("->"):
+			p.next()
+			x = &cmember{x: x, op: t, sel: p.expectIdent()}
+		case p.is("++"), p.is("--"):
+			x = &cpostfix{x: x, op: p.next()}
+		default:
+			return x
+		}
+	}
+}
+
+// parsePrimary parses a primary expression.
+func (p *parser) parsePrimary() cexpr {
+	t := p.peek()
+	switch {
+	case t.kind == tokString || p.is("CppAsString2"):
+		x := new(cstring)
+		for {
+			switch {
+			case p.peek().kind == tokString:
+				x.parts = append(x.parts, p.next())
+			case p.is("CppAsString2"):
+				p.next()
+				p.expect("(")
+				x.parts = append(x.parts, p.expectIdent())
+				p.expect(")")
+			default:
+				return x
+			}
+		}
+
+	case t.kind == tokIdent:
+		return &cident{tok: p.next()}
+
+	case t.kind == tokNumber, t.kind == tokChar:
+		return &cbasic{tok: p.next()}
+
+	case p.is("("):
+		p.next()
+		x := &cparen{tok: t, x: p.parseExpr()}
+		p.expect(")")
+		return x
+	}
+	p.errorf(t, "unexpected %q", t.text)
+	return nil
+}
+
+// Go types used by the translator.
+const (
+	goBool     = "bool"
+	goInt      = "int"
+	goRune     = "rune"
+	goByte     = "byte"
+	goString   = "string"
+	goBuffer   = "*bytes.Buffer"
+	goResult   = "*Result"
+	goQueryOpt = "printQueryOpt"
+	goTableOpt = "printTableOpt"
+
+	// goNull is the type of NULL, which is converted to the empty string or
+	// nil, depending on use.
+	goNull = "NULL"
+)
+
+// goFields are the Go fields (and types) of the translated psql structs.
+var goFields = map[string]map[string][2]string{
+	goQueryOpt: {
+		"nullPrint":           {"nullPrint", goString},
+		"title":               {"title", goString},
+		"footers":             {"footers", "[]string"},
+		"translate_header":    {"translateHeader", goBool},
+		"translate_columns":   {"translateColumns", "[]bool"},
+		"n_translate_columns": {"nTranslateColumns", goInt},
+		"topt":                {"topt", goTableOpt},
+	},
+	goTableOpt: {
+		"default_footer": {"defaultFooter", goBool},
+	},
+}
+
+// goReserved are the Go keywords and identifiers used by the generated code,
+// that cannot be used as variable names.
+var goReserved = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true, "continue": true,
+	"default": true, "defer": true, "else": true, "fallthrough": true,
+	"for": true, "func": true, "go": true, "goto": true, "if": true,
+	"import": true, "interface": true, "map": true, "package": true,
+	"range": true, "return": true, "select": true, "struct": true,
+	"switch": true, "type": true, "var": true,
+	"bytes": true, "d": true, "err": true, "fmt": true, "len": true,
+	"new": true, "nil": true, "string": true, "w": true,
+}
+
+// goType returns the Go type for the C type typ.
+func goType(typ ctype) (string, bool) {
+	var s string
+	switch {
+	case typ.name == "bool" && typ.ptr == 0:
+		s = goBool
+	case (typ.name == "int" || typ.name == "long" || typ.name == "unsigned int") && typ.ptr == 0:
+		s = goInt
+	case typ.name == "char" && typ.ptr == 0 && !typ.array:
+		s = goByte
+	case typ.name == "char" && typ.ptr == 1:
+		s = goString
+	case (typ.name == "PQExpBufferData" && typ.ptr == 0 || typ.name == "PQExpBuffer" && typ.ptr == 0) && !typ.array:
+		return goBuffer, true
+	case typ.name == "PGresult" && typ.ptr == 1 && !typ.array:
+		return goResult, true
+	case typ.name == "printQueryOpt" && typ.ptr == 0 && !typ.array:
+		return goQueryOpt, true
+	default:
+		return "", false
+	}
+	if typ.array {
+		s = "[]" + s
+	}
+	return s, true
+}
+
+// cvar is a C variable in scope.
+type cvar struct {
+	// name is the Go name.
+	name string
+
+	// typ is the Go type.
+	typ string
+
+	// query is whether or not the variable is the query buffer (buf), which
+	// is translated to the func's io.Writer.
+	query bool
+
+	// local is whether or not the variable is a local variable.
+	local bool
+
+	// read is whether or not the variable is read by the generated code.
+	read bool
+}
+
+// chunk is a chunk of generated code.
+type chunk struct {
+	text string
+
+	// v is the local variable the chunk declares or assigns. When set, the
+	// chunk is generated by gen, and is only included when v is read, as Go
+	// does not permit unused variables.
+	v   *cvar
+	gen func() string
+}
+
+// translator translates a parsed describe.c func to Go.
+//
+// The query buffer (buf) is translated to the func's io.Writer, to which the
+// query is written. Result processing is translated to calls to psqlExec and
+// psqlPrintQuery (see psql.go).
+type translator struct {
+	file   string
+	names  map[string]string
+	consts map[string][2]string
+
+	// queryOnly is whether or not to stop translating at the first query
+	// execution.
+	queryOnly bool
+
+	params []*cvar
+	scopes []map[string]*cvar
+	chunks []chunk
+	depth  int
+	errs   []error
+
+	// exec is whether or not err is declared, as when the generated code
+	// executes queries.
+	exec bool
+
+	// done is set when the remaining statements are not translated.
+	done bool
+
+	// failErr is the Go error returned for "return false", when set.
+	failErr string
+
+	// failLabels are the labels followed only by cleanup and "return false".
+	failLabels map[string]bool
+}
+
+// translate translates the func f, returning the Go func body.
+func (t *translator) translate(f *cfunc) (string, error) {
+	if f.typ.name != "bool" || f.typ.ptr != 0 {
+		t.errorf(f.body.tok, "return type for %s is not bool, has: %s", f.name, f.typ)
+	}
+
+	t.push()
+	for _, p := range f.params {
+		typ, ok := goType(p.typ)
+		if !ok || typ != goString && typ != goBool && typ != goInt {
+			t.errorf(p.name, "unsupported parameter type %s", p.typ)
+		}
+		t.params = append(t.params, t.declare(p.name.text, typ, false))
+	}
+
+	// find failure labels (ie, error_return), which are translated by
+	// returning the failure's error at the goto
+	t.failLabels = make(map[string]bool)
+	for i, s := range f.body.stmts {
+		if l, ok := s.(*clabel); ok && t.isFail(&cblock{stmts: f.body.stmts[i+1:]}) {
+			t.failLabels[l.tok.text] = true
+		}
+	}
+
+	t.depth = 1
+	if !t.stmts(f.body.stmts) {
+		t.emit("return nil\n")
+	}
+	t.pop()
+
+	// generate the chunks for read variables; as generating a chunk may read
+	// other variables, repeat until nothing changes
+	for changed := true; changed; {
+		changed = false
+		for i := range t.chunks {
+			if c := &t.chunks[i]; c.gen != nil && c.v.read {
+				c.text, c.gen, changed = c.gen(), nil, true
+			}
+		}
+	}
+	if len(t.errs) != 0 {
+		return "", errors.Join(t.errs...)
+	}
+
+	var buf bytes.Buffer
+	for _, c := range t.chunks {
+		if c.gen == nil {
+			buf.WriteString(c.text)
+		}
+	}
+
+	// remove blank lines at the start of blocks
+	code := strings.TrimLeft(buf.String(), "\n")
+	for strings.Contains(code, "{\n\n") {
+		code = strings.Replace(code, "{\n\n", "{\n", -1)
+	}
+	if t.exec {
+		code = "\tvar err error\n\n" + code
+	}
+	return code, nil
+}
+
+// errorf records an error at the position of tok.
+func (t *translator) errorf(tok token, format string, v ...interface{}) {
+	t.errs = append(t.errs, &posError{file: t.file, line: tok.line, col: tok.col, msg: fmt.Sprintf(format, v...)})
+}
+
+// push pushes a new scope.
+func (t *translator) push() {
+	t.scopes = append(t.scopes, make(map[string]*cvar))
+}
+
+// pop pops the current scope.
+func (t *translator) pop() {
+	t.scopes = t.scopes[:len(t.scopes)-1]
+}
+
+// declare declares a variable in the current scope.
+func (t *translator) declare(name, typ string, local bool) *cvar {
+	v := &cvar{name: name, typ: typ, local: local}
+	if goReserved[name] {
+		v.name += "_"
+	}
+	t.scopes[len(t.scopes)-1][name] = v
+	return v
+}
+
+// lookup returns the variable name in scope.
+func (t *translator) lookup(name string) *cvar {
+	for i := len(t.scopes) - 1; i >= 0; i-- {
+		if v, ok := t.scopes[i][name]; ok {
+			return v
+		}
+	}
+	return nil
+}
+
+// emit emits generated code, indented to the current depth.
+func (t *translator) emit(s string) {
+	t.chunks = append(t.chunks, chunk{text: t.indent(s)})
+}
+
+// emitFor emits code generated by gen, only when v is read.
+func (t *translator) emitFor(v *cvar, gen func() string) {
+	depth, scopes := t.depth, append([]map[string]*cvar(nil), t.scopes...)
+	t.chunks = append(t.chunks, chunk{v: v, gen: func() string {
+		savedDepth, savedScopes := t.depth, t.scopes
+		defer func() { t.depth, t.scopes = savedDepth, savedScopes }()
+		t.depth, t.scopes = depth, scopes
+		return t.indent(gen())
+	}})
+}
+
+// indent indents the lines of s to the current depth.
+func (t *translator) indent(s string) string {
+	prefix := strings.Repeat("\t", t.depth)
+	lines := strings.SplitAfter(s, "\n")
+	for i, line := range lines {
+		if line != "\n" && line != "" {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "")
+}
+
+// trail appends the trailing comments of the token preceding tok to the last
+// emitted line.
+func (t *translator) trail(tok token) {
+	if len(tok.trailing) == 0 || len(t.chunks) == 0 {
+		return
+	}
+	var s string
+	for _, comment := range tok.trailing {
+		s += " " + strings.Replace(goComment(comment), "\n// ", " ", -1)
+	}
+	c := &t.chunks[len(t.chunks)-1]
+	if gen := c.gen; gen != nil {
+		c.gen = func() string {
+			return strings.TrimSuffix(gen(), "\n") + s + "\n"
+		}
+		return
+	}
+	c.text = strings.TrimSuffix(c.text, "\n") + s + "\n"
+}
+
+// leading returns the blank line and comments preceding tok.
+func leading(tok token) string {
+	var s string
+	if tok.blank {
+		s = "\n"
+	}
+	for _, c := range tok.comments {
+		s += goComment(c) + "\n"
+	}
+	return s
+}
+
+// stmts translates a list of statements, returning whether or not the last
+// translated statement terminates. Statements following a terminating
+// statement are unreachable, and are not translated.
+func (t *translator) stmts(stmts []cstmt) bool {
+	for _, s := range stmts {
+		if t.done {
+			return true
+		}
+		if t.stmt(s) {
+			return true
+		}
+	}
+	return t.done
+}
+
+// block translates s as a Go block, returning whether or not it terminates.
+func (t *translator) block(s cstmt) bool {
+	t.push()
+	defer t.pop()
+	t.depth++
+	defer func() { t.depth-- }()
+	if b, ok := s.(*cblock); ok {
+		return t.stmts(b.stmts)
+	}
+	return t.stmts([]cstmt{s})
+}
+
+// stmt translates a statement, returning whether or not it terminates.
+func (t *translator) stmt(s cstmt) bool {
+	t.trail(s.first())
+	switch s := s.(type) {
+	case *cblock:
+		t.emit(leading(s.tok) + "{\n")
+		term := t.block(s)
+		t.emit("}\n")
+		return term
+
+	case *cempty:
+		if s := leading(s.tok); s != "" {
+			t.emit(s)
+		}
+		return false
+
+	case *cdeclStmt:
+		for i, d := range s.decls {
+			t.decl(d, i == 0)
+		}
+		return false
+
+	case *cif:
+		return t.ifStmt(s)
+
+	case *cfor:
+		return t.forStmt(s)
+
+	case *creturn:
+		t.emit(leading(s.tok) + "return " + t.returnValue(s) + "\n")
+		return true
+
+	case *cbranch:
+		t.emit(leading(s.tok) + s.tok.text + "\n")
+		return false
+
+	case *cexprStmt:
+		return t.exprStmt(s)
+
+	case *cgoto:
+		if !t.failLabels[s.label.text] || t.failErr == "" {
+			t.errorf(s.tok, "unsupported goto %s (only to a failure label after a failed call)", s.label.text)
+		}
+		t.emit(leading(s.tok) + "return " + t.failErr + "\n")
+		return true
+
+	case *clabel:
+		if !t.failLabels[s.tok.text] {
+			t.errorf(s.tok, "unsupported label %s", s.tok.text)
+		}
+		// only reachable by goto
+		t.done = true
+		return true
+	}
+	panic(fmt.Sprintf("unknown statement %T", s))
+}
+
+// returnValue returns the Go error returned for a return statement.
+func (t *translator) returnValue(s *creturn) string {
+	if c, ok := s.x.(*ccall); ok && t.isErrCall(c) {
+		return t.errCall(c)
+	}
+	x, ok := s.x.(*cident)
+	switch {
+	case ok && x.tok.text == "true":
+		return "nil"
+	case ok && x.tok.text == "false" && t.failErr != "":
+		return t.failErr
+	}
+	t.errorf(s.tok, "unsupported return (only true, or false after a failed call)")
+	return "nil"
+}
+
+// decl translates a variable declaration.
+func (t *translator) decl(d *cdecl, first bool) {
+	var lead string
+	if first {
+		lead = leading(d.tok)
+	}
+
+	// the query buffer
+	if d.name.text == "buf" && d.typ.name == "PQExpBufferData" {
+		v := t.declare(d.name.text, goBuffer, true)
+		v.name, v.query = "w", true
+		return
+	}
+
+	typ, ok := goType(d.typ)
+	v := t.declare(d.name.text, typ, true)
+	t.emitFor(v, func() string {
+		if !ok {
+			t.errorf(d.name, "unsupported type %s for %s", d.typ, d.name.text)
+			return ""
+		}
+		switch {
+		case typ == goBuffer:
+			return lead + v.name + " := new(bytes.Buffer)\n"
+		case typ == goQueryOpt:
+			return lead + v.name + " := d.popt()\n"
+		case d.init == nil:
+			return lead + "var " + v.name + " " + typ + "\n"
+		}
+		if x, ok := d.init.(*cinit); ok {
+			if !d.typ.array {
+				t.errorf(x.tok, "unsupported initializer for %s", d.name.text)
+			}
+			var elems []string
+			for _, e := range x.elems {
+				s, _ := t.expr(e)
+				elems = append(elems, s)
+			}
+			return lead + v.name + " := " + typ + "{" + strings.Join(elems, ", ") + "}\n"
+		}
+		s, xtyp := t.expr(d.init)
+		switch {
+		case xtyp == goNull && typ != goString:
+			return lead + "var " + v.name + " " + typ + "\n"
+		case xtyp != typ && (typ == goByte || typ == goInt):
+			s = typ + "(" + s + ")"
+		}
+		return lead + v.name + " := " + s + "\n"
+	})
+}
+
+// ifStmt translates an if statement, returning whether or not it terminates.
+func (t *translator) ifStmt(s *cif) bool {
+	lead := leading(s.tok)
+
+	// failed call: if (!f(...)) { ...; return false; }
+	if x, ok := s.cond.(*cunary); ok && x.op.text == "!" {
+		if c, ok := x.x.(*ccall); ok && t.isErrCall(c) {
+			if s.els != nil {
+				t.errorf(s.els.first(), "unsupported else for failed call")
+			}
+			t.emit(lead + "if err := " + t.errCall(c) + "; err != nil {\n")
+			t.failBlock(s.then, "err")
+			t.emit("}\n")
+			return false
+		}
+
+		// failed query: if (!res) return false;
+		if id, ok := x.x.(*cident); ok {
+			if v := t.lookup(id.tok.text); v != nil && v.typ == goResult && t.isFail(s.then) {
+				// checked by psqlExec
+				return false
+			}
+		}
+	}
+
+	// cancelled: if (cancel_pressed) { ...; return false; }
+	if id, ok := s.cond.(*cident); ok && id.tok.text == "cancel_pressed" && s.els == nil {
+		t.emit(lead + "if err := d.context().Err(); err != nil {\n")
+		t.failBlock(s.then, "err")
+		t.emit("}\n")
+		return false
+	}
+
+	cond := t.cond(s.cond)
+	switch {
+	case cond == "true":
+		if lead != "" {
+			t.emit(lead)
+		}
+		return t.stmts(flatten(s.then))
+	case cond == "false" && s.els != nil:
+		if lead != "" {
+			t.emit(lead)
+		}
+		return t.stmts(flatten(s.els))
+	case cond == "false":
+		return false
+	}
+
+	t.emit(lead + "if " + cond + " {\n")
+	term := t.block(s.then)
+	if s.els == nil {
+		t.emit("}\n")
+		return false
+	}
+
+	// else if
+	if x, ok := s.els.(*cif); ok && len(x.tok.comments) == 0 {
+		t.emit("} else ")
+		t.chunks[len(t.chunks)-1].text = strings.TrimSuffix(t.chunks[len(t.chunks)-1].text, "\n")
+		saved := t.depth
+		t.depth = 0
+		elseTerm := t.ifStmt(x)
+		t.depth = saved
+		return term && elseTerm
+	}
+
+	t.emit("} else {\n")
+	elseTerm := t.block(s.els)
+	t.emit("}\n")
+	return term && elseTerm
+}
+
+// flatten returns the statements of s, when s is a block.
+func flatten(s cstmt) []cstmt {
+	if b, ok := s.(*cblock); ok {
+		return b.stmts
+	}
+	return []cstmt{s}
+}
+
+// isFail returns whether or not s only cleans up and returns false.
+func (t *translator) isFail(s cstmt) bool {
+	stmts := flatten(s)
+	for i, s := range stmts {
+		switch s := s.(type) {
+		case *creturn:
+			x, ok := s.x.(*cident)
+			return ok && x.tok.text == "false" && i == len(stmts)-1
+		case *cexprStmt:
+			c, ok := s.x.(*ccall)
+			if !ok || !cleanupFuncs[funcName(c)] {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// failBlock translates the block s, translating "return false" to return the
+// Go error failErr.
+func (t *translator) failBlock(s cstmt, failErr string) bool {
+	saved := t.failErr
+	defer func() { t.failErr = saved }()
+	t.failErr = failErr
+	return t.block(s)
+}
+
+// forStmt translates a for or while statement.
+func (t *translator) forStmt(s *cfor) bool {
+	t.push()
+	defer t.pop()
+
+	var init, post string
+	switch x := s.init.(type) {
+	case *cdeclStmt:
+		if len(x.decls) != 1 || x.decls[0].init == nil {
+			t.errorf(x.tok, "unsupported for loop declaration")
+			break
+		}
+		d := x.decls[0]
+		typ, _ := goType(d.typ)
+		v := t.declare(d.name.text, typ, true)
+		val, _ := t.expr(d.init)
+		init = v.name + " := " + val
+	case *cexprStmt:
+		init = t.simpleStmt(x.x)
+	}
+	if s.post != nil {
+		post = t.simpleStmt(s.post)
+	}
+
+	var cond string
+	if s.cond != nil {
+		cond = t.cond(s.cond)
+	}
+
+	header := "for " + cond
+	if s.tok.text == "for" && (init != "" || post != "") {
+		header = "for " + init + "; " + cond + "; " + post
+	}
+	t.emit(leading(s.tok) + strings.TrimSpace(header) + " {\n")
+	t.block(s.body)
+	t.emit("}\n")
+	return false
+}
+
+// simpleStmt translates an assignment, increment or decrement expression for
+// use as a Go simple statement.
+func (t *translator) simpleStmt(x cexpr) string {
+	switch x := x.(type) {
+	case *cassign:
+		// a = b = c = true
+		lhs, y := []cexpr{x.x}, x.y
+		for {
+			z, ok := y.(*cassign)
+			if !ok || x.op.text != "=" || z.op.text != "=" {
+				break
+			}
+			lhs, y = append(lhs, z.x), z.y
+		}
+		var names, vals []string
+		for _, l := range lhs {
+			name, typ := t.lvalue(l)
+			val, vtyp := t.expr(y)
+			names, vals = append(names, name), append(vals, t.convert(val, vtyp, typ))
+		}
+		return strings.Join(names, ", ") + " " + x.op.text + " " + strings.Join(vals, ", ")
+	case *cpostfix:
+		name, _ := t.lvalue(x.x)
+		return name + x.op.text
+	case *cunary:
+		if x.op.text == "++" || x.op.text == "--" {
+			name, _ := t.lvalue(x.x)
+			return name + x.op.text
+		}
+	}
+	t.errorf(x.first(), "unsupported statement")
+	return ""
+}
+
+// lvalue translates the left hand side of an assignment. Unlike expr, the
+// variable assigned is not marked as read.
+func (t *translator) lvalue(x cexpr) (string, string) {
+	if id, ok := x.(*cident); ok {
+		if v := t.lookup(id.tok.text); v != nil {
+			return v.name, v.typ
+		}
+	}
+	if v := rootVar(t, x); v != nil {
+		// expr marks the root as read
+		read := v.read
+		defer func() { v.read = read }()
+	}
+	return t.expr(x)
+}
+
+// rootVar returns the local variable at the root of the member or index
+// expression x.
+func rootVar(t *translator, x cexpr) *cvar {
+	for {
+		switch y := x.(type) {
+		case *cident:
+			if v := t.lookup(y.tok.text); v != nil && v.local {
+				return v
+			}
+			return nil
+		case *cmember:
+			x = y.x
+		case *cindex:
+			x = y.x
+		default:
+			return nil
+		}
+	}
+}
+
+// assigned returns the local variable assigned by the expression x.
+func assigned(t *translator, x cexpr) *cvar {
+	switch x := x.(type) {
+	case *cassign:
+		if _, ok := x.y.(*cassign); !ok {
+			return rootVar(t, x.x)
+		}
+	case *cpostfix:
+		return rootVar(t, x.x)
+	case *cunary:
+		if x.op.text == "++" || x.op.text == "--" {
+			return rootVar(t, x.x)
+		}
+	}
+	return nil
+}
+
+// cleanupFuncs are the psql funcs that free memory, which are not translated.
+var cleanupFuncs = map[string]bool{
+	"initPQExpBuffer":   true,
+	"termPQExpBuffer":   true,
+	"PQclear":           true,
+	"free":              true,
+	"printTableCleanup": true,
+}
+
+// funcName returns the name of the func called by c.
+func funcName(c *ccall) string {
+	if id, ok := c.fn.(*cident); ok {
+		return id.tok.text
+	}
+	return ""
+}
+
+// exprStmt translates an expression statement, returning whether or not it
+// terminates.
+func (t *translator) exprStmt(s *cexprStmt) bool {
+	lead := leading(s.tok)
+
+	// res = PSQLexec(buf.data);
+	if x, ok := s.x.(*cassign); ok && x.op.text == "=" {
+		if c, ok := x.y.(*ccall); ok && funcName(c) == "PSQLexec" {
+			if len(c.args) != 1 || !t.isQueryData(c.args[0]) {
+				t.errorf(c.fn.first(), "unsupported PSQLexec of other than the query buffer")
+			}
+			if t.queryOnly {
+				t.emit(lead + "return nil\n")
+				t.done = true
+				return true
+			}
+			name, typ := t.lvalue(x.x)
+			if typ != goResult {
+				t.errorf(x.op, "unsupported assignment of PSQLexec to %s", typ)
+			}
+			t.exec = true
+			t.emit(lead + name + ", err = d.psqlExec(w)\nif " + name + " == nil {\n\treturn err\n}\n")
+			return false
+		}
+	}
+
+	// added = processSQLNamePattern(...);
+	if x, ok := s.x.(*cassign); ok && x.op.text == "=" {
+		if c, ok := x.y.(*ccall); ok && funcName(c) == "processSQLNamePattern" {
+			name, _ := t.expr(x.x)
+			t.exec = true
+			t.emit(lead + "if " + name + ", err = " + t.namePattern(c) + "; err != nil {\n\treturn err\n}\n")
+			return false
+		}
+	}
+
+	// assignments to local variables are only generated when read
+	if v := assigned(t, s.x); v != nil {
+		t.emitFor(v, func() string {
+			return lead + t.simpleStmt(s.x) + "\n"
+		})
+		return false
+	}
+
+	c, ok := s.x.(*ccall)
+	if !ok {
+		t.emit(lead + t.simpleStmt(s.x) + "\n")
+		return false
+	}
+
+	name := funcName(c)
+	switch {
+	case cleanupFuncs[name]:
+		if lead != "" {
+			t.emit(lead)
+		}
+		return false
+
+	case name == "pg_log_error" || name == "psql_error":
+		t.emit(lead + "return fmt.Errorf(" + t.list(nil, c.args, t.exprOnly) + ")\n")
+		return true
+
+	case name == "appendPQExpBufferStr":
+		if len(c.args) != 2 {
+			break
+		}
+		b := t.bufArg(c.args[0])
+		t.emit(lead + "fmt.Fprint(" + b + t.list(c.args[0], c.args[1:], t.exprOnly) + ")\n")
+		return false
+
+	case name == "appendPQExpBufferChar":
+		if len(c.args) != 2 {
+			break
+		}
+		b := t.bufArg(c.args[0])
+		t.emit(lead + "fmt.Fprintf(" + b + ", \"%c\"" + t.list(c.args[0], c.args[1:], t.exprOnly) + ")\n")
+		return false
+
+	case name == "appendPQExpBuffer" || name == "printfPQExpBuffer":
+		if len(c.args) < 2 {
+			break
+		}
+		b := t.bufArg(c.args[0])
+		if name == "printfPQExpBuffer" && b != "w" {
+			lead += b + ".Reset()\n"
+		}
+		t.emit(lead + t.printf(b, c.args[0], c.args[1], c.args[2:]) + "\n")
+		return false
+
+	case name == "resetPQExpBuffer":
+		if len(c.args) != 1 {
+			break
+		}
+		// the query buffer is reset by psqlExec
+		if b := t.bufArg(c.args[0]); b != "w" {
+			t.emit(lead + b + ".Reset()\n")
+		} else if lead != "" {
+			t.emit(lead)
+		}
+		return false
+
+	case name == "printQuery":
+		if len(c.args) != 5 {
+			break
+		}
+		res, _ := t.expr(c.args[0])
+		opt, _ := t.expr(c.args[1])
+		t.emit(lead + "if err = d.psqlPrintQuery(w, " + res + ", " + opt + "); err != nil {\n\treturn err\n}\n")
+		return false
+
+	case t.isErrCall(c):
+		t.emit(lead + "if err := " + t.errCall(c) + "; err != nil {\n\treturn err\n}\n")
+		return false
+
+	case name == "processSQLNamePattern":
+		t.emit(lead + "if _, err := " + t.namePattern(c) + "; err != nil {\n\treturn err\n}\n")
+		return false
+
+	default:
+		s, _ := t.call(c)
+		t.emit(lead + s + "\n")
+		return false
+	}
+
+	t.errorf(c.fn.first(), "wrong number of arguments to %s", name)
+	return false
+}
+
+// isQueryData returns whether or not x is buf.data, the query.
+func (t *translator) isQueryData(x cexpr) bool {
+	m, ok := x.(*cmember)
+	if !ok || m.op.text != "." || m.sel.text != "data" {
+		return false
+	}
+	id, ok := m.x.(*cident)
+	if !ok {
+		return false
+	}
+	v := t.lookup(id.tok.text)
+	return v != nil && v.query
+}
+
+// bufArg translates a PQExpBuffer argument (ie, &buf).
+func (t *translator) bufArg(x cexpr) string {
+	if u, ok := x.(*cunary); ok && u.op.text == "&" {
+		x = u.x
+	}
+	if id, ok := x.(*cident); ok {
+		if v := t.lookup(id.tok.text); v != nil && v.typ == goBuffer {
+			v.read = true
+			return v.name
+		}
+	}
+	t.errorf(x.first(), "expected PQExpBuffer")
+	return "w"
+}
+
+// printf translates a printf style call writing format and args to the
+// buffer b.
+//
+// Caller supplied values quoted in the query (ie, '%s' for oid) are passed
+// as query parameters (see literal in festring.go). A translated format
+// (ie, _("...")) is formatted by Gettext.
+func (t *translator) printf(b string, buf, format cexpr, args []cexpr) string {
+	if c, ok := format.(*ccall); ok && funcName(c) == "_" && len(c.args) == 1 {
+		return "fmt.Fprint(" + b + "," + t.sep(buf, format) + "Gettext(" + t.list(nil, append(c.args, args...), t.exprOnly) + "))"
+	}
+	str, ok := format.(*cstring)
+	if !ok || b != "w" {
+		return "fmt.Fprintf(" + b + t.list(buf, append([]cexpr{format}, args...), t.exprOnly) + ")"
+	}
+
+	// find the verbs quoting parameters
+	params := make(map[int]bool)
+	var verb int
+	var parts []token
+	for _, part := range str.parts {
+		if part.kind != tokString {
+			parts = append(parts, part)
+			continue
+		}
+		s := part.text
+		for i := 0; i < len(s); i++ {
+			if s[i] != '%' {
+				continue
+			}
+			if i+1 < len(s) && s[i+1] == '%' {
+				i++
+				continue
+			}
+			if strings.HasPrefix(s[i:], "%s'") && i > 0 && s[i-1] == '\'' && verb < len(args) && t.isParam(args[verb]) {
+				s = s[:i-1] + "%s" + s[i+3:]
+				params[verb] = true
+			}
+			verb++
+		}
+		part.text = s
+		parts = append(parts, part)
+	}
+
+	return "fmt.Fprintf(" + b + "," + t.sep(buf, format) + t.str(&cstring{parts: parts}) + t.list(format, args, func(i int, x cexpr) string {
+		v, _ := t.expr(x)
+		if params[i] {
+			return "literal(w, " + v + ")"
+		}
+		return v
+	}) + ")"
+}
+
+// isParam returns whether or not x is a string parameter of the func.
+func (t *translator) isParam(x cexpr) bool {
+	if id, ok := x.(*cident); ok {
+		v := t.lookup(id.tok.text)
+		for _, p := range t.params {
+			if v == p && p.typ == goString {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// list translates a list of args following prev, each preceded by a comma.
+// When prev is nil, the first arg is not preceded by a comma.
+func (t *translator) list(prev cexpr, args []cexpr, f func(int, cexpr) string) string {
+	var s string
+	for i, arg := range args {
+		if prev != nil {
+			s += "," + t.sep(prev, arg)
+		}
+		s += f(i, arg)
+		prev = arg
+	}
+	return s
+}
+
+// exprOnly translates x, discarding its type.
+func (t *translator) exprOnly(_ int, x cexpr) string {
+	s, _ := t.expr(x)
+	return s
+}
+
+// sep returns the separator between the args prev and x, preserving the line
+// breaks and comments of the C source.
+func (t *translator) sep(prev, x cexpr) string {
+	tok := x.first()
+	var s string
+	for _, c := range tok.trailing {
+		s += " " + strings.Replace(goComment(c), "\n// ", " ", -1)
+	}
+	for _, c := range tok.comments {
+		s += "\n" + goComment(c)
+	}
+	if s != "" || tok.line > lastLine(prev) {
+		return s + "\n"
+	}
+	return " "
+}
+
+// lastLine returns the last line of the expression x in the C source.
+func lastLine(x cexpr) int {
+	switch x := x.(type) {
+	case *cstring:
+		return x.parts[len(x.parts)-1].line
+	case *ccall:
+		if len(x.args) != 0 {
+			return lastLine(x.args[len(x.args)-1])
+		}
+		return lastLine(x.fn)
+	case *cunary:
+		return lastLine(x.x)
+	case *cbinary:
+		return lastLine(x.y)
+	case *cassign:
+		return lastLine(x.y)
+	case *ccond:
+		return lastLine(x.y)
+	case *cindex:
+		return lastLine(x.index)
+	case *cmember:
+		return x.sel.line
+	case *cparen:
+		return lastLine(x.x)
+	case *ccast:
+		return lastLine(x.x)
+	case *cinit:
+		if len(x.elems) != 0 {
+			return lastLine(x.elems[len(x.elems)-1])
+		}
+	}
+	return x.first().line
+}
+
+// isErrCall returns whether or not c calls a func translated to return an
+// error.
+func (t *translator) isErrCall(c *ccall) bool {
+	name := funcName(c)
+	_, ok := t.names[name]
+	return ok || name == "validateSQLNamePattern"
+}
+
+// errCall translates a call to a func that returns an error.
+func (t *translator) errCall(c *ccall) string {
+	name := funcName(c)
+	if name != "validateSQLNamePattern" {
+		return "d." + t.names[name] + "(w" + t.list(c.fn, c.args, t.exprOnly) + ")"
+	}
+	if len(c.args) != 10 {
+		t.errorf(c.fn.first(), "wrong number of arguments to %s", name)
+		return "nil"
+	}
+	types := []string{goString, goBool, goBool, goString, goString, goString, goString, "*bool", goInt}
+	return "d." + name + "(" + t.bufArg(c.args[0]) + t.list(c.args[0], c.args[1:], func(i int, x cexpr) string {
+		v, typ := t.expr(x)
+		return t.convert(v, typ, types[i])
+	}) + ")"
+}
+
+// namePattern translates a call to processSQLNamePattern.
+func (t *translator) namePattern(c *ccall) string {
+	// the connection is not used, and the optional dbnamebuf and dotcnt are
+	// handled by processSQLNamePattern
+	switch len(c.args) {
+	case 9:
+	case 11:
+		for _, arg := range c.args[9:] {
+			if id, ok := arg.(*cident); !ok || id.tok.text != "NULL" {
+				t.errorf(arg.first(), "unsupported processSQLNamePattern argument")
+			}
+		}
+	default:
+		t.errorf(c.fn.first(), "wrong number of arguments to processSQLNamePattern")
+		return "nil"
+	}
+	return "d.processSQLNamePattern(" + t.bufArg(c.args[1]) + ", " + t.list(nil, c.args[2:9], t.exprOnly) + ")"
+}
+
+// convert converts the Go expression x of type from, for use as type to.
+func (t *translator) convert(x, from, to string) string {
+	if from == goNull && to != goString {
+		return "nil"
+	}
+	return x
+}
+
+// cond translates x for use as a boolean condition.
+func (t *translator) cond(x cexpr) string {
+	// strcmp(a, b) is true when a != b
+	if c, ok := x.(*ccall); ok && funcName(c) == "strcmp" {
+		return t.strcmp(c, "!=")
+	}
+	s, typ := t.expr(x)
+	return truth(s, typ, false)
+}
+
+// truth converts the Go expression s of type typ to a boolean (or its
+// negation).
+func truth(s, typ string, not bool) string {
+	var zero string
+	switch typ {
+	case goBool:
+		switch {
+		case !not:
+			return s
+		case s == "true":
+			return "false"
+		case s == "false":
+			return "true"
+		}
+		return "!" + s
+	case goString, goNull:
+		zero = "NULL"
+	case goResult, goBuffer:
+		zero = "nil"
+	default:
+		zero = "0"
+	}
+	if not {
+		return s + " == " + zero
+	}
+	return s + " != " + zero
+}
+
+// strcmp translates the comparison of the result of strcmp to 0.
+func (t *translator) strcmp(c *ccall, op string) string {
+	if len(c.args) != 2 {
+		t.errorf(c.fn.first(), "wrong number of arguments to strcmp")
+		return "false"
+	}
+	a, _ := t.expr(c.args[0])
+	b, _ := t.expr(c.args[1])
+	return a + " " + op + " " + b
+}
+
+// goPrec are the Go binary operator precedences.
+var goPrec = map[string]int{
+	"||": 1,
+	"&&": 2,
+	"==": 3, "!=": 3, "<": 3, "<=": 3, ">": 3, ">=": 3,
+	"+": 4, "-": 4, "|": 4, "^": 4,
+	"*": 5, "/": 5, "%": 5, "<<": 5, ">>": 5, "&": 5,
+}
+
+// operand translates x as an operand of a binary operator with precedence
+// prec, adding parens where needed.
+func (t *translator) operand(x cexpr, prec int, logical bool) (string, string) {
+	var s, typ string
+	if logical {
+		s, typ = t.cond(x), goBool
+	} else {
+		s, typ = t.expr(x)
+	}
+	if b, ok := x.(*cbinary); ok && goPrec[b.op.text] < prec {
+		s = "(" + s + ")"
+	} else if !ok && logical && prec > 3 && strings.ContainsAny(s, "=<>") {
+		s = "(" + s + ")"
+	}
+	return s, typ
+}
+
+// expr translates an expression, returning the Go expression and its type.
+func (t *translator) expr(x cexpr) (string, string) {
+	switch x := x.(type) {
+	case *cident:
+		return t.ident(x)
+
+	case *cbasic:
+		if x.tok.kind == tokChar {
+			return x.tok.text, goRune
+		}
+		return strings.TrimRight(x.tok.text, "lLuU"), goInt
+
+	case *cstring:
+		return t.str(x), goString
+
+	case *cparen:
+		s, typ := t.expr(x.x)
+		if _, ok := x.x.(*cbinary); !ok {
+			return s, typ
+		}
+		return "(" + s + ")", typ
+
+	case *ccall:
+		return t.call(x)
+
+	case *cmember:
+		return t.member(x)
+
+	case *cindex:
+		s, typ := t.expr(x.x)
+		i, _ := t.expr(x.index)
+		if !strings.HasPrefix(typ, "[]") {
+			t.errorf(x.first(), "unsupported index of %s", typ)
+		}
+		return s + "[" + i + "]", strings.TrimPrefix(typ, "[]")
+
+	case *cunary:
+		switch x.op.text {
+		case "!":
+			if c, ok := x.x.(*ccall); ok && funcName(c) == "strcmp" {
+				return t.strcmp(c, "=="), goBool
+			}
+			s, typ := t.expr(x.x)
+			if _, ok := x.x.(*cbinary); ok {
+				s = "(" + s + ")"
+			}
+			return truth(s, typ, true), goBool
+		case "-", "+":
+			s, typ := t.expr(x.x)
+			return x.op.text + s, typ
+		case "&":
+			if id, ok := x.x.(*cident); ok {
+				if v := t.lookup(id.tok.text); v != nil && v.typ == goBuffer {
+					return t.bufArg(x.x), goBuffer
+				}
+			}
+			s, typ := t.expr(x.x)
+			return "&" + s, "*" + typ
+		}
+
+	case *cbinary:
+		op := x.op.text
+		switch op {
+		case "&&", "||":
+			a, _ := t.operand(x.x, goPrec[op], true)
+			b, _ := t.operand(x.y, goPrec[op]+1, true)
+			switch {
+			case op == "&&" && a == "true", op == "||" && a == "false":
+				return b, goBool
+			case op == "&&" && b == "true", op == "||" && b == "false":
+				return a, goBool
+			case op == "&&" && (a == "false" || b == "false"):
+				return "false", goBool
+			case op == "||" && (a == "true" || b == "true"):
+				return "true", goBool
+			}
+			return a + " " + op + " " + b, goBool
+
+		case "==", "!=":
+			// strcmp(a, b) == 0
+			if c, ok := x.x.(*ccall); ok && funcName(c) == "strcmp" {
+				if n, ok := x.y.(*cbasic); ok && n.tok.text == "0" {
+					return t.strcmp(c, op), goBool
+				}
+			}
+			a, atyp := t.operand(x.x, goPrec[op], false)
+			b, btyp := t.operand(x.y, goPrec[op]+1, false)
+			return t.convert(a, atyp, btyp) + " " + op + " " + t.convert(b, btyp, atyp), goBool
+		}
+		if _, ok := goPrec[op]; ok && op != "^" {
+			a, typ := t.operand(x.x, goPrec[op], false)
+			b, _ := t.operand(x.y, goPrec[op]+1, false)
+			if goPrec[op] == 3 {
+				typ = goBool
+			}
+			return a + " " + op + " " + b, typ
+		}
+
+	case *ccast:
+		if typ, ok := goType(x.typ); ok && (typ == goInt || typ == goBool) {
+			s, _ := t.expr(x.x)
+			return typ + "(" + s + ")", typ
+		}
+		t.errorf(x.tok, "unsupported cast to %s", x.typ)
+		return "nil", ""
+
+	case *ccond:
+		t.errorf(x.first(), "unsupported conditional expression")
+		return "nil", ""
+
+	case *csizeof:
+		t.errorf(x.tok, "unsupported sizeof")
+		return "0", goInt
+
+	case *cinit:
+		t.errorf(x.tok, "unsupported initializer list")
+		return "nil", ""
+
+	case *cassign, *cpostfix:
+		t.errorf(x.first(), "unsupported assignment in expression")
+		return "nil", ""
+	}
+	t.errorf(x.first(), "unsupported expression")
+	return "nil", ""
+}
+
+// ident translates an identifier.
+func (t *translator) ident(x *cident) (string, string) {
+	name := x.tok.text
+	if v := t.lookup(name); v != nil {
+		v.read = true
+		return v.name, v.typ
+	}
+	switch name {
+	case "NULL":
+		return "NULL", goNull
+	case "true", "false":
+		return name, goBool
+	case "cancel_pressed":
+		return "d.context().Err() != nil", goBool
+	}
+	if c, ok := t.consts[name]; ok {
+		if isCharConst(c) {
+			return name, goRune
+		}
+		return name, goInt
+	}
+	t.errorf(x.tok, "unsupported identifier %s", name)
+	return name, ""
+}
+
+// member translates a member expression.
+func (t *translator) member(x *cmember) (string, string) {
+	if x.op.text != "." {
+		t.errorf(x.op, "unsupported %s", x.op.text)
+		return "nil", ""
+	}
+
+	// psql settings
+	if id, ok := x.x.(*cident); ok && id.tok.text == "pset" {
+		switch x.sel.text {
+		case "sversion":
+			return "d.version", goInt
+		case "quiet":
+			// errors are always returned
+			return "false", goBool
+		}
+		t.errorf(x.sel, "unsupported pset.%s", x.sel.text)
+		return "nil", ""
+	}
+
+	s, typ := t.expr(x.x)
+	switch {
+	case typ == goBuffer && x.sel.text == "data" && s != "w":
+		return s + ".String()", goString
+	case typ == goBuffer && x.sel.text == "len" && s != "w":
+		return s + ".Len()", goInt
+	}
+	if f, ok := goFields[typ][x.sel.text]; ok {
+		return s + "." + f[0], f[1]
+	}
+	t.errorf(x.sel, "unsupported member %s of %s", x.sel.text, typ)
+	return "nil", ""
+}
+
+// call translates a func call used as an expression.
+func (t *translator) call(c *ccall) (string, string) {
+	name := funcName(c)
+	nargs := func(n int) bool {
+		if len(c.args) != n {
+			t.errorf(c.fn.first(), "wrong number of arguments to %s", name)
+			return false
+		}
+		return true
+	}
+	switch name {
+	case "gettext_noop":
+		if nargs(1) {
+			return "GettextNoop(" + t.list(nil, c.args, t.exprOnly) + ")", goString
+		}
+	case "_":
+		if nargs(1) {
+			return "Gettext(" + t.list(nil, c.args, t.exprOnly) + ")", goString
+		}
+	case "formatPGVersionNumber":
+		return "d.sversion", goString
+	case "strchr":
+		if nargs(2) {
+			return "strchr(" + t.list(nil, c.args, t.exprOnly) + ")", goString
+		}
+	case "strlen", "strspn":
+		if nargs(map[string]int{"strlen": 1, "strspn": 2}[name]) {
+			return name + "(" + t.list(nil, c.args, t.exprOnly) + ")", goInt
+		}
+	case "fmtId":
+		if nargs(1) {
+			return "fmtId(" + t.list(nil, c.args, t.exprOnly) + ")", goString
+		}
+	case "lengthof":
+		if nargs(1) {
+			s, _ := t.expr(c.args[0])
+			return "len(" + s + ")", goInt
+		}
+	case "PQntuples":
+		if nargs(1) {
+			s, _ := t.expr(c.args[0])
+			return s + ".Len()", goInt
+		}
+	case "PQgetvalue", "PQgetisnull":
+		if nargs(3) {
+			s, _ := t.expr(c.args[0])
+			if name == "PQgetisnull" {
+				return s + ".IsNull(" + t.list(nil, c.args[1:], t.exprOnly) + ")", goBool
+			}
+			return s + ".Value(" + t.list(nil, c.args[1:], t.exprOnly) + ")", goString
+		}
+	case "processSQLNamePattern":
+		t.errorf(c.fn.first(), "unsupported use of %s, other than as a statement or assignment", name)
+	case "printACLColumn":
+		if nargs(2) {
+			return "d.printACLColumn(" + t.bufArg(c.args[0]) + t.list(c.args[0], c.args[1:], t.exprOnly) + ")", ""
+		}
+	case "strcmp":
+		t.errorf(c.fn.first(), "unsupported use of strcmp, other than comparing to 0")
+	case "":
+		t.errorf(c.fn.first(), "unsupported call of expression")
+	default:
+		if t.isErrCall(c) {
+			t.errorf(c.fn.first(), "unsupported use of %s, other than as a statement or failure check", name)
+		} else {
+			t.errorf(c.fn.first(), "unsupported call to %s", name)
+		}
+	}
+	return "nil", ""
+}
+
+// str translates a string literal concatenation.
+//
+// Literals on the same line in the C source are joined, and literals on
+// separate lines are concatenated with +. CppAsString2 macros are quoted,
+// as by the C preprocessor.
+func (t *translator) str(x *cstring) string {
+	var pieces []string
+	var lit strings.Builder
+	var inLit bool
+	line := x.parts[0].line
+	flush := func() {
+		if inLit {
+			pieces = append(pieces, strconv.Quote(lit.String()))
+			lit.Reset()
+			inLit = false
+		}
+	}
+	var s string
+	for _, part := range x.parts {
+		if part.line != line {
+			flush()
+			s += strings.Join(pieces, " + ") + " +\n"
+			pieces, line = nil, part.line
+		}
+		if part.kind == tokString {
+			v, err := unquoteC(part.text[1 : len(part.text)-1])
+			if err != nil {
+				t.errorf(part, "%v", err)
+			}
+			lit.WriteString(v)
+			inLit = true
+			continue
+		}
+		// CppAsString2
+		c, ok := t.consts[part.text]
+		switch {
+		case !ok:
+			t.errorf(part, "unsupported identifier %s", part.text)
+		case isCharConst(c):
+			lit.WriteString("'")
+			inLit = true
+			flush()
+			pieces = append(pieces, "string("+part.text+")")
+			lit.WriteString("'")
+			inLit = true
+		default:
+			flush()
+			pieces = append(pieces, "strconv.Itoa("+part.text+")")
+		}
+	}
+	flush()
+	return s + strings.Join(pieces, " + ")
+}
+
+// unquoteC unquotes the contents of a C string literal.
+func unquoteC(s string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i++; i >= len(s) {
+			return "", errors.New("invalid escape at end of string")
+		}
+		switch c := s[i]; c {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case 'r':
+			b.WriteByte('\r')
+		case '\\', '\'', '"', '?':
+			b.WriteByte(c)
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			n := 0
+			for j := 0; j < 3 && i < len(s) && '0' <= s[i] && s[i] <= '7'; i, j = i+1, j+1 {
+				n = n*8 + int(s[i]-'0')
+			}
+			i--
+			b.WriteByte(byte(n))
+		default:
+			return "", fmt.Errorf("unsupported escape \\%c", c)
+		}
+	}
+	return b.String(), nil
+}
+
+// cache holds information about a cached file.
+type cache struct {
+	path   string
+	ttl    time.Duration
+	decode bool
+	url    string
+}
+
+// get retrieves a file from disk or from the remote URL, optionally base64
+// decoding it and writing it to disk.
+func get(c cache) ([]byte, error) {
+	var err error
+
+	if err = os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return nil, err
+	}
+
+	// check if exists on disk
+	fi, err := os.Stat(c.path)
+	if err == nil && c.ttl != 0 && !time.Now().After(fi.ModTime().Add(c.ttl)) {
+		return ioutil.ReadFile(c.path)
+	}
+
+	logf("RETRIEVING: %s", c.url)
+
+	// retrieve
+	cl := &http.Client{}
+	req, err := http.NewRequest("GET", c.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := cl.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	buf, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// decode
+	if c.decode {
+		buf, err = base64.StdEncoding.DecodeString(string(buf))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	logf("WRITING: %s", c.path)
+	if err = ioutil.WriteFile(c.path, buf, 0644); err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// source is a postgres source tree.
+type source interface {
+	// read reads the file with the path relative to the root of the source
+	// tree.
+	read(name string) ([]byte, error)
+
+	// revision returns a description of the source revision.
+	revision() string
+}
+
+// openSource opens the postgres source specified by the -src or -ref flags.
+func openSource() (source, error) {
+	switch fi, err := os.Stat(*flagSrc); {
+	case *flagSrc == "":
+		return newRemoteSource(*flagRef)
+	case err != nil:
+		return nil, err
+	case fi.IsDir():
+		return newDirSource(*flagSrc)
+	}
+	return newTarSource(*flagSrc)
+}
+
+// remoteSource is a source retrieved from GitHub, pinned to a commit.
+type remoteSource struct {
+	ref    string
+	commit string
+}
+
+// newRemoteSource creates a remote source for the tag or branch ref,
+// resolving it to a commit.
+func newRemoteSource(ref string) (*remoteSource, error) {
+	logf("RESOLVING: %s", ref)
+	req, err := http.NewRequest("GET", postgresCommits+ref, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github.sha")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	buf, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not resolve ref %q: %s", ref, res.Status)
+	}
+	return &remoteSource{
+		ref:    ref,
+		commit: strings.TrimSpace(string(buf)),
+	}, nil
+}
+
+// read satisfies the source interface.
+func (s *remoteSource) read(name string) ([]byte, error) {
+	return get(cache{
+		url:  postgresRaw + s.commit + "/" + name,
+		path: filepath.Join(*flagCache, s.commit, filepath.FromSlash(name)),
+		ttl:  *flagTTL,
+	})
+}
+
+// revision satisfies the source interface.
+func (s *remoteSource) revision() string {
+	return fmt.Sprintf("%s (%s)", s.ref, s.commit)
+}
+
+// dirSource is a local postgres checkout.
+type dirSource struct {
+	dir string
+	rev string
+}
+
+// newDirSource creates a source for a local postgres checkout, using git (if
+// available) to determine the checked out revision.
+func newDirSource(dir string) (*dirSource, error) {
+	s := &dirSource{dir: dir}
+	if out, err := exec.Command("git", "-C", dir, "describe", "--tags", "--always", "--dirty").Output(); err == nil {
+		s.rev = strings.TrimSpace(string(out))
+	}
+	if out, err := exec.Command("git", "-C", dir, "rev-parse", "HEAD").Output(); err == nil {
+		s.rev = fmt.Sprintf("%s (%s)", s.rev, strings.TrimSpace(string(out)))
+	}
+	if s.rev == "" {
+		buf, err := s.read(configureac)
+		if err != nil {
+			return nil, err
+		}
+		s.rev = configureVersion(buf, filepath.Base(dir))
+	}
+	return s, nil
+}
+
+// read satisfies the source interface.
+func (s *dirSource) read(name string) ([]byte, error) {
+	logf("READING: %s", name)
+	return ioutil.ReadFile(filepath.Join(s.dir, filepath.FromSlash(name)))
+}
+
+// revision satisfies the source interface.
+func (s *dirSource) revision() string {
+	return s.rev
+}
+
+// tarSource is a postgres source tarball (ie, postgresql-16.1.tar.bz2, or a
+// tarball created by git archive).
+type tarSource struct {
+	files map[string][]byte
+	rev   string
+}
+
+// newTarSource creates a source for a postgres source tarball, reading the
+// used files into memory.
+func newTarSource(path string) (*tarSource, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	// decompress
+	var r io.Reader = f
+	switch {
+	case strings.HasSuffix(path, ".gz") || strings.HasSuffix(path, ".tgz"):
+		if r, err = gzip.NewReader(f); err != nil {
+			return nil, err
+		}
+	case strings.HasSuffix(path, ".bz2"):
+		r = bzip2.NewReader(f)
+	}
+
+	want := map[string]bool{
+		helpc: true, describeh: true, describec: true,
+		configureac: true,
+	}
+	for _, c := range catalogConsts {
+		want[c.path] = true
+	}
+	s := &tarSource{files: make(map[string][]byte)}
+	var commit string
+	tr := tar.NewReader(r)
+	for {
+		h, err := tr.Next()
+		switch {
+		case err == io.EOF:
+			buf, ok := s.files[configureac]
+			if !ok {
+				return nil, fmt.Errorf("%s: not a postgres source tarball", path)
+			}
+			s.rev = configureVersion(buf, filepath.Base(path))
+			if commit != "" {
+				s.rev = fmt.Sprintf("%s (%s)", s.rev, commit)
+			}
+			return s, nil
+		case err != nil:
+			return nil, err
+		}
+
+		// git archive records the commit in the global header
+		if h.Typeflag == tar.TypeXGlobalHeader {
+			commit = h.PAXRecords["comment"]
+			continue
+		}
+
+		// strip the leading directory (ie, postgresql-16.1/)
+		name := h.Name
+		if i := strings.Index(name, "/"); i != -1 {
+			name = name[i+1:]
+		}
+		if h.Typeflag != tar.TypeReg || !want[name] {
+			continue
+		}
+		if s.files[name], err = ioutil.ReadAll(tr); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// read satisfies the source interface.
+func (s *tarSource) read(name string) ([]byte, error) {
+	logf("READING: %s", name)
+	buf, ok := s.files[name]
+	if !ok {
+		return nil, fmt.Errorf("%s: not found in tarball", name)
+	}
+	return buf, nil
+}
+
+// revision satisfies the source interface.
+func (s *tarSource) revision() string {
+	return s.rev
+}
+
+// configureVersionRE is a regexp matching the version in configure.ac.
+var configureVersionRE = regexp.MustCompile(`AC_INIT\(\[PostgreSQL\],\s*\[([^\]]+)\]`)
+
+// configureVersion returns the version in configure.ac, or def when the
+// version cannot be determined.
+func configureVersion(buf []byte, def string) string {
+	if m := configureVersionRE.FindSubmatch(buf); m != nil {
+		return string(m[1])
+	}
+	return def
+}
 
 // logf is a wrapper around log.Printf.
 func logf(s string, v ...interface{}) {
@@ -776,6 +3376,8 @@ package pgdesc
 
 // Code generated by gen.go. DO NOT EDIT.
 
+// Generated from PostgreSQL %s.
+
 //go:generate go run gen.go
 
 import (
@@ -783,9 +3385,55 @@ import (
 	"io"
 )
 
-// Postgres RELKIND and other related constants.
+// Postgres catalog constants (RELKIND, PROKIND, CONSTRAINT, etc).
+const (%s
+)
+%s
+`
+
+	// enumTemplate is the template for a catalog enum's named type.
+	enumTemplate = `
+// %s is %s (%s).
+type %s rune
+
+// %s values.
 const (%s
 )
 
+// String satisfies the fmt.Stringer interface.
+func (v %s) String() string {
+	switch v {%s
+	}
+	return fmt.Sprintf("%s(%%q)", rune(v))
+}
+
+// Valid returns whether or not v is a valid %s.
+func (v %s) Valid() bool {
+	switch v {
+	case %s:
+		return true
+	}
+	return false
+}
+
+// Parse%s parses s as a %s, from either the
+// %s value or the name returned by String.
+func Parse%s(s string) (%s, error) {
+	switch s {%s
+	}
+	return 0, fmt.Errorf("invalid %s %%q", s)
+}
+`
+
+	// helpStart is the start of the generated help code.
+	helpStart = `package pgdesc
+
+// Code generated by gen.go. DO NOT EDIT.
+
+// Generated from PostgreSQL %s.
+
+// HelpSections are the sections of psql's \? meta-command help, generated
+// from help.c.
+var HelpSections = []HelpSection{
 `
 )
@@ -0,0 +1,83 @@
+package pgdesc
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGenerate runs gen.go against the reduced postgres source in
+// testdata/gen, and compares the generated files with the golden files
+// testdata/gen/pgdesc.go.golden and testdata/gen/pghelp.go.golden. Run with
+// -update to refresh the golden files after changing gen.go.
+func TestGenerate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping go run gen.go in short mode")
+	}
+	// copy the source out of the repository, so that the revision in the
+	// header is read from configure.ac, and not from git
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src")
+	if err := copyDir(src, filepath.Join("testdata", "gen")); err != nil {
+		t.Fatal(err)
+	}
+	out, help := filepath.Join(dir, "pgdesc.go"), filepath.Join(dir, "pghelp.go")
+	cmd := exec.Command("go", "run", "gen.go",
+		"-src", src,
+		"-o", out,
+		"-help-out", help,
+		"-cache", filepath.Join(dir, "cache"),
+	)
+	if buf, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("expected no error, got: %v\n%s", err, buf)
+	}
+	for _, name := range []string{out, help} {
+		buf, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkGolden(t, filepath.Join("testdata", "gen", filepath.Base(name)+".golden"), buf)
+	}
+
+	// the translations of !pattern and CppAsString2
+	buf, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		"if !showSystem && pattern == NULL {\n\t\tfmt.Fprint(w, \"WHERE l.lanplcallfoid != 0\\n\")",
+		"if err := d.validateSQLNamePattern(w, pattern,\n\t\t!showSystem && pattern == NULL, false,",
+		"\"WHERE t.typtype = '\"+string(TYPTYPE_DOMAIN)+\"'\\n\"",
+		"\" WHEN '\"+string(AMTYPE_INDEX)+\"' THEN '%s'\"",
+	} {
+		if !strings.Contains(string(buf), s) {
+			t.Errorf("expected generated code to contain:\n%s", s)
+		}
+	}
+}
+
+// copyDir recursively copies the files in src to dst.
+func copyDir(dst, src string) error {
+	return filepath.Walk(src, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		switch {
+		case fi.IsDir():
+			return os.MkdirAll(filepath.Join(dst, name), 0o755)
+		case strings.HasSuffix(name, ".golden"):
+			return nil
+		}
+		buf, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(dst, name), buf, 0o644)
+	})
+}
@@ -1,6 +1,9 @@
 module github.com/xo/pgdesc
 
+go 1.21
+
 require (
 	github.com/knq/snaker v0.0.0-20181215144011-2bc8a4db4687
+	github.com/lib/pq v1.9.0
 	golang.org/x/tools v0.0.0-20190128232029-0a99049195af
 )
@@ -0,0 +1,76 @@
+package pgdesc
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// HelpSection is a section of psql's \? meta-command help (ie, "General",
+// "Informational").
+type HelpSection struct {
+	// Title is the section title.
+	Title string
+
+	// Commands are the section's commands.
+	Commands []HelpCommand
+}
+
+// HelpCommand is a meta-command's help text.
+//
+// Entries without a Name are notes (ie, "(options: S = show system objects,
+// + = additional detail)").
+type HelpCommand struct {
+	// Name is the command name, without the leading backslash or any
+	// options (ie, "dA" for "\dA[+]  [PATTERN]").
+	Name string
+
+	// Syntax is the command syntax (ie, "\dA[+]  [PATTERN]").
+	Syntax string
+
+	// Description is the command description. Descriptions of commands that
+	// show a current setting contain a "%s" verb (ie, "toggle expanded
+	// output (currently %s)").
+	Description string
+
+	// Text is the command's formatted help text, as printed by psql,
+	// including the trailing newline.
+	Text string
+}
+
+// PrintHelp prints the meta-command help in the same manner as psql's \?.
+//
+// The current settings shown for commands such as \x and \timing are looked
+// up by command name in current (ie, current["x"] = "off").
+func PrintHelp(w io.Writer, current map[string]string) error {
+	bw := bufio.NewWriter(w)
+	for i, section := range HelpSections {
+		if i != 0 {
+			bw.WriteString("\n")
+		}
+		bw.WriteString(translate(section.Title))
+		bw.WriteString("\n")
+		for _, cmd := range section.Commands {
+			if strings.Contains(cmd.Text, "%s") {
+				bw.WriteString(Gettext(cmd.Text, current[cmd.Name]))
+			} else {
+				bw.WriteString(translate(cmd.Text))
+			}
+		}
+	}
+	return bw.Flush()
+}
+
+// LookupHelp returns the help for the command name (ie, "dA" or "\dA"), or
+// nil when there is no help for the command.
+func LookupHelp(name string) *HelpCommand {
+	name = strings.TrimPrefix(name, `\`)
+	for i := range HelpSections {
+		for j := range HelpSections[i].Commands {
+			if cmd := &HelpSections[i].Commands[j]; cmd.Name == name && name != "" {
+				return cmd
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,340 @@
+package pgdesc
+
+// Manually translated describe.c funcs, for commands newer than the
+// describe.c that pgdesc.go was last generated from. gen.go skips these funcs
+// (see manualFuncs), so that regenerating does not duplicate them.
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ConfigurationParameters handles \dconfig.
+//
+// Manually translated from describeConfigurationParameters in psql's
+// describe.c.
+//
+// \dconfig
+//
+// Describes configuration parameters.
+func (d *PgDesc) ConfigurationParameters(w io.Writer, pattern string, verbose, showSystem bool) error {
+	fmt.Fprintf(w,
+		"SELECT s.name AS \"%s\", "+
+			"pg_catalog.current_setting(s.name) AS \"%s\"",
+		GettextNoop("Parameter"),
+		GettextNoop("Value"))
+
+	if verbose {
+		fmt.Fprintf(w,
+			", s.vartype AS \"%s\", s.context AS \"%s\", ",
+			GettextNoop("Type"),
+			GettextNoop("Context"))
+		if d.version >= 150000 {
+			d.printACLColumn(w, "p.paracl")
+		} else {
+			fmt.Fprintf(w, "NULL AS \"%s\"",
+				GettextNoop("Access privileges"))
+		}
+	}
+
+	fmt.Fprint(w, "\nFROM pg_catalog.pg_settings s\n")
+
+	if verbose && d.version >= 150000 {
+		fmt.Fprint(w,
+			"  LEFT JOIN pg_catalog.pg_parameter_acl p\n"+
+				"  ON pg_catalog.lower(s.name) = p.parname\n")
+	}
+
+	if pattern != NULL {
+		if _, err := d.processSQLNamePattern(w, pattern, false, false,
+			NULL, "pg_catalog.lower(s.name)", NULL,
+			NULL); err != nil {
+			return err
+		}
+	} else {
+		fmt.Fprint(w, "WHERE s.source <> 'default' AND\n"+
+			"      s.setting IS DISTINCT FROM s.boot_val\n")
+	}
+
+	fmt.Fprint(w, "ORDER BY 1;")
+
+	return nil
+}
+
+// ExtendedStats handles \dX.
+//
+// Manually translated from listExtendedStats in psql's describe.c.
+//
+// \dX
+//
+// Describes extended statistics.
+func (d *PgDesc) ExtendedStats(w io.Writer, pattern string) error {
+	if d.version < 100000 {
+		return fmt.Errorf("The server (version %s) does not support extended statistics.\n",
+			d.sversion)
+	}
+
+	fmt.Fprintf(w,
+		"SELECT \n"+
+			"es.stxnamespace::pg_catalog.regnamespace::pg_catalog.text AS \"%s\", \n"+
+			"es.stxname AS \"%s\", \n",
+		GettextNoop("Schema"),
+		GettextNoop("Name"))
+
+	if d.version >= 140000 {
+		fmt.Fprintf(w,
+			"pg_catalog.format('%%s FROM %%s', \n"+
+				"  pg_catalog.pg_get_statisticsobjdef_columns(es.oid), \n"+
+				"  es.stxrelid::pg_catalog.regclass) AS \"%s\"",
+			GettextNoop("Definition"))
+	} else {
+		fmt.Fprintf(w,
+			"pg_catalog.format('%%s FROM %%s', \n"+
+				"  (SELECT pg_catalog.string_agg(pg_catalog.quote_ident(a.attname),', ') \n"+
+				"   FROM pg_catalog.unnest(es.stxkeys) s(attnum) \n"+
+				"   JOIN pg_catalog.pg_attribute a \n"+
+				"   ON (es.stxrelid = a.attrelid \n"+
+				"   AND a.attnum = s.attnum \n"+
+				"   AND NOT a.attisdropped)), \n"+
+				"es.stxrelid::pg_catalog.regclass) AS \"%s\"",
+			GettextNoop("Definition"))
+	}
+
+	fmt.Fprintf(w,
+		",\nCASE WHEN '"+string(STATS_EXT_NDISTINCT)+"' = any(es.stxkind) THEN 'defined' \n"+
+			"END AS \"%s\", \n"+
+			"CASE WHEN '"+string(STATS_EXT_DEPENDENCIES)+"' = any(es.stxkind) THEN 'defined' \n"+
+			"END AS \"%s\"",
+		GettextNoop("Ndistinct"),
+		GettextNoop("Dependencies"))
+
+	/*
+	 * Include the MCV statistics kind if server supports it.
+	 */
+	if d.version >= 120000 {
+		fmt.Fprintf(w,
+			",\nCASE WHEN '"+string(STATS_EXT_MCV)+"' = any(es.stxkind) THEN 'defined' \n"+
+				"END AS \"%s\" ",
+			GettextNoop("MCV"))
+	}
+
+	fmt.Fprint(w,
+		" \nFROM pg_catalog.pg_statistic_ext es \n")
+
+	if err := d.validateSQLNamePattern(w, pattern, false, false,
+		"es.stxnamespace::pg_catalog.regnamespace::pg_catalog.text", "es.stxname", NULL,
+		"pg_catalog.pg_statistics_obj_is_visible(es.oid)", nil, 3); err != nil {
+		return err
+	}
+
+	fmt.Fprint(w, "ORDER BY 1, 2;")
+
+	return nil
+}
+
+// PartitionedTables handles \dP.
+//
+// Manually translated from listPartitionedTables in psql's describe.c.
+//
+// \dP
+// Takes an optional regexp to select particular relations
+//
+// As with \d, you can specify the kinds of relations you want:
+//
+// t for tables
+// i for indexes
+//
+// And there's additional flags:
+//
+// n to list non-leaf partitioned tables
+//
+// and you can mix and match these in any order.
+func (d *PgDesc) PartitionedTables(w io.Writer, reltypes, pattern string, verbose bool) error {
+	showTables := strings.ContainsRune(reltypes, 't')
+	showIndexes := strings.ContainsRune(reltypes, 'i')
+	showNested := strings.ContainsRune(reltypes, 'n')
+
+	/*
+	 * Note: Declarative table partitioning is only supported as of Pg 10.0,
+	 * but partitioned indexes and pg_partition_tree() only as of Pg 12.0.
+	 */
+	if d.version < 120000 {
+		return fmt.Errorf("The server (version %s) does not support declarative table partitioning.\n",
+			d.sversion)
+	}
+
+	/* If no relation kind was selected, show them all */
+	if !showTables && !showIndexes {
+		showTables, showIndexes = true, true
+	}
+
+	/* show all kinds */
+	mixedOutput := showTables && showIndexes
+
+	fmt.Fprintf(w,
+		"SELECT n.nspname as \"%s\",\n"+
+			"  c.relname as \"%s\",\n"+
+			"  pg_catalog.pg_get_userbyid(c.relowner) as \"%s\"",
+		GettextNoop("Schema"),
+		GettextNoop("Name"),
+		GettextNoop("Owner"))
+
+	if mixedOutput {
+		fmt.Fprintf(w,
+			",\n  CASE c.relkind"+
+				" WHEN '"+string(RELKIND_PARTITIONED_TABLE)+"' THEN '%s'"+
+				" WHEN '"+string(RELKIND_PARTITIONED_INDEX)+"' THEN '%s'"+
+				" END as \"%s\"",
+			GettextNoop("partitioned table"),
+			GettextNoop("partitioned index"),
+			GettextNoop("Type"))
+	}
+
+	if showNested || pattern != NULL {
+		fmt.Fprintf(w,
+			",\n  inh.inhparent::pg_catalog.regclass as \"%s\"",
+			GettextNoop("Parent name"))
+	}
+
+	if showIndexes {
+		fmt.Fprintf(w,
+			",\n c2.oid::pg_catalog.regclass as \"%s\"",
+			GettextNoop("Table"))
+	}
+
+	if verbose {
+		if showNested {
+			fmt.Fprintf(w,
+				",\n  s.dps as \"%s\"",
+				GettextNoop("Leaf partition size"))
+			fmt.Fprintf(w,
+				",\n  s.tps as \"%s\"",
+				GettextNoop("Total size"))
+		} else {
+			/* Sizes of all partitions are considered in this case. */
+			fmt.Fprintf(w,
+				",\n  s.tps as \"%s\"",
+				GettextNoop("Total size"))
+		}
+
+		fmt.Fprintf(w,
+			",\n  pg_catalog.obj_description(c.oid, 'pg_class') as \"%s\"",
+			GettextNoop("Description"))
+	}
+
+	fmt.Fprint(w,
+		"\nFROM pg_catalog.pg_class c"+
+			"\n     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace")
+
+	if showIndexes {
+		fmt.Fprint(w,
+			"\n     LEFT JOIN pg_catalog.pg_index i ON i.indexrelid = c.oid"+
+				"\n     LEFT JOIN pg_catalog.pg_class c2 ON i.indrelid = c2.oid")
+	}
+
+	if showNested || pattern != NULL {
+		fmt.Fprint(w,
+			"\n     LEFT JOIN pg_catalog.pg_inherits inh ON c.oid = inh.inhrelid")
+	}
+
+	if verbose {
+		fmt.Fprint(w,
+			",\n     LATERAL (SELECT pg_catalog.pg_size_pretty(sum("+
+				"\n                 CASE WHEN ppt.isleaf AND ppt.level = 1"+
+				"\n                      THEN pg_catalog.pg_table_size(ppt.relid) ELSE 0 END)) AS dps"+
+				",\n                     pg_catalog.pg_size_pretty(sum("+
+				"pg_catalog.pg_table_size(ppt.relid))) AS tps"+
+				"\n              FROM pg_catalog.pg_partition_tree(c.oid) ppt) s")
+	}
+
+	fmt.Fprint(w, "\nWHERE c.relkind IN (")
+	if showTables {
+		fmt.Fprint(w, "'"+string(RELKIND_PARTITIONED_TABLE)+"',")
+	}
+	if showIndexes {
+		fmt.Fprint(w, "'"+string(RELKIND_PARTITIONED_INDEX)+"',")
+	}
+	fmt.Fprint(w, "''") /* dummy */
+	fmt.Fprint(w, ")\n")
+
+	if !showNested && pattern == NULL {
+		fmt.Fprint(w, " AND NOT c.relispartition\n")
+	}
+
+	if pattern == NULL {
+		fmt.Fprint(w, "      AND n.nspname <> 'pg_catalog'\n"+
+			"      AND n.nspname !~ '^pg_toast'\n"+
+			"      AND n.nspname <> 'information_schema'\n")
+	}
+
+	if err := d.validateSQLNamePattern(w, pattern, true, false,
+		"n.nspname", "c.relname", NULL,
+		"pg_catalog.pg_table_is_visible(c.oid)", nil, 3); err != nil {
+		return err
+	}
+
+	var orderType, orderParent string
+	if mixedOutput {
+		orderType = "\"Type\" DESC, "
+	}
+	if showNested || pattern != NULL {
+		orderParent = "\"Parent name\" NULLS FIRST, "
+	}
+	fmt.Fprintf(w, "ORDER BY \"Schema\", %s%s\"Name\";",
+		orderType, orderParent)
+
+	return nil
+}
+
+// RoleGrants handles \drg.
+//
+// Manually translated from describeRoleGrants in psql's describe.c.
+//
+// \drg
+// Describes role grants.
+func (d *PgDesc) RoleGrants(w io.Writer, pattern string, showSystem bool) error {
+	fmt.Fprintf(w,
+		"SELECT m.rolname AS \"%s\", r.rolname AS \"%s\",\n"+
+			"  pg_catalog.concat_ws(', ',\n",
+		GettextNoop("Role name"),
+		GettextNoop("Member of"))
+
+	if d.version >= 160000 {
+		fmt.Fprint(w,
+			"    CASE WHEN pam.admin_option THEN 'ADMIN' END,\n"+
+				"    CASE WHEN pam.inherit_option THEN 'INHERIT' END,\n"+
+				"    CASE WHEN pam.set_option THEN 'SET' END\n")
+	} else {
+		fmt.Fprint(w,
+			"    CASE WHEN pam.admin_option THEN 'ADMIN' END,\n"+
+				"    CASE WHEN m.rolinherit THEN 'INHERIT' END,\n"+
+				"    'SET'\n")
+	}
+
+	fmt.Fprintf(w,
+		"  ) AS \"%s\",\n"+
+			"  g.rolname AS \"%s\"\n",
+		GettextNoop("Options"),
+		GettextNoop("Grantor"))
+
+	fmt.Fprint(w,
+		"FROM pg_catalog.pg_roles m\n"+
+			"     JOIN pg_catalog.pg_auth_members pam ON (pam.member = m.oid)\n"+
+			"     LEFT JOIN pg_catalog.pg_roles r ON (pam.roleid = r.oid)\n"+
+			"     LEFT JOIN pg_catalog.pg_roles g ON (pam.grantor = g.oid)\n")
+
+	if !showSystem && pattern == NULL {
+		fmt.Fprint(w, "WHERE m.rolname !~ '^pg_'\n")
+	}
+
+	if err := d.validateSQLNamePattern(w, pattern, false, false,
+		NULL, "m.rolname", NULL,
+		NULL, nil, 1); err != nil {
+		return err
+	}
+
+	fmt.Fprint(w, "ORDER BY 1, 2, 4;\n")
+
+	return nil
+}
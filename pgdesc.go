@@ -14,34 +14,358 @@ import (
 	"io"
 )
 
-// Postgres RELKIND and other related constants.
+// Postgres catalog constants (RELKIND, PROKIND, CONSTRAINT, etc).
 const (
-	COERCION_CODE_ASSIGNMENT  = 'a' // coercion in context of assignment
-	COERCION_CODE_EXPLICIT    = 'e' // explicit cast operation
-	COERCION_CODE_IMPLICIT    = 'i' // coercion in context of expression
-	COERCION_METHOD_BINARY    = 'b' // types are binary-compatible
-	COERCION_METHOD_FUNCTION  = 'f' // use a function
-	COERCION_METHOD_INOUT     = 'i' // use input/output functions
-	DEFACLOBJ_FUNCTION        = 'f' // function
-	DEFACLOBJ_NAMESPACE       = 'n' // namespace
-	DEFACLOBJ_RELATION        = 'r' // table, view
-	DEFACLOBJ_SEQUENCE        = 'S' // sequence
-	DEFACLOBJ_TYPE            = 'T' // type
-	RELKIND_COMPOSITE_TYPE    = 'c' // composite type
-	RELKIND_FOREIGN_TABLE     = 'f' // foreign table
-	RELKIND_INDEX             = 'i' // secondary index
-	RELKIND_MATVIEW           = 'm' // materialized view
-	RELKIND_PARTITIONED_INDEX = 'I' // partitioned index
-	RELKIND_PARTITIONED_TABLE = 'p' // partitioned table
-	RELKIND_RELATION          = 'r' // ordinary table
-	RELKIND_SEQUENCE          = 'S' // sequence object
-	RELKIND_TOASTVALUE        = 't' // for out-of-line values
-	RELKIND_VIEW              = 'v' // view
-	RELPERSISTENCE_PERMANENT  = 'p' // regular table
-	RELPERSISTENCE_TEMP       = 't' // temporary table
-	RELPERSISTENCE_UNLOGGED   = 'u' // unlogged permanent table
+	AMTYPE_INDEX                  = 'i' // index access method
+	AMTYPE_TABLE                  = 't' // table access method
+	ATTRIBUTE_GENERATED_STORED    = 's'
+	ATTRIBUTE_IDENTITY_ALWAYS     = 'a'
+	ATTRIBUTE_IDENTITY_BY_DEFAULT = 'd'
+	COLLPROVIDER_BUILTIN          = 'b'
+	COLLPROVIDER_DEFAULT          = 'd'
+	COLLPROVIDER_ICU              = 'i'
+	COLLPROVIDER_LIBC             = 'c'
+	CONSTRAINT_CHECK              = 'c'
+	CONSTRAINT_EXCLUSION          = 'x'
+	CONSTRAINT_FOREIGN            = 'f'
+	CONSTRAINT_NOTNULL            = 'n'
+	CONSTRAINT_PRIMARY            = 'p'
+	CONSTRAINT_TRIGGER            = 't'
+	CONSTRAINT_UNIQUE             = 'u'
+	PROARGMODE_IN                 = 'i'
+	PROARGMODE_INOUT              = 'b'
+	PROARGMODE_OUT                = 'o'
+	PROARGMODE_TABLE              = 't'
+	PROARGMODE_VARIADIC           = 'v'
+	PROKIND_AGGREGATE             = 'a'
+	PROKIND_FUNCTION              = 'f'
+	PROKIND_PROCEDURE             = 'p'
+	PROKIND_WINDOW                = 'w'
+	PROPARALLEL_RESTRICTED        = 'r' // can run in parallel leader only
+	PROPARALLEL_SAFE              = 's' // can run in worker or leader
+	PROPARALLEL_UNSAFE            = 'u' // banned while in parallel mode
+	PROVOLATILE_IMMUTABLE         = 'i' // never changes for given input
+	PROVOLATILE_STABLE            = 's' // does not change within a scan
+	PROVOLATILE_VOLATILE          = 'v' // can change even within a scan
+	STATS_EXT_DEPENDENCIES        = 'f'
+	STATS_EXT_EXPRESSIONS         = 'e'
+	STATS_EXT_MCV                 = 'm'
+	STATS_EXT_NDISTINCT           = 'd'
+	TRIGGER_TYPE_AFTER            = 0
+	TRIGGER_TYPE_BEFORE           = (1 << 1)
+	TRIGGER_TYPE_DELETE           = (1 << 3)
+	TRIGGER_TYPE_EVENT_MASK       = (TRIGGER_TYPE_INSERT | TRIGGER_TYPE_DELETE | TRIGGER_TYPE_UPDATE | TRIGGER_TYPE_TRUNCATE)
+	TRIGGER_TYPE_INSERT           = (1 << 2)
+	TRIGGER_TYPE_INSTEAD          = (1 << 6)
+	TRIGGER_TYPE_LEVEL_MASK       = (TRIGGER_TYPE_ROW)
+	TRIGGER_TYPE_ROW              = (1 << 0)
+	TRIGGER_TYPE_STATEMENT        = 0
+	TRIGGER_TYPE_TIMING_MASK      = (TRIGGER_TYPE_BEFORE | TRIGGER_TYPE_INSTEAD)
+	TRIGGER_TYPE_TRUNCATE         = (1 << 5)
+	TRIGGER_TYPE_UPDATE           = (1 << 4)
+	TYPTYPE_BASE                  = 'b' // base type (ordinary scalar type)
+	TYPTYPE_COMPOSITE             = 'c' // composite (e.g., table's rowtype)
+	TYPTYPE_DOMAIN                = 'd' // domain over another type
+	TYPTYPE_ENUM                  = 'e' // enumerated type
+	TYPTYPE_MULTIRANGE            = 'm' // multirange type
+	TYPTYPE_PSEUDO                = 'p' // pseudo-type
+	TYPTYPE_RANGE                 = 'r' // range type
 )
 
+// CoercionContext is a cast's coercion context (pg_cast.castcontext).
+type CoercionContext rune
+
+// CoercionContext values.
+const (
+	COERCION_CODE_ASSIGNMENT CoercionContext = 'a' // coercion in context of assignment
+	COERCION_CODE_EXPLICIT   CoercionContext = 'e' // explicit cast operation
+	COERCION_CODE_IMPLICIT   CoercionContext = 'i' // coercion in context of expression
+)
+
+// String satisfies the fmt.Stringer interface.
+func (v CoercionContext) String() string {
+	switch v {
+	case COERCION_CODE_ASSIGNMENT:
+		return "assignment"
+	case COERCION_CODE_EXPLICIT:
+		return "explicit"
+	case COERCION_CODE_IMPLICIT:
+		return "implicit"
+	}
+	return fmt.Sprintf("CoercionContext(%q)", rune(v))
+}
+
+// Valid returns whether or not v is a valid CoercionContext.
+func (v CoercionContext) Valid() bool {
+	switch v {
+	case COERCION_CODE_ASSIGNMENT,
+		COERCION_CODE_EXPLICIT,
+		COERCION_CODE_IMPLICIT:
+		return true
+	}
+	return false
+}
+
+// ParseCoercionContext parses s as a CoercionContext, from either the
+// pg_cast.castcontext value or the name returned by String.
+func ParseCoercionContext(s string) (CoercionContext, error) {
+	switch s {
+	case "a", "assignment":
+		return COERCION_CODE_ASSIGNMENT, nil
+	case "e", "explicit":
+		return COERCION_CODE_EXPLICIT, nil
+	case "i", "implicit":
+		return COERCION_CODE_IMPLICIT, nil
+	}
+	return 0, fmt.Errorf("invalid castcontext %q", s)
+}
+
+// CoercionMethod is a cast's coercion method (pg_cast.castmethod).
+type CoercionMethod rune
+
+// CoercionMethod values.
+const (
+	COERCION_METHOD_BINARY   CoercionMethod = 'b' // types are binary-compatible
+	COERCION_METHOD_FUNCTION CoercionMethod = 'f' // use a function
+	COERCION_METHOD_INOUT    CoercionMethod = 'i' // use input/output functions
+)
+
+// String satisfies the fmt.Stringer interface.
+func (v CoercionMethod) String() string {
+	switch v {
+	case COERCION_METHOD_BINARY:
+		return "binary"
+	case COERCION_METHOD_FUNCTION:
+		return "function"
+	case COERCION_METHOD_INOUT:
+		return "inout"
+	}
+	return fmt.Sprintf("CoercionMethod(%q)", rune(v))
+}
+
+// Valid returns whether or not v is a valid CoercionMethod.
+func (v CoercionMethod) Valid() bool {
+	switch v {
+	case COERCION_METHOD_BINARY,
+		COERCION_METHOD_FUNCTION,
+		COERCION_METHOD_INOUT:
+		return true
+	}
+	return false
+}
+
+// ParseCoercionMethod parses s as a CoercionMethod, from either the
+// pg_cast.castmethod value or the name returned by String.
+func ParseCoercionMethod(s string) (CoercionMethod, error) {
+	switch s {
+	case "b", "binary":
+		return COERCION_METHOD_BINARY, nil
+	case "f", "function":
+		return COERCION_METHOD_FUNCTION, nil
+	case "i", "inout":
+		return COERCION_METHOD_INOUT, nil
+	}
+	return 0, fmt.Errorf("invalid castmethod %q", s)
+}
+
+// DefACLObjType is a default ACL's object type (pg_default_acl.defaclobjtype).
+type DefACLObjType rune
+
+// DefACLObjType values.
+const (
+	DEFACLOBJ_FUNCTION  DefACLObjType = 'f' // function
+	DEFACLOBJ_NAMESPACE DefACLObjType = 'n' // namespace
+	DEFACLOBJ_RELATION  DefACLObjType = 'r' // table, view
+	DEFACLOBJ_SEQUENCE  DefACLObjType = 'S' // sequence
+	DEFACLOBJ_TYPE      DefACLObjType = 'T' // type
+)
+
+// String satisfies the fmt.Stringer interface.
+func (v DefACLObjType) String() string {
+	switch v {
+	case DEFACLOBJ_FUNCTION:
+		return "function"
+	case DEFACLOBJ_NAMESPACE:
+		return "schema"
+	case DEFACLOBJ_RELATION:
+		return "table"
+	case DEFACLOBJ_SEQUENCE:
+		return "sequence"
+	case DEFACLOBJ_TYPE:
+		return "type"
+	}
+	return fmt.Sprintf("DefACLObjType(%q)", rune(v))
+}
+
+// Valid returns whether or not v is a valid DefACLObjType.
+func (v DefACLObjType) Valid() bool {
+	switch v {
+	case DEFACLOBJ_FUNCTION,
+		DEFACLOBJ_NAMESPACE,
+		DEFACLOBJ_RELATION,
+		DEFACLOBJ_SEQUENCE,
+		DEFACLOBJ_TYPE:
+		return true
+	}
+	return false
+}
+
+// ParseDefACLObjType parses s as a DefACLObjType, from either the
+// pg_default_acl.defaclobjtype value or the name returned by String.
+func ParseDefACLObjType(s string) (DefACLObjType, error) {
+	switch s {
+	case "f", "function":
+		return DEFACLOBJ_FUNCTION, nil
+	case "n", "schema":
+		return DEFACLOBJ_NAMESPACE, nil
+	case "r", "table":
+		return DEFACLOBJ_RELATION, nil
+	case "S", "sequence":
+		return DEFACLOBJ_SEQUENCE, nil
+	case "T", "type":
+		return DEFACLOBJ_TYPE, nil
+	}
+	return 0, fmt.Errorf("invalid defaclobjtype %q", s)
+}
+
+// RelKind is a relation's kind (pg_class.relkind).
+type RelKind rune
+
+// RelKind values.
+const (
+	RELKIND_COMPOSITE_TYPE    RelKind = 'c' // composite type
+	RELKIND_FOREIGN_TABLE     RelKind = 'f' // foreign table
+	RELKIND_INDEX             RelKind = 'i' // secondary index
+	RELKIND_MATVIEW           RelKind = 'm' // materialized view
+	RELKIND_PARTITIONED_INDEX RelKind = 'I' // partitioned index
+	RELKIND_PARTITIONED_TABLE RelKind = 'p' // partitioned table
+	RELKIND_RELATION          RelKind = 'r' // ordinary table
+	RELKIND_SEQUENCE          RelKind = 'S' // sequence object
+	RELKIND_TOASTVALUE        RelKind = 't' // for out-of-line values
+	RELKIND_VIEW              RelKind = 'v' // view
+)
+
+// String satisfies the fmt.Stringer interface.
+func (v RelKind) String() string {
+	switch v {
+	case RELKIND_COMPOSITE_TYPE:
+		return "composite type"
+	case RELKIND_FOREIGN_TABLE:
+		return "foreign table"
+	case RELKIND_INDEX:
+		return "index"
+	case RELKIND_MATVIEW:
+		return "materialized view"
+	case RELKIND_PARTITIONED_INDEX:
+		return "partitioned index"
+	case RELKIND_PARTITIONED_TABLE:
+		return "partitioned table"
+	case RELKIND_RELATION:
+		return "table"
+	case RELKIND_SEQUENCE:
+		return "sequence"
+	case RELKIND_TOASTVALUE:
+		return "TOAST table"
+	case RELKIND_VIEW:
+		return "view"
+	}
+	return fmt.Sprintf("RelKind(%q)", rune(v))
+}
+
+// Valid returns whether or not v is a valid RelKind.
+func (v RelKind) Valid() bool {
+	switch v {
+	case RELKIND_COMPOSITE_TYPE,
+		RELKIND_FOREIGN_TABLE,
+		RELKIND_INDEX,
+		RELKIND_MATVIEW,
+		RELKIND_PARTITIONED_INDEX,
+		RELKIND_PARTITIONED_TABLE,
+		RELKIND_RELATION,
+		RELKIND_SEQUENCE,
+		RELKIND_TOASTVALUE,
+		RELKIND_VIEW:
+		return true
+	}
+	return false
+}
+
+// ParseRelKind parses s as a RelKind, from either the
+// pg_class.relkind value or the name returned by String.
+func ParseRelKind(s string) (RelKind, error) {
+	switch s {
+	case "c", "composite type":
+		return RELKIND_COMPOSITE_TYPE, nil
+	case "f", "foreign table":
+		return RELKIND_FOREIGN_TABLE, nil
+	case "i", "index":
+		return RELKIND_INDEX, nil
+	case "m", "materialized view":
+		return RELKIND_MATVIEW, nil
+	case "I", "partitioned index":
+		return RELKIND_PARTITIONED_INDEX, nil
+	case "p", "partitioned table":
+		return RELKIND_PARTITIONED_TABLE, nil
+	case "r", "table":
+		return RELKIND_RELATION, nil
+	case "S", "sequence":
+		return RELKIND_SEQUENCE, nil
+	case "t", "TOAST table":
+		return RELKIND_TOASTVALUE, nil
+	case "v", "view":
+		return RELKIND_VIEW, nil
+	}
+	return 0, fmt.Errorf("invalid relkind %q", s)
+}
+
+// RelPersistence is a relation's persistence (pg_class.relpersistence).
+type RelPersistence rune
+
+// RelPersistence values.
+const (
+	RELPERSISTENCE_PERMANENT RelPersistence = 'p' // regular table
+	RELPERSISTENCE_TEMP      RelPersistence = 't' // temporary table
+	RELPERSISTENCE_UNLOGGED  RelPersistence = 'u' // unlogged permanent table
+)
+
+// String satisfies the fmt.Stringer interface.
+func (v RelPersistence) String() string {
+	switch v {
+	case RELPERSISTENCE_PERMANENT:
+		return "permanent"
+	case RELPERSISTENCE_TEMP:
+		return "temporary"
+	case RELPERSISTENCE_UNLOGGED:
+		return "unlogged"
+	}
+	return fmt.Sprintf("RelPersistence(%q)", rune(v))
+}
+
+// Valid returns whether or not v is a valid RelPersistence.
+func (v RelPersistence) Valid() bool {
+	switch v {
+	case RELPERSISTENCE_PERMANENT,
+		RELPERSISTENCE_TEMP,
+		RELPERSISTENCE_UNLOGGED:
+		return true
+	}
+	return false
+}
+
+// ParseRelPersistence parses s as a RelPersistence, from either the
+// pg_class.relpersistence value or the name returned by String.
+func ParseRelPersistence(s string) (RelPersistence, error) {
+	switch s {
+	case "p", "permanent":
+		return RELPERSISTENCE_PERMANENT, nil
+	case "t", "temporary":
+		return RELPERSISTENCE_TEMP, nil
+	case "u", "unlogged":
+		return RELPERSISTENCE_UNLOGGED, nil
+	}
+	return 0, fmt.Errorf("invalid relpersistence %q", s)
+}
+
 // AccessMethods handles \dA.
 //
 // Generated from describeAccessMethods in psql's describe.c.
@@ -68,10 +392,12 @@ func (d *PgDesc) AccessMethods(w io.Writer, pattern string, verbose bool) error
 	fmt.Fprintf(w,
 		"SELECT amname AS \"%s\",\n"+
 			"  CASE amtype"+
-			" WHEN 'i' THEN '%s'"+
+			" WHEN '"+string(AMTYPE_INDEX)+"' THEN '%s'"+
+			" WHEN '"+string(AMTYPE_TABLE)+"' THEN '%s'"+
 			" END AS \"%s\"",
 		GettextNoop("Name"),
 		GettextNoop("Index"),
+		GettextNoop("Table"),
 		GettextNoop("Type"))
 
 	if verbose {
@@ -85,9 +411,11 @@ func (d *PgDesc) AccessMethods(w io.Writer, pattern string, verbose bool) error
 	fmt.Fprint(w,
 		"\nFROM pg_catalog.pg_am\n")
 
-	processSQLNamePattern(w, pattern, false, false,
+	if err := d.validateSQLNamePattern(w, pattern, false, false,
 		NULL, "amname", NULL,
-		NULL)
+		NULL, nil, 1); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1;")
 
@@ -162,7 +490,7 @@ func (d *PgDesc) Aggregates(w io.Writer, pattern string, verbose bool, showSyste
 			"  pg_catalog.obj_description(p.oid, 'pg_proc') as \"%s\"\n"+
 				"FROM pg_catalog.pg_proc p\n"+
 				"     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace\n"+
-				"WHERE p.prokind = 'a'\n",
+				"WHERE p.prokind = '"+string(PROKIND_AGGREGATE)+"'\n",
 			GettextNoop("Description"))
 	} else {
 		fmt.Fprintf(w,
@@ -173,14 +501,16 @@ func (d *PgDesc) Aggregates(w io.Writer, pattern string, verbose bool, showSyste
 			GettextNoop("Description"))
 	}
 
-	if !showSystem && pattern != NULL {
+	if !showSystem && pattern == NULL {
 		fmt.Fprint(w, "      AND n.nspname <> 'pg_catalog'\n"+
 			"      AND n.nspname <> 'information_schema'\n")
 	}
 
-	processSQLNamePattern(w, pattern, true, false,
+	if err := d.validateSQLNamePattern(w, pattern, true, false,
 		"n.nspname", "p.proname", NULL,
-		"pg_catalog.pg_function_is_visible(p.oid)")
+		"pg_catalog.pg_function_is_visible(p.oid)", nil, 3); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1, 2, 4;")
 
@@ -291,17 +621,21 @@ func (d *PgDesc) Casts(w io.Writer, pattern string, verbose bool) error {
 	 * Match name pattern against either internal or external name of either
 	 * castsource or casttarget
 	 */
-	processSQLNamePattern(w, pattern, true, false,
+	if err := d.validateSQLNamePattern(w, pattern, true, false,
 		"ns.nspname", "ts.typname",
 		"pg_catalog.format_type(ts.oid, NULL)",
-		"pg_catalog.pg_type_is_visible(ts.oid)")
+		"pg_catalog.pg_type_is_visible(ts.oid)", nil, 3); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, ") OR (true")
 
-	processSQLNamePattern(w, pattern, true, false,
+	if err := d.validateSQLNamePattern(w, pattern, true, false,
 		"nt.nspname", "tt.typname",
 		"pg_catalog.format_type(tt.oid, NULL)",
-		"pg_catalog.pg_type_is_visible(tt.oid)")
+		"pg_catalog.pg_type_is_visible(tt.oid)", nil, 3); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, ") )\nORDER BY 1, 2;")
 
@@ -359,10 +693,33 @@ func (d *PgDesc) Collations(w io.Writer, pattern string, verbose bool, showSyste
 
 	if d.version >= 100000 {
 		fmt.Fprintf(w,
-			",\n       CASE c.collprovider WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS \"%s\"",
+			",\n       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS \"%s\"",
 			GettextNoop("Provider"))
 	}
 
+	if d.version >= 170000 {
+		fmt.Fprintf(w,
+			",\n       c.colllocale AS \"%s\"",
+			GettextNoop("Locale"))
+	} else if d.version >= 150000 {
+		fmt.Fprintf(w,
+			",\n       c.colliculocale AS \"%s\"",
+			GettextNoop("Locale"))
+	}
+
+	if d.version >= 160000 {
+		fmt.Fprintf(w,
+			",\n       c.collicurules AS \"%s\"",
+			GettextNoop("ICU Rules"))
+	}
+
+	if d.version >= 120000 {
+		fmt.Fprintf(w,
+			",\n       CASE WHEN c.collisdeterministic THEN '%s' ELSE '%s' END AS \"%s\"",
+			GettextNoop("yes"), GettextNoop("no"),
+			GettextNoop("Deterministic?"))
+	}
+
 	if verbose {
 		fmt.Fprintf(w,
 			",\n       pg_catalog.obj_description(c.oid, 'pg_collation') AS \"%s\"",
@@ -373,7 +730,7 @@ func (d *PgDesc) Collations(w io.Writer, pattern string, verbose bool, showSyste
 		"\nFROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n\n"+
 			"WHERE n.oid = c.collnamespace\n")
 
-	if !showSystem && pattern != NULL {
+	if !showSystem && pattern == NULL {
 		fmt.Fprint(w, "      AND n.nspname <> 'pg_catalog'\n"+
 			"      AND n.nspname <> 'information_schema'\n")
 	}
@@ -386,9 +743,11 @@ func (d *PgDesc) Collations(w io.Writer, pattern string, verbose bool, showSyste
 	 */
 	fmt.Fprint(w, "      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))\n")
 
-	processSQLNamePattern(w, pattern, true, false,
+	if err := d.validateSQLNamePattern(w, pattern, true, false,
 		"n.nspname", "c.collname", NULL,
-		"pg_catalog.pg_collation_is_visible(c.oid)")
+		"pg_catalog.pg_collation_is_visible(c.oid)", nil, 3); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1, 2;")
 
@@ -462,14 +821,16 @@ func (d *PgDesc) Conversions(w io.Writer, pattern string, verbose bool, showSyst
 
 	fmt.Fprint(w, "WHERE true\n")
 
-	if !showSystem && pattern != NULL {
+	if !showSystem && pattern == NULL {
 		fmt.Fprint(w, "  AND n.nspname <> 'pg_catalog'\n"+
 			"  AND n.nspname <> 'information_schema'\n")
 	}
 
-	processSQLNamePattern(w, pattern, true, false,
+	if err := d.validateSQLNamePattern(w, pattern, true, false,
 		"n.nspname", "c.conname", NULL,
-		"pg_catalog.pg_conversion_is_visible(c.oid)")
+		"pg_catalog.pg_conversion_is_visible(c.oid)", nil, 3); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1, 2;")
 
@@ -497,7 +858,6 @@ func (d *PgDesc) Conversions(w io.Writer, pattern string, verbose bool, showSyst
 //
 // \drds
 func (d *PgDesc) DatabaseRoleSettings(w io.Writer, pattern string, pattern2 string) error {
-
 	// PQExpBufferData buf;
 	// PGresult   *res;
 	// printQueryOpt myopt = pset.popt;
@@ -521,10 +881,14 @@ func (d *PgDesc) DatabaseRoleSettings(w io.Writer, pattern string, pattern2 stri
 		GettextNoop("Role"),
 		GettextNoop("Database"),
 		GettextNoop("Settings"))
-	havewhere = processSQLNamePattern(w, pattern, false, false,
-		NULL, "r.rolname", NULL, NULL)
-	processSQLNamePattern(w, pattern2, havewhere, false,
-		NULL, "d.datname", NULL, NULL)
+	if err := d.validateSQLNamePattern(w, pattern, false, false,
+		NULL, "r.rolname", NULL, NULL, &havewhere, 1); err != nil {
+		return err
+	}
+	if err := d.validateSQLNamePattern(w, pattern2, havewhere, false,
+		NULL, "d.datname", NULL, NULL, nil, 1); err != nil {
+		return err
+	}
 	fmt.Fprint(w, "ORDER BY 1, 2;")
 
 	// res = PSQLexec(buf.data);
@@ -591,6 +955,25 @@ func (d *PgDesc) Databases(w io.Writer, pattern string, verbose bool) error {
 			GettextNoop("Collate"),
 			GettextNoop("Ctype"))
 	}
+	if d.version >= 150000 {
+		fmt.Fprintf(w,
+			"       CASE d.datlocprovider WHEN 'b' THEN 'builtin' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS \"%s\",\n",
+			GettextNoop("Locale Provider"))
+	}
+	if d.version >= 170000 {
+		fmt.Fprintf(w,
+			"       d.datlocale as \"%s\",\n",
+			GettextNoop("Locale"))
+	} else if d.version >= 150000 {
+		fmt.Fprintf(w,
+			"       d.daticulocale as \"%s\",\n",
+			GettextNoop("Locale"))
+	}
+	if d.version >= 160000 {
+		fmt.Fprintf(w,
+			"       d.daticurules as \"%s\",\n",
+			GettextNoop("ICU Rules"))
+	}
 	fmt.Fprint(w, "       ")
 	d.printACLColumn(w, "d.datacl")
 	if verbose && d.version >= 80200 {
@@ -619,8 +1002,10 @@ func (d *PgDesc) Databases(w io.Writer, pattern string, verbose bool) error {
 	}
 
 	if pattern != NULL {
-		processSQLNamePattern(w, pattern, false, false,
-			NULL, "d.datname", NULL, NULL)
+		if err := d.validateSQLNamePattern(w, pattern, false, false,
+			NULL, "d.datname", NULL, NULL, nil, 1); err != nil {
+			return err
+		}
 	}
 
 	fmt.Fprint(w, "ORDER BY 1;")
@@ -689,11 +1074,13 @@ func (d *PgDesc) DefaultACLS(w io.Writer, pattern string) error {
 	fmt.Fprint(w, "\nFROM pg_catalog.pg_default_acl d\n"+
 		"     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = d.defaclnamespace\n")
 
-	processSQLNamePattern(w, pattern, false, false,
+	if err := d.validateSQLNamePattern(w, pattern, false, false,
 		NULL,
 		"n.nspname",
 		"pg_catalog.pg_get_userbyid(d.defaclrole)",
-		NULL)
+		NULL, nil, 3); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1, 2, 3;")
 
@@ -778,16 +1165,18 @@ func (d *PgDesc) Domains(w io.Writer, pattern string, verbose bool, showSystem b
 				"AND d.objsubid = 0\n")
 	}
 
-	fmt.Fprint(w, "WHERE t.typtype = 'd'\n")
+	fmt.Fprint(w, "WHERE t.typtype = '"+string(TYPTYPE_DOMAIN)+"'\n")
 
-	if !showSystem && pattern != NULL {
+	if !showSystem && pattern == NULL {
 		fmt.Fprint(w, "      AND n.nspname <> 'pg_catalog'\n"+
 			"      AND n.nspname <> 'information_schema'\n")
 	}
 
-	processSQLNamePattern(w, pattern, true, false,
+	if err := d.validateSQLNamePattern(w, pattern, true, false,
 		"n.nspname", "t.typname", NULL,
-		"pg_catalog.pg_type_is_visible(t.oid)")
+		"pg_catalog.pg_type_is_visible(t.oid)", nil, 3); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1, 2;")
 
@@ -853,8 +1242,10 @@ func (d *PgDesc) EventTriggers(w io.Writer, pattern string, verbose bool) error
 	fmt.Fprint(w,
 		"\nFROM pg_catalog.pg_event_trigger e ")
 
-	processSQLNamePattern(w, pattern, false, false,
-		NULL, "evtname", NULL, NULL)
+	if err := d.validateSQLNamePattern(w, pattern, false, false,
+		NULL, "evtname", NULL, NULL, nil, 1); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1")
 
@@ -876,77 +1267,6 @@ func (d *PgDesc) EventTriggers(w io.Writer, pattern string, verbose bool) error
 	return nil
 }
 
-// ExtensionContents handles \dx+.
-//
-// Generated from listExtensionContents in psql's describe.c.
-//
-// \dx+
-//
-// List contents of installed extensions.
-func (d *PgDesc) ExtensionContents(w io.Writer, pattern string) error {
-
-	// PQExpBufferData buf;
-	// PGresult   *res;
-	// int			i;
-
-	if d.version < 90100 {
-		// char sverbuf[32];
-
-		return fmt.Errorf("The server (version %s) does not support extensions.\n",
-			d.sversion)
-		// return true;
-	}
-
-	// initPQExpBuffer(w);
-	fmt.Fprintf(w,
-		"SELECT e.extname, e.oid\n"+
-			"FROM pg_catalog.pg_extension e\n")
-
-	processSQLNamePattern(w, pattern,
-		false, false,
-		NULL, "e.extname", NULL,
-		NULL)
-
-	fmt.Fprint(w, "ORDER BY 1;")
-
-	// res = PSQLexec(buf.data);
-	// termPQExpBuffer(w);
-	// if (!res)
-	// return false;
-
-	// if (PQntuples(res) == 0) {
-	// 	if (!pset.quiet) {
-	// 		if (pattern != NULL)
-	// 			return fmt.Errorf("Did not find any extension named \"%s\".\n",
-	// 					   pattern);
-	// 		else
-	// 			return fmt.Errorf("Did not find any extensions.\n");
-	// 	}
-	// 	PQclear(res);
-	// return false;
-	// }
-	// for i := 0; i < PQntuples(res); i++ {
-	// 	const char *extname;
-	// 	const char *oid;
-
-	// 	extname = PQgetvalue(res, i, 0);
-	// 	oid = PQgetvalue(res, i, 1);
-
-	// 	if (!d.OneExtensionContents(w, extname, oid)) {
-	// 		PQclear(res);
-	// return false;
-	// 	}
-	// 	if (cancel_pressed) {
-	// 		PQclear(res);
-	// return false;
-	// 	}
-	// }
-
-	// PQclear(res);
-	// return true;
-	return nil
-}
-
 // Extensions handles \dx.
 //
 // Generated from listExtensions in psql's describe.c.
@@ -981,10 +1301,12 @@ func (d *PgDesc) Extensions(w io.Writer, pattern string) error {
 		GettextNoop("Schema"),
 		GettextNoop("Description"))
 
-	processSQLNamePattern(w, pattern,
+	if err := d.validateSQLNamePattern(w, pattern,
 		false, false,
 		NULL, "e.extname", NULL,
-		NULL)
+		NULL, nil, 1); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1;")
 
@@ -1068,8 +1390,10 @@ func (d *PgDesc) ForeignDataWrappers(w io.Writer, pattern string, verbose bool)
 				"AND d.objoid = fdw.oid AND d.objsubid = 0\n")
 	}
 
-	processSQLNamePattern(w, pattern, false, false,
-		NULL, "fdwname", NULL, NULL)
+	if err := d.validateSQLNamePattern(w, pattern, false, false,
+		NULL, "fdwname", NULL, NULL, nil, 1); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1;")
 
@@ -1150,8 +1474,10 @@ func (d *PgDesc) ForeignServers(w io.Writer, pattern string, verbose bool) error
 				"AND d.objsubid = 0\n")
 	}
 
-	processSQLNamePattern(w, pattern, false, false,
-		NULL, "s.srvname", NULL, NULL)
+	if err := d.validateSQLNamePattern(w, pattern, false, false,
+		NULL, "s.srvname", NULL, NULL, nil, 1); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1;")
 
@@ -1229,9 +1555,11 @@ func (d *PgDesc) ForeignTables(w io.Writer, pattern string, verbose bool) error
 				"d.objoid = c.oid AND d.objsubid = 0\n")
 	}
 
-	processSQLNamePattern(w, pattern, false, false,
+	if err := d.validateSQLNamePattern(w, pattern, false, false,
 		"n.nspname", "c.relname", NULL,
-		"pg_catalog.pg_table_is_visible(c.oid)")
+		"pg_catalog.pg_table_is_visible(c.oid)", nil, 3); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1, 2;")
 
@@ -1319,9 +1647,9 @@ func (d *PgDesc) Functions(w io.Writer, functypes string, pattern string, verbos
 			"  pg_catalog.pg_get_function_result(p.oid) as \"%s\",\n"+
 				"  pg_catalog.pg_get_function_arguments(p.oid) as \"%s\",\n"+
 				" CASE p.prokind\n"+
-				"  WHEN 'a' THEN '%s'\n"+
-				"  WHEN 'w' THEN '%s'\n"+
-				"  WHEN 'p' THEN '%s'\n"+
+				"  WHEN '"+string(PROKIND_AGGREGATE)+"' THEN '%s'\n"+
+				"  WHEN '"+string(PROKIND_WINDOW)+"' THEN '%s'\n"+
+				"  WHEN '"+string(PROKIND_PROCEDURE)+"' THEN '%s'\n"+
 				"  ELSE '%s'\n"+
 				" END as \"%s\"",
 			GettextNoop("Result data type"),
@@ -1358,10 +1686,10 @@ func (d *PgDesc) Functions(w io.Writer, functypes string, pattern string, verbos
 				"    pg_catalog.array_to_string(ARRAY(\n"+
 				"      SELECT\n"+
 				"        CASE\n"+
-				"          WHEN p.proargmodes[s.i] = 'i' THEN ''\n"+
-				"          WHEN p.proargmodes[s.i] = 'o' THEN 'OUT '\n"+
-				"          WHEN p.proargmodes[s.i] = 'b' THEN 'INOUT '\n"+
-				"          WHEN p.proargmodes[s.i] = 'v' THEN 'VARIADIC '\n"+
+				"          WHEN p.proargmodes[s.i] = '"+string(PROARGMODE_IN)+"' THEN ''\n"+
+				"          WHEN p.proargmodes[s.i] = '"+string(PROARGMODE_OUT)+"' THEN 'OUT '\n"+
+				"          WHEN p.proargmodes[s.i] = '"+string(PROARGMODE_INOUT)+"' THEN 'INOUT '\n"+
+				"          WHEN p.proargmodes[s.i] = '"+string(PROARGMODE_VARIADIC)+"' THEN 'VARIADIC '\n"+
 				"        END ||\n"+
 				"        CASE\n"+
 				"          WHEN COALESCE(p.proargnames[s.i], '') = '' THEN ''\n"+
@@ -1417,9 +1745,9 @@ func (d *PgDesc) Functions(w io.Writer, functypes string, pattern string, verbos
 	if verbose {
 		fmt.Fprintf(w,
 			",\n CASE\n"+
-				"  WHEN p.provolatile = 'i' THEN '%s'\n"+
-				"  WHEN p.provolatile = 's' THEN '%s'\n"+
-				"  WHEN p.provolatile = 'v' THEN '%s'\n"+
+				"  WHEN p.provolatile = '"+string(PROVOLATILE_IMMUTABLE)+"' THEN '%s'\n"+
+				"  WHEN p.provolatile = '"+string(PROVOLATILE_STABLE)+"' THEN '%s'\n"+
+				"  WHEN p.provolatile = '"+string(PROVOLATILE_VOLATILE)+"' THEN '%s'\n"+
 				" END as \"%s\"",
 			GettextNoop("immutable"),
 			GettextNoop("stable"),
@@ -1428,9 +1756,9 @@ func (d *PgDesc) Functions(w io.Writer, functypes string, pattern string, verbos
 		if d.version >= 90600 {
 			fmt.Fprintf(w,
 				",\n CASE\n"+
-					"  WHEN p.proparallel = 'r' THEN '%s'\n"+
-					"  WHEN p.proparallel = 's' THEN '%s'\n"+
-					"  WHEN p.proparallel = 'u' THEN '%s'\n"+
+					"  WHEN p.proparallel = '"+string(PROPARALLEL_RESTRICTED)+"' THEN '%s'\n"+
+					"  WHEN p.proparallel = '"+string(PROPARALLEL_SAFE)+"' THEN '%s'\n"+
+					"  WHEN p.proparallel = '"+string(PROPARALLEL_UNSAFE)+"' THEN '%s'\n"+
 					" END as \"%s\"",
 				GettextNoop("restricted"),
 				GettextNoop("safe"),
@@ -1478,7 +1806,7 @@ func (d *PgDesc) Functions(w io.Writer, functypes string, pattern string, verbos
 				have_where = true
 			}
 			if d.version >= 110000 {
-				fmt.Fprint(w, "p.prokind <> 'a'\n")
+				fmt.Fprint(w, "p.prokind <> '"+string(PROKIND_AGGREGATE)+"'\n")
 			} else {
 				fmt.Fprint(w, "NOT p.proisagg\n")
 			}
@@ -1490,7 +1818,7 @@ func (d *PgDesc) Functions(w io.Writer, functypes string, pattern string, verbos
 				fmt.Fprint(w, "WHERE ")
 				have_where = true
 			}
-			fmt.Fprint(w, "p.prokind <> 'p'\n")
+			fmt.Fprint(w, "p.prokind <> '"+string(PROKIND_PROCEDURE)+"'\n")
 		}
 		if !showTrigger {
 			if have_where {
@@ -1509,7 +1837,7 @@ func (d *PgDesc) Functions(w io.Writer, functypes string, pattern string, verbos
 				have_where = true
 			}
 			if d.version >= 110000 {
-				fmt.Fprint(w, "p.prokind <> 'w'\n")
+				fmt.Fprint(w, "p.prokind <> '"+string(PROKIND_WINDOW)+"'\n")
 			} else {
 				fmt.Fprint(w, "NOT p.proiswindow\n")
 			}
@@ -1522,7 +1850,7 @@ func (d *PgDesc) Functions(w io.Writer, functypes string, pattern string, verbos
 		/* Note: at least one of these must be true ... */
 		if showAggregate {
 			if d.version >= 110000 {
-				fmt.Fprint(w, "p.prokind = 'a'\n")
+				fmt.Fprint(w, "p.prokind = '"+string(PROKIND_AGGREGATE)+"'\n")
 			} else {
 				fmt.Fprint(w, "p.proisagg\n")
 			}
@@ -1540,7 +1868,7 @@ func (d *PgDesc) Functions(w io.Writer, functypes string, pattern string, verbos
 			if needs_or {
 				fmt.Fprint(w, "       OR ")
 			}
-			fmt.Fprint(w, "p.prokind = 'p'\n")
+			fmt.Fprint(w, "p.prokind = '"+string(PROKIND_PROCEDURE)+"'\n")
 			needs_or = true
 		}
 		if showWindow {
@@ -1548,7 +1876,7 @@ func (d *PgDesc) Functions(w io.Writer, functypes string, pattern string, verbos
 				fmt.Fprint(w, "       OR ")
 			}
 			if d.version >= 110000 {
-				fmt.Fprint(w, "p.prokind = 'w'\n")
+				fmt.Fprint(w, "p.prokind = '"+string(PROKIND_WINDOW)+"'\n")
 			} else {
 				fmt.Fprint(w, "p.proiswindow\n")
 			}
@@ -1557,11 +1885,13 @@ func (d *PgDesc) Functions(w io.Writer, functypes string, pattern string, verbos
 		fmt.Fprint(w, "      )\n")
 	}
 
-	processSQLNamePattern(w, pattern, have_where, false,
+	if err := d.validateSQLNamePattern(w, pattern, have_where, false,
 		"n.nspname", "p.proname", NULL,
-		"pg_catalog.pg_function_is_visible(p.oid)")
+		"pg_catalog.pg_function_is_visible(p.oid)", nil, 3); err != nil {
+		return err
+	}
 
-	if !showSystem && pattern != NULL {
+	if !showSystem && pattern == NULL {
 		fmt.Fprint(w, "      AND n.nspname <> 'pg_catalog'\n"+
 			"      AND n.nspname <> 'information_schema'\n")
 	}
@@ -1645,11 +1975,13 @@ func (d *PgDesc) Languages(w io.Writer, pattern string, verbose bool, showSystem
 		GettextNoop("Description"))
 
 	if pattern != NULL {
-		processSQLNamePattern(w, pattern, false, false,
-			NULL, "l.lanname", NULL, NULL)
+		if err := d.validateSQLNamePattern(w, pattern, false, false,
+			NULL, "l.lanname", NULL, NULL, nil, 2); err != nil {
+			return err
+		}
 	}
 
-	if !showSystem && pattern != NULL {
+	if !showSystem && pattern == NULL {
 		fmt.Fprint(w, "WHERE l.lanplcallfoid != 0\n")
 	}
 
@@ -1714,14 +2046,16 @@ func (d *PgDesc) ObjectDescription(w io.Writer, pattern string, showSystem bool)
 			"    ON n.oid = c.relnamespace\n",
 		GettextNoop("table constraint"))
 
-	if !showSystem && pattern != NULL {
+	if !showSystem && pattern == NULL {
 		fmt.Fprint(w, "WHERE n.nspname <> 'pg_catalog'\n"+
 			"      AND n.nspname <> 'information_schema'\n")
 	}
 
-	processSQLNamePattern(w, pattern, !showSystem && pattern != NULL,
+	if err := d.validateSQLNamePattern(w, pattern, !showSystem && pattern == NULL,
 		false, "n.nspname", "pgc.conname", NULL,
-		"pg_catalog.pg_table_is_visible(c.oid)")
+		"pg_catalog.pg_table_is_visible(c.oid)", nil, 3); err != nil {
+		return err
+	}
 
 	/* Domain constraint descriptions */
 	fmt.Fprintf(w,
@@ -1737,14 +2071,16 @@ func (d *PgDesc) ObjectDescription(w io.Writer, pattern string, showSystem bool)
 			"    ON n.oid = t.typnamespace\n",
 		GettextNoop("domain constraint"))
 
-	if !showSystem && pattern != NULL {
+	if !showSystem && pattern == NULL {
 		fmt.Fprint(w, "WHERE n.nspname <> 'pg_catalog'\n"+
 			"      AND n.nspname <> 'information_schema'\n")
 	}
 
-	processSQLNamePattern(w, pattern, !showSystem && pattern != NULL,
+	if err := d.validateSQLNamePattern(w, pattern, !showSystem && pattern == NULL,
 		false, "n.nspname", "pgc.conname", NULL,
-		"pg_catalog.pg_type_is_visible(t.oid)")
+		"pg_catalog.pg_type_is_visible(t.oid)", nil, 3); err != nil {
+		return err
+	}
 
 	/*
 	 * pg_opclass.opcmethod only available in 8.3+
@@ -1764,14 +2100,16 @@ func (d *PgDesc) ObjectDescription(w io.Writer, pattern string, showSystem bool)
 				"n.oid = o.opcnamespace\n",
 			GettextNoop("operator class"))
 
-		if !showSystem && pattern != NULL {
+		if !showSystem && pattern == NULL {
 			fmt.Fprint(w, "      AND n.nspname <> 'pg_catalog'\n"+
 				"      AND n.nspname <> 'information_schema'\n")
 		}
 
-		processSQLNamePattern(w, pattern, true, false,
+		if err := d.validateSQLNamePattern(w, pattern, true, false,
 			"n.nspname", "o.opcname", NULL,
-			"pg_catalog.pg_opclass_is_visible(o.oid)")
+			"pg_catalog.pg_opclass_is_visible(o.oid)", nil, 3); err != nil {
+			return err
+		}
 	}
 
 	/*
@@ -1793,14 +2131,16 @@ func (d *PgDesc) ObjectDescription(w io.Writer, pattern string, showSystem bool)
 				"ON opf.opfnamespace = n.oid\n",
 			GettextNoop("operator family"))
 
-		if !showSystem && pattern != NULL {
+		if !showSystem && pattern == NULL {
 			fmt.Fprint(w, "      AND n.nspname <> 'pg_catalog'\n"+
 				"      AND n.nspname <> 'information_schema'\n")
 		}
 
-		processSQLNamePattern(w, pattern, true, false,
+		if err := d.validateSQLNamePattern(w, pattern, true, false,
 			"n.nspname", "opf.opfname", NULL,
-			"pg_catalog.pg_opfamily_is_visible(opf.oid)")
+			"pg_catalog.pg_opfamily_is_visible(opf.oid)", nil, 3); err != nil {
+			return err
+		}
 	}
 
 	/* Rule descriptions (ignore rules for views) */
@@ -1816,14 +2156,16 @@ func (d *PgDesc) ObjectDescription(w io.Writer, pattern string, showSystem bool)
 			"  WHERE r.rulename != '_RETURN'\n",
 		GettextNoop("rule"))
 
-	if !showSystem && pattern != NULL {
+	if !showSystem && pattern == NULL {
 		fmt.Fprint(w, "      AND n.nspname <> 'pg_catalog'\n"+
 			"      AND n.nspname <> 'information_schema'\n")
 	}
 
-	processSQLNamePattern(w, pattern, true, false,
+	if err := d.validateSQLNamePattern(w, pattern, true, false,
 		"n.nspname", "r.rulename", NULL,
-		"pg_catalog.pg_table_is_visible(c.oid)")
+		"pg_catalog.pg_table_is_visible(c.oid)", nil, 3); err != nil {
+		return err
+	}
 
 	/* Trigger descriptions */
 	fmt.Fprintf(w,
@@ -1837,14 +2179,16 @@ func (d *PgDesc) ObjectDescription(w io.Writer, pattern string, showSystem bool)
 			"       LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace\n",
 		GettextNoop("trigger"))
 
-	if !showSystem && pattern != NULL {
+	if !showSystem && pattern == NULL {
 		fmt.Fprint(w, "WHERE n.nspname <> 'pg_catalog'\n"+
 			"      AND n.nspname <> 'information_schema'\n")
 	}
 
-	processSQLNamePattern(w, pattern, !showSystem && pattern != NULL, false,
+	if err := d.validateSQLNamePattern(w, pattern, !showSystem && pattern == NULL, false,
 		"n.nspname", "t.tgname", NULL,
-		"pg_catalog.pg_table_is_visible(c.oid)")
+		"pg_catalog.pg_table_is_visible(c.oid)", nil, 3); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w,
 		") AS tt\n"+
@@ -1870,235 +2214,6 @@ func (d *PgDesc) ObjectDescription(w io.Writer, pattern string, showSystem bool)
 	return nil
 }
 
-// OneExtensionContents handles [none].
-//
-// Generated from listOneExtensionContents in psql's describe.c.
-//
-// show one extension contents
-func (d *PgDesc) OneExtensionContents(w io.Writer, extname string, oid string) error {
-
-	// PQExpBufferData buf;
-	// PGresult   *res;
-	// PQExpBufferData title;
-	// printQueryOpt myopt = pset.popt;
-
-	// initPQExpBuffer(w);
-	fmt.Fprintf(w,
-		"SELECT pg_catalog.pg_describe_object(classid, objid, 0) AS \"%s\"\n"+
-			"FROM pg_catalog.pg_depend\n"+
-			"WHERE refclassid = 'pg_catalog.pg_extension'::pg_catalog.regclass AND refobjid = '%s' AND deptype = 'e'\n"+
-			"ORDER BY 1;",
-		GettextNoop("Object description"),
-		oid)
-
-	// res = PSQLexec(buf.data);
-	// termPQExpBuffer(w);
-	// if (!res)
-	// return false;
-
-	// myopt.nullPrint = NULL;
-	// initPQExpBuffer(&title);
-	// fmt.Fprintf(&title, _("Objects in extension \"%s\""), extname);
-	// myopt.title = title.data;
-	// myopt.translate_header = true;
-
-	// printQuery(res, &myopt, pset.queryFout, false, pset.logfile);
-
-	// termPQExpBuffer(&title);
-	// PQclear(res);
-	// return true;
-	return nil
-}
-
-// OneTextSearchConfig handles [none].
-//
-// Generated from describeOneTSConfig in psql's describe.c.
-//
-// show one description of text search config
-func (d *PgDesc) OneTextSearchConfig(w io.Writer, oid string, nspname string, cfgname string, pnspname string, prsname string) error {
-
-	// PQExpBufferData buf,
-	// 			title;
-	// PGresult   *res;
-	// printQueryOpt myopt = pset.popt;
-
-	// initPQExpBuffer(w);
-
-	fmt.Fprintf(w,
-		"SELECT\n"+
-			"  ( SELECT t.alias FROM\n"+
-			"    pg_catalog.ts_token_type(c.cfgparser) AS t\n"+
-			"    WHERE t.tokid = m.maptokentype ) AS \"%s\",\n"+
-			"  pg_catalog.btrim(\n"+
-			"    ARRAY( SELECT mm.mapdict::pg_catalog.regdictionary\n"+
-			"           FROM pg_catalog.pg_ts_config_map AS mm\n"+
-			"           WHERE mm.mapcfg = m.mapcfg AND mm.maptokentype = m.maptokentype\n"+
-			"           ORDER BY mapcfg, maptokentype, mapseqno\n"+
-			"    ) :: pg_catalog.text,\n"+
-			"  '{}') AS \"%s\"\n"+
-			"FROM pg_catalog.pg_ts_config AS c, pg_catalog.pg_ts_config_map AS m\n"+
-			"WHERE c.oid = '%s' AND m.mapcfg = c.oid\n"+
-			"GROUP BY m.mapcfg, m.maptokentype, c.cfgparser\n"+
-			"ORDER BY 1;",
-		GettextNoop("Token"),
-		GettextNoop("Dictionaries"),
-		oid)
-
-	// res = PSQLexec(buf.data);
-	// termPQExpBuffer(w);
-	// if (!res)
-	// return false;
-
-	// initPQExpBuffer(&title);
-
-	// if (nspname)
-	// 	fmt.Fprintf(&title, _("Text search configuration \"%s.%s\""),
-	// 					  nspname, cfgname);
-	// else
-	// 	fmt.Fprintf(&title, _("Text search configuration \"%s\""),
-	// 					  cfgname);
-
-	// if (pnspname)
-	// 	fmt.Fprintf(&title, _("\nParser: \"%s.%s\""),
-	// 					  pnspname, prsname);
-	// else
-	// 	fmt.Fprintf(&title, _("\nParser: \"%s\""),
-	// 					  prsname);
-
-	// myopt.nullPrint = NULL;
-	// myopt.title = title.data;
-	// myopt.footers = NULL;
-	// myopt.topt.default_footer = false;
-	// myopt.translate_header = true;
-
-	// printQuery(res, &myopt, pset.queryFout, false, pset.logfile);
-
-	// termPQExpBuffer(&title);
-
-	// PQclear(res);
-	// return true;
-	return nil
-}
-
-// OneTextSearchParser handles [none].
-//
-// Generated from describeOneTSParser in psql's describe.c.
-//
-// show one description of text search parser
-func (d *PgDesc) OneTextSearchParser(w io.Writer, oid string, nspname string, prsname string) error {
-
-	// PQExpBufferData buf;
-	// PGresult   *res;
-	// PQExpBufferData title;
-	// printQueryOpt myopt = pset.popt;
-	// static const bool translate_columns[] = {true, false, false};
-
-	// initPQExpBuffer(w);
-
-	fmt.Fprintf(w,
-		"SELECT '%s' AS \"%s\",\n"+
-			"   p.prsstart::pg_catalog.regproc AS \"%s\",\n"+
-			"   pg_catalog.obj_description(p.prsstart, 'pg_proc') as \"%s\"\n"+
-			" FROM pg_catalog.pg_ts_parser p\n"+
-			" WHERE p.oid = '%s'\n"+
-			"UNION ALL\n"+
-			"SELECT '%s',\n"+
-			"   p.prstoken::pg_catalog.regproc,\n"+
-			"   pg_catalog.obj_description(p.prstoken, 'pg_proc')\n"+
-			" FROM pg_catalog.pg_ts_parser p\n"+
-			" WHERE p.oid = '%s'\n"+
-			"UNION ALL\n"+
-			"SELECT '%s',\n"+
-			"   p.prsend::pg_catalog.regproc,\n"+
-			"   pg_catalog.obj_description(p.prsend, 'pg_proc')\n"+
-			" FROM pg_catalog.pg_ts_parser p\n"+
-			" WHERE p.oid = '%s'\n"+
-			"UNION ALL\n"+
-			"SELECT '%s',\n"+
-			"   p.prsheadline::pg_catalog.regproc,\n"+
-			"   pg_catalog.obj_description(p.prsheadline, 'pg_proc')\n"+
-			" FROM pg_catalog.pg_ts_parser p\n"+
-			" WHERE p.oid = '%s'\n"+
-			"UNION ALL\n"+
-			"SELECT '%s',\n"+
-			"   p.prslextype::pg_catalog.regproc,\n"+
-			"   pg_catalog.obj_description(p.prslextype, 'pg_proc')\n"+
-			" FROM pg_catalog.pg_ts_parser p\n"+
-			" WHERE p.oid = '%s';",
-		GettextNoop("Start parse"),
-		GettextNoop("Method"),
-		GettextNoop("Function"),
-		GettextNoop("Description"),
-		oid,
-		GettextNoop("Get next token"),
-		oid,
-		GettextNoop("End parse"),
-		oid,
-		GettextNoop("Get headline"),
-		oid,
-		GettextNoop("Get token types"),
-		oid)
-
-	// res = PSQLexec(buf.data);
-	// termPQExpBuffer(w);
-	// if (!res)
-	// return false;
-
-	// myopt.nullPrint = NULL;
-	// initPQExpBuffer(&title);
-	// if (nspname)
-	// 	fmt.Fprintf(&title, _("Text search parser \"%s.%s\""),
-	// 					  nspname, prsname);
-	// else
-	// 	fmt.Fprintf(&title, _("Text search parser \"%s\""), prsname);
-	// myopt.title = title.data;
-	// myopt.footers = NULL;
-	// myopt.topt.default_footer = false;
-	// myopt.translate_header = true;
-	// myopt.translate_columns = translate_columns;
-	// myopt.n_translate_columns = lengthof(translate_columns);
-
-	// printQuery(res, &myopt, pset.queryFout, false, pset.logfile);
-
-	// PQclear(res);
-
-	// initPQExpBuffer(w);
-
-	// fmt.Fprintf(w,
-	// 				  "SELECT t.alias as \"%s\",\n"+
-	// 				  "  t.description as \"%s\"\n"+
-	// 				  "FROM pg_catalog.ts_token_type( '%s'::pg_catalog.oid ) as t\n"+
-	// 				  "ORDER BY 1;",
-	// 				  GettextNoop("Token name"),
-	// 				  GettextNoop("Description"),
-	// 				  oid);
-
-	// res = PSQLexec(buf.data);
-	// termPQExpBuffer(w);
-	// if (!res)
-	// return false;
-
-	// myopt.nullPrint = NULL;
-	// if (nspname)
-	// 	fmt.Fprintf(&title, _("Token types for parser \"%s.%s\""),
-	// 					  nspname, prsname);
-	// else
-	// 	fmt.Fprintf(&title, _("Token types for parser \"%s\""), prsname);
-	// myopt.title = title.data;
-	// myopt.footers = NULL;
-	// myopt.topt.default_footer = true;
-	// myopt.translate_header = true;
-	// myopt.translate_columns = NULL;
-	// myopt.n_translate_columns = 0;
-
-	// printQuery(res, &myopt, pset.queryFout, false, pset.logfile);
-
-	// termPQExpBuffer(&title);
-	// PQclear(res);
-	// return true;
-	return nil
-}
-
 // Operators handles \do.
 //
 // Generated from describeOperators in psql's describe.c.
@@ -2151,14 +2266,16 @@ func (d *PgDesc) Operators(w io.Writer, pattern string, verbose bool, showSystem
 			"     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = o.oprnamespace\n",
 		GettextNoop("Description"))
 
-	if !showSystem && pattern != NULL {
+	if !showSystem && pattern == NULL {
 		fmt.Fprint(w, "WHERE n.nspname <> 'pg_catalog'\n"+
 			"      AND n.nspname <> 'information_schema'\n")
 	}
 
-	processSQLNamePattern(w, pattern, !showSystem && pattern != NULL, true,
+	if err := d.validateSQLNamePattern(w, pattern, !showSystem && pattern == NULL, true,
 		"n.nspname", "o.oprname", NULL,
-		"pg_catalog.pg_operator_is_visible(o.oid)")
+		"pg_catalog.pg_operator_is_visible(o.oid)", nil, 3); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1, 2, 3, 4;")
 
@@ -2313,9 +2430,11 @@ func (d *PgDesc) Permissions(w io.Writer, pattern string) error {
 	 * point of view.  You can see 'em by explicit request though, eg with \z
 	 * pg_catalog.*
 	 */
-	processSQLNamePattern(w, pattern, true, false,
+	if err := d.validateSQLNamePattern(w, pattern, true, false,
 		"n.nspname", "c.relname", NULL,
-		"n.nspname !~ '^pg_' AND pg_catalog.pg_table_is_visible(c.oid)")
+		"n.nspname !~ '^pg_' AND pg_catalog.pg_table_is_visible(c.oid)", nil, 3); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1, 2;")
 
@@ -2354,6 +2473,7 @@ func (d *PgDesc) PublicationDetails(w io.Writer, pattern string) error {
 	// int			i;
 	// PGresult   *res;
 	var has_pubtruncate bool
+	var has_pubviaroot bool
 
 	if d.version < 100000 {
 		// char sverbuf[32];
@@ -2364,6 +2484,7 @@ func (d *PgDesc) PublicationDetails(w io.Writer, pattern string) error {
 	}
 
 	has_pubtruncate = (d.version >= 110000)
+	has_pubviaroot = (d.version >= 130000)
 
 	// initPQExpBuffer(w);
 
@@ -2374,13 +2495,25 @@ func (d *PgDesc) PublicationDetails(w io.Writer, pattern string) error {
 	if has_pubtruncate {
 		fmt.Fprintf(w,
 			", pubtruncate")
+	} else {
+		fmt.Fprintf(w,
+			", false AS pubtruncate")
+	}
+	if has_pubviaroot {
+		fmt.Fprintf(w,
+			", pubviaroot")
+	} else {
+		fmt.Fprintf(w,
+			", false AS pubviaroot")
 	}
 	fmt.Fprintf(w,
 		"\nFROM pg_catalog.pg_publication\n")
 
-	processSQLNamePattern(w, pattern, false, false,
+	if err := d.validateSQLNamePattern(w, pattern, false, false,
 		NULL, "pubname", NULL,
-		NULL)
+		NULL, nil, 1); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 2;")
 
@@ -2530,13 +2663,20 @@ func (d *PgDesc) Publications(w io.Writer, pattern string) error {
 			",\n  pubtruncate AS \"%s\"",
 			GettextNoop("Truncates"))
 	}
+	if d.version >= 130000 {
+		fmt.Fprintf(w,
+			",\n  pubviaroot AS \"%s\"",
+			GettextNoop("Via root"))
+	}
 
 	fmt.Fprint(w,
 		"\nFROM pg_catalog.pg_publication\n")
 
-	processSQLNamePattern(w, pattern, false, false,
+	if err := d.validateSQLNamePattern(w, pattern, false, false,
 		NULL, "pubname", NULL,
-		NULL)
+		NULL, nil, 1); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1;")
 
@@ -2607,12 +2747,14 @@ func (d *PgDesc) Roles(w io.Writer, pattern string, verbose bool, showSystem boo
 
 		fmt.Fprint(w, "\nFROM pg_catalog.pg_roles r\n")
 
-		if !showSystem && pattern != NULL {
+		if !showSystem && pattern == NULL {
 			fmt.Fprint(w, "WHERE r.rolname !~ '^pg_'\n")
 		}
 
-		processSQLNamePattern(w, pattern, false, false,
-			NULL, "r.rolname", NULL, NULL)
+		if err := d.validateSQLNamePattern(w, pattern, false, false,
+			NULL, "r.rolname", NULL, NULL, nil, 1); err != nil {
+			return err
+		}
 	} else {
 		fmt.Fprintf(w,
 			"SELECT u.usename AS rolname,\n"+
@@ -2624,8 +2766,10 @@ func (d *PgDesc) Roles(w io.Writer, pattern string, verbose bool, showSystem boo
 				"  ARRAY(SELECT g.groname FROM pg_catalog.pg_group g WHERE u.usesysid = ANY(g.grolist)) as memberof"+
 				"\nFROM pg_catalog.pg_user u\n")
 
-		processSQLNamePattern(w, pattern, false, false,
-			NULL, "u.usename", NULL, NULL)
+		if err := d.validateSQLNamePattern(w, pattern, false, false,
+			NULL, "u.usename", NULL, NULL, nil, 1); err != nil {
+			return err
+		}
 	}
 
 	fmt.Fprint(w, "ORDER BY 1;")
@@ -2749,15 +2893,17 @@ func (d *PgDesc) Schemas(w io.Writer, pattern string, verbose bool, showSystem b
 	fmt.Fprintf(w,
 		"\nFROM pg_catalog.pg_namespace n\n")
 
-	if !showSystem && pattern != NULL {
+	if !showSystem && pattern == NULL {
 		fmt.Fprint(w,
 			"WHERE n.nspname !~ '^pg_' AND n.nspname <> 'information_schema'\n")
 	}
 
-	processSQLNamePattern(w, pattern,
-		!showSystem && pattern != NULL, false,
+	if err := d.validateSQLNamePattern(w, pattern,
+		!showSystem && pattern == NULL, false,
 		NULL, "n.nspname", NULL,
-		NULL)
+		NULL, nil, 2); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1;")
 
@@ -2814,11 +2960,64 @@ func (d *PgDesc) Subscriptions(w io.Writer, pattern string, verbose bool) error
 		GettextNoop("Publication"))
 
 	if verbose {
+		/* Binary mode and streaming are only supported in v14 and higher */
+		if d.version >= 140000 {
+			fmt.Fprintf(w,
+				",  subbinary AS \"%s\"\n",
+				GettextNoop("Binary"))
+
+			if d.version >= 160000 {
+				fmt.Fprintf(w,
+					",  (CASE substream\n"+
+						"    WHEN 'f' THEN 'off'\n"+
+						"    WHEN 't' THEN 'on'\n"+
+						"    WHEN 'p' THEN 'parallel'\n"+
+						"   END) AS \"%s\"\n",
+					GettextNoop("Streaming"))
+			} else {
+				fmt.Fprintf(w,
+					",  substream AS \"%s\"\n",
+					GettextNoop("Streaming"))
+			}
+		}
+
+		/* Two-phase and disable on error are only supported in v15 and higher */
+		if d.version >= 150000 {
+			fmt.Fprintf(w,
+				",  subtwophasestate AS \"%s\"\n"+
+					",  subdisableonerr AS \"%s\"\n",
+				GettextNoop("Two-phase commit"),
+				GettextNoop("Disable on error"))
+		}
+
+		if d.version >= 160000 {
+			fmt.Fprintf(w,
+				",  suborigin AS \"%s\"\n"+
+					",  subpasswordrequired AS \"%s\"\n"+
+					",  subrunasowner AS \"%s\"\n",
+				GettextNoop("Origin"),
+				GettextNoop("Password required"),
+				GettextNoop("Run as owner?"))
+		}
+
+		if d.version >= 170000 {
+			fmt.Fprintf(w,
+				",  subfailover AS \"%s\"\n",
+				GettextNoop("Failover"))
+		}
+
 		fmt.Fprintf(w,
 			",  subsynccommit AS \"%s\"\n"+
 				",  subconninfo AS \"%s\"\n",
 			GettextNoop("Synchronous commit"),
 			GettextNoop("Conninfo"))
+
+		/* Skip LSN is only supported in v15 and higher */
+		if d.version >= 150000 {
+			fmt.Fprintf(w,
+				",  subskiplsn AS \"%s\"\n",
+				GettextNoop("Skip LSN"))
+		}
 	}
 
 	/* Only display subscriptions in current database. */
@@ -2828,9 +3027,11 @@ func (d *PgDesc) Subscriptions(w io.Writer, pattern string, verbose bool) error
 			"                 FROM pg_catalog.pg_database\n"+
 			"                 WHERE datname = pg_catalog.current_database())")
 
-	processSQLNamePattern(w, pattern, true, false,
+	if err := d.validateSQLNamePattern(w, pattern, true, false,
 		NULL, "subname", NULL,
-		NULL)
+		NULL, nil, 1); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1;")
 
@@ -2877,14 +3078,16 @@ func (d *PgDesc) TableDetails(w io.Writer, pattern string, verbose bool, showSys
 			"FROM pg_catalog.pg_class c\n"+
 			"     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace\n")
 
-	if !showSystem && pattern != NULL {
+	if !showSystem && pattern == NULL {
 		fmt.Fprint(w, "WHERE n.nspname <> 'pg_catalog'\n"+
 			"      AND n.nspname <> 'information_schema'\n")
 	}
 
-	processSQLNamePattern(w, pattern, !showSystem && pattern != NULL, false,
+	if err := d.validateSQLNamePattern(w, pattern, !showSystem && pattern == NULL, false,
 		"n.nspname", "c.relname", NULL,
-		"pg_catalog.pg_table_is_visible(c.oid)")
+		"pg_catalog.pg_table_is_visible(c.oid)", nil, 3); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 2, 3;")
 
@@ -3005,6 +3208,16 @@ func (d *PgDesc) Tables(w io.Writer, tabtypes string, pattern string, verbose bo
 	}
 
 	if verbose {
+		/*
+		 * Show access method for tables, materialized views and indexes, as
+		 * of PostgreSQL 12.
+		 */
+		if d.version >= 120000 && (showTables || showMatViews || showIndexes) {
+			fmt.Fprintf(w,
+				",\n  am.amname as \"%s\"",
+				GettextNoop("Access method"))
+		}
+
 		/*
 		 * As of PostgreSQL 9.0, use pg_table_size() to show a more accurate
 		 * size of a table, including FSM, VM and TOAST tables.
@@ -3032,6 +3245,10 @@ func (d *PgDesc) Tables(w io.Writer, tabtypes string, pattern string, verbose bo
 			"\n     LEFT JOIN pg_catalog.pg_index i ON i.indexrelid = c.oid"+
 				"\n     LEFT JOIN pg_catalog.pg_class c2 ON i.indrelid = c2.oid")
 	}
+	if verbose && d.version >= 120000 && (showTables || showMatViews || showIndexes) {
+		fmt.Fprint(w,
+			"\n     LEFT JOIN pg_catalog.pg_am am ON am.oid = c.relam")
+	}
 
 	fmt.Fprint(w, "\nWHERE c.relkind IN (")
 	if showTables {
@@ -3061,7 +3278,7 @@ func (d *PgDesc) Tables(w io.Writer, tabtypes string, pattern string, verbose bo
 	fmt.Fprint(w, "''") /* dummy */
 	fmt.Fprint(w, ")\n")
 
-	if !showSystem && pattern != NULL {
+	if !showSystem && pattern == NULL {
 		fmt.Fprint(w, "      AND n.nspname <> 'pg_catalog'\n"+
 			"      AND n.nspname <> 'information_schema'\n")
 	}
@@ -3075,9 +3292,11 @@ func (d *PgDesc) Tables(w io.Writer, tabtypes string, pattern string, verbose bo
 	 */
 	fmt.Fprint(w, "      AND n.nspname !~ '^pg_toast'\n")
 
-	processSQLNamePattern(w, pattern, true, false,
+	if err := d.validateSQLNamePattern(w, pattern, true, false,
 		"n.nspname", "c.relname", NULL,
-		"pg_catalog.pg_table_is_visible(c.oid)")
+		"pg_catalog.pg_table_is_visible(c.oid)", nil, 3); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1,2;")
 
@@ -3180,9 +3399,11 @@ func (d *PgDesc) Tablespaces(w io.Writer, pattern string, verbose bool) error {
 	fmt.Fprint(w,
 		"\nFROM pg_catalog.pg_tablespace\n")
 
-	processSQLNamePattern(w, pattern, false, false,
+	if err := d.validateSQLNamePattern(w, pattern, false, false,
 		NULL, "spcname", NULL,
-		NULL)
+		NULL, nil, 1); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1;")
 
@@ -3239,9 +3460,11 @@ func (d *PgDesc) TextSearchConfigs(w io.Writer, pattern string, verbose bool) er
 		GettextNoop("Name"),
 		GettextNoop("Description"))
 
-	processSQLNamePattern(w, pattern, false, false,
+	if err := d.validateSQLNamePattern(w, pattern, false, false,
 		"n.nspname", "c.cfgname", NULL,
-		"pg_catalog.pg_ts_config_is_visible(c.oid)")
+		"pg_catalog.pg_ts_config_is_visible(c.oid)", nil, 3); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1, 2;")
 
@@ -3261,83 +3484,6 @@ func (d *PgDesc) TextSearchConfigs(w io.Writer, pattern string, verbose bool) er
 	return nil
 }
 
-// TextSearchConfigsVerbose handles [none].
-//
-// Generated from listTSConfigsVerbose in psql's describe.c.
-//
-// full description of configs
-func (d *PgDesc) TextSearchConfigsVerbose(w io.Writer, pattern string) error {
-
-	// PQExpBufferData buf;
-	// PGresult   *res;
-	// int			i;
-
-	// initPQExpBuffer(w);
-
-	fmt.Fprintf(w,
-		"SELECT c.oid, c.cfgname,\n"+
-			"   n.nspname,\n"+
-			"   p.prsname,\n"+
-			"   np.nspname as pnspname\n"+
-			"FROM pg_catalog.pg_ts_config c\n"+
-			"   LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.cfgnamespace,\n"+
-			" pg_catalog.pg_ts_parser p\n"+
-			"   LEFT JOIN pg_catalog.pg_namespace np ON np.oid = p.prsnamespace\n"+
-			"WHERE  p.oid = c.cfgparser\n")
-
-	processSQLNamePattern(w, pattern, true, false,
-		"n.nspname", "c.cfgname", NULL,
-		"pg_catalog.pg_ts_config_is_visible(c.oid)")
-
-	fmt.Fprint(w, "ORDER BY 3, 2;")
-
-	// res = PSQLexec(buf.data);
-	// termPQExpBuffer(w);
-	// if (!res)
-	// return false;
-
-	// if (PQntuples(res) == 0) {
-	// 	if (!pset.quiet) {
-	// 		if (pattern != NULL)
-	// 			return fmt.Errorf("Did not find any text search configuration named \"%s\".\n",
-	// 					   pattern);
-	// 		else
-	// 			return fmt.Errorf("Did not find any text search configurations.\n");
-	// 	}
-	// 	PQclear(res);
-	// return false;
-	// }
-	// for i := 0; i < PQntuples(res); i++ {
-	// 	const char *oid;
-	// 	const char *cfgname;
-	// 	const char *nspname = NULL;
-	// 	const char *prsname;
-	// 	const char *pnspname = NULL;
-
-	// 	oid = PQgetvalue(res, i, 0);
-	// 	cfgname = PQgetvalue(res, i, 1);
-	// 	if (!PQgetisnull(res, i, 2))
-	// 		nspname = PQgetvalue(res, i, 2);
-	// 	prsname = PQgetvalue(res, i, 3);
-	// 	if (!PQgetisnull(res, i, 4))
-	// 		pnspname = PQgetvalue(res, i, 4);
-
-	// 	if (!d.OneTextSearchConfig(w, oid, nspname, cfgname, pnspname, prsname)) {
-	// 		PQclear(res);
-	// return false;
-	// 	}
-
-	// 	if (cancel_pressed) {
-	// 		PQclear(res);
-	// return false;
-	// 	}
-	// }
-
-	// PQclear(res);
-	// return true;
-	return nil
-}
-
 // TextSearchDictionaries handles \dFd.
 //
 // Generated from listTSDictionaries in psql's describe.c.
@@ -3385,9 +3531,11 @@ func (d *PgDesc) TextSearchDictionaries(w io.Writer, pattern string, verbose boo
 	fmt.Fprint(w, "FROM pg_catalog.pg_ts_dict d\n"+
 		"LEFT JOIN pg_catalog.pg_namespace n ON n.oid = d.dictnamespace\n")
 
-	processSQLNamePattern(w, pattern, false, false,
+	if err := d.validateSQLNamePattern(w, pattern, false, false,
 		"n.nspname", "d.dictname", NULL,
-		"pg_catalog.pg_ts_dict_is_visible(d.oid)")
+		"pg_catalog.pg_ts_dict_is_visible(d.oid)", nil, 3); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1, 2;")
 
@@ -3444,9 +3592,11 @@ func (d *PgDesc) TextSearchParsers(w io.Writer, pattern string, verbose bool) er
 		GettextNoop("Name"),
 		GettextNoop("Description"))
 
-	processSQLNamePattern(w, pattern, false, false,
+	if err := d.validateSQLNamePattern(w, pattern, false, false,
 		"n.nspname", "p.prsname", NULL,
-		"pg_catalog.pg_ts_parser_is_visible(p.oid)")
+		"pg_catalog.pg_ts_parser_is_visible(p.oid)", nil, 3); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1, 2;")
 
@@ -3466,74 +3616,6 @@ func (d *PgDesc) TextSearchParsers(w io.Writer, pattern string, verbose bool) er
 	return nil
 }
 
-// TextSearchParsersVerbose handles [none].
-//
-// Generated from listTSParsersVerbose in psql's describe.c.
-//
-// full description of parsers
-func (d *PgDesc) TextSearchParsersVerbose(w io.Writer, pattern string) error {
-
-	// PQExpBufferData buf;
-	// PGresult   *res;
-	// int			i;
-
-	// initPQExpBuffer(w);
-
-	fmt.Fprintf(w,
-		"SELECT p.oid,\n"+
-			"  n.nspname,\n"+
-			"  p.prsname\n"+
-			"FROM pg_catalog.pg_ts_parser p\n"+
-			"LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.prsnamespace\n")
-
-	processSQLNamePattern(w, pattern, false, false,
-		"n.nspname", "p.prsname", NULL,
-		"pg_catalog.pg_ts_parser_is_visible(p.oid)")
-
-	fmt.Fprint(w, "ORDER BY 1, 2;")
-
-	// res = PSQLexec(buf.data);
-	// termPQExpBuffer(w);
-	// if (!res)
-	// return false;
-
-	// if (PQntuples(res) == 0) {
-	// 	if (!pset.quiet) {
-	// 		if (pattern != NULL)
-	// 			return fmt.Errorf("Did not find any text search parser named \"%s\".\n",
-	// 					   pattern);
-	// 		else
-	// 			return fmt.Errorf("Did not find any text search parsers.\n");
-	// 	}
-	// 	PQclear(res);
-	// return false;
-	// }
-	// for i := 0; i < PQntuples(res); i++ {
-	// 	const char *oid;
-	// 	const char *nspname = NULL;
-	// 	const char *prsname;
-
-	// 	oid = PQgetvalue(res, i, 0);
-	// 	if (!PQgetisnull(res, i, 1))
-	// 		nspname = PQgetvalue(res, i, 1);
-	// 	prsname = PQgetvalue(res, i, 2);
-
-	// 	if (!d.OneTextSearchParser(w, oid, nspname, prsname)) {
-	// 		PQclear(res);
-	// return false;
-	// 	}
-
-	// 	if (cancel_pressed) {
-	// 		PQclear(res);
-	// return false;
-	// 	}
-	// }
-
-	// PQclear(res);
-	// return true;
-	return nil
-}
-
 // TextSearchTemplates handles \dFt.
 //
 // Generated from listTSTemplates in psql's describe.c.
@@ -3583,9 +3665,11 @@ func (d *PgDesc) TextSearchTemplates(w io.Writer, pattern string, verbose bool)
 	fmt.Fprint(w, "FROM pg_catalog.pg_ts_template t\n"+
 		"LEFT JOIN pg_catalog.pg_namespace n ON n.oid = t.tmplnamespace\n")
 
-	processSQLNamePattern(w, pattern, false, false,
+	if err := d.validateSQLNamePattern(w, pattern, false, false,
 		"n.nspname", "t.tmplname", NULL,
-		"pg_catalog.pg_ts_template_is_visible(t.oid)")
+		"pg_catalog.pg_ts_template_is_visible(t.oid)", nil, 3); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1, 2;")
 
@@ -3694,16 +3778,18 @@ func (d *PgDesc) Types(w io.Writer, pattern string, verbose bool, showSystem boo
 		fmt.Fprint(w, "  AND t.typname !~ '^_'\n")
 	}
 
-	if !showSystem && pattern != NULL {
+	if !showSystem && pattern == NULL {
 		fmt.Fprint(w, "      AND n.nspname <> 'pg_catalog'\n"+
 			"      AND n.nspname <> 'information_schema'\n")
 
 		/* Match name pattern against either internal or external name */
 	}
-	processSQLNamePattern(w, pattern, true, false,
+	if err := d.validateSQLNamePattern(w, pattern, true, false,
 		"n.nspname", "t.typname",
 		"pg_catalog.format_type(t.oid, NULL)",
-		"pg_catalog.pg_type_is_visible(t.oid)")
+		"pg_catalog.pg_type_is_visible(t.oid)", nil, 3); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1, 2;")
 
@@ -3764,8 +3850,10 @@ func (d *PgDesc) UserMappings(w io.Writer, pattern string, verbose bool) error {
 
 	fmt.Fprint(w, "\nFROM pg_catalog.pg_user_mappings um\n")
 
-	processSQLNamePattern(w, pattern, false, false,
-		NULL, "um.srvname", "um.usename", NULL)
+	if err := d.validateSQLNamePattern(w, pattern, false, false,
+		NULL, "um.srvname", "um.usename", NULL, nil, 1); err != nil {
+		return err
+	}
 
 	fmt.Fprint(w, "ORDER BY 1, 2;")
 
@@ -0,0 +1,344 @@
+package pgdesc
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// goldenVersions are the server versions the generated queries are checked
+// against, covering each version threshold used in pgdesc.go.
+var goldenVersions = []int{
+	80000, 80100, 80200, 80300, 80400,
+	90000, 90100, 90200, 90300, 90400, 90500, 90600,
+	100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000,
+}
+
+// goldenValues are the values used for the string parameters of the
+// generated funcs, by parameter name. Other string parameters are passed a
+// name, and bool parameters both true and false.
+var goldenValues = map[string][]string{
+	"pattern":   {"", "public.foo*"},
+	"pattern2":  {"", "bar?"},
+	"functypes": {"", "anptwS"},
+	"tabtypes":  {"", "tvmsiE"},
+	"reltypes":  {"", "ti"},
+	"oid":       {"16384"},
+}
+
+// goldenFiles are the files containing the funcs checked by TestGolden: the
+// generated funcs, and the manually translated describe.c funcs.
+var goldenFiles = []string{"pgdesc.go", "manual.go", "extensions.go", "textsearch.go"}
+
+// TestGolden checks the queries built by the funcs in the golden files, for
+// each server version and each combination of their parameters, against the
+// golden files in testdata/golden. Run with -update to refresh the golden
+// files after regenerating pgdesc.go.
+func TestGolden(t *testing.T) {
+	var funcs []goldenFunc
+	for _, name := range goldenFiles {
+		v, err := generatedFuncs(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		funcs = append(funcs, v...)
+	}
+	for _, f := range funcs {
+		t.Run(f.name, func(t *testing.T) {
+			buf, err := golden(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkGolden(t, filepath.Join("testdata", "golden", f.name+".sql"), buf)
+		})
+	}
+}
+
+// TestGoldenDetails checks the queries executed by the funcs describing
+// relations and publications in detail, for each server version, against the
+// golden files in testdata/golden. The queries are answered by the result
+// sets in testdata/fakedb. Run with -update to refresh the golden files.
+func TestGoldenDetails(t *testing.T) {
+	tests := []struct {
+		name  string
+		db    string
+		calls []goldenCall
+	}{
+		{"GetTableDetails", "details", tableDetailsCalls()},
+		{"GetPublicationDetails", "publications", []goldenCall{
+			{`GetPublicationDetails(pattern="pgdesc_*")`, func(d *PgDesc) error {
+				_, err := d.GetPublicationDetails("pgdesc_*")
+				return err
+			}},
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			for _, call := range test.calls {
+				fmt.Fprintf(buf, "-- %s\n", call.name)
+				var prev string
+				var versions []string
+				flush := func() {
+					if versions != nil {
+						fmt.Fprintf(buf, "-- versions: %s\n%s\n", strings.Join(versions, ", "), prev)
+					}
+				}
+				for _, version := range goldenVersions {
+					db, fdb := openFakeDB(t, test.db)
+					err := call.f(NewPgDesc(db, version))
+					s := fdb.golden(err)
+					if versions != nil && s != prev {
+						flush()
+						versions = nil
+					}
+					prev, versions = s, append(versions, strconv.Itoa(version))
+				}
+				flush()
+			}
+			checkGolden(t, filepath.Join("testdata", "golden", test.name+".sql"), buf.Bytes())
+		})
+	}
+}
+
+// goldenCall is a call checked by TestGoldenDetails.
+type goldenCall struct {
+	name string
+	f    func(*PgDesc) error
+}
+
+// tableDetailsCalls returns the GetTableDetails calls for each relation in
+// testdata/fakedb/details.txt, with and without verbose.
+func tableDetailsCalls() []goldenCall {
+	var calls []goldenCall
+	for _, name := range []string{"t", "v", "m", "s", "i", "p", "f", "c"} {
+		pattern := "pgdesc_" + name
+		for _, verbose := range []bool{false, true} {
+			calls = append(calls, goldenCall{
+				fmt.Sprintf("GetTableDetails(pattern=%q, verbose=%t)", pattern, verbose),
+				func(d *PgDesc) error {
+					_, err := d.GetTableDetails(pattern, verbose, false)
+					return err
+				},
+			})
+		}
+	}
+	return calls
+}
+
+// golden returns the queries executed against fdb and their arguments, and
+// the error returned by the func executing them.
+func (fdb *fakeDB) golden(err error) string {
+	fdb.mu.Lock()
+	defer fdb.mu.Unlock()
+	var s string
+	for _, q := range fdb.queries {
+		s += q.query
+		if !strings.HasSuffix(s, "\n") {
+			s += "\n"
+		}
+		for i, arg := range q.args {
+			s += fmt.Sprintf("-- $%d = %#v\n", i+1, arg)
+		}
+	}
+	if err != nil {
+		s += fmt.Sprintf("-- error: %q\n", err.Error())
+	}
+	return s
+}
+
+// checkGolden compares buf with the named golden file, or writes buf to the
+// golden file when run with -update.
+func checkGolden(t *testing.T, name string, buf []byte) {
+	t.Helper()
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(name, buf, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	exp, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v (run with -update to create)", err)
+	}
+	if !bytes.Equal(buf, exp) {
+		t.Errorf("output does not match %s (run with -update to refresh)\n%s", name, diffLine(exp, buf))
+	}
+}
+
+// goldenFunc is a generated func and its parameters.
+type goldenFunc struct {
+	name   string
+	params []goldenParam
+}
+
+// goldenParam is a generated func parameter.
+type goldenParam struct {
+	name string
+	typ  string
+}
+
+// generatedFuncs returns the exported PgDesc funcs in the named file taking
+// an io.Writer and string or bool parameters.
+func generatedFuncs(name string) ([]goldenFunc, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), name, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	var funcs []goldenFunc
+loop:
+	for _, decl := range f.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Recv == nil || !fd.Name.IsExported() {
+			continue
+		}
+		params := fd.Type.Params.List
+		if len(params) == 0 || len(params[0].Names) != 1 || exprString(params[0].Type) != "io.Writer" {
+			continue
+		}
+		gf := goldenFunc{name: fd.Name.Name}
+		for _, field := range params[1:] {
+			typ := exprString(field.Type)
+			if typ != "string" && typ != "bool" {
+				continue loop
+			}
+			for _, n := range field.Names {
+				gf.params = append(gf.params, goldenParam{name: n.Name, typ: typ})
+			}
+		}
+		funcs = append(funcs, gf)
+	}
+	return funcs, nil
+}
+
+// exprString returns the type expression x as a string.
+func exprString(x ast.Expr) string {
+	switch x := x.(type) {
+	case *ast.Ident:
+		return x.Name
+	case *ast.SelectorExpr:
+		return exprString(x.X) + "." + x.Sel.Name
+	}
+	return fmt.Sprintf("%T", x)
+}
+
+// golden builds the golden file for f, calling f with each combination of
+// parameters for each server version. Versions building the same query are
+// grouped.
+func golden(f goldenFunc) ([]byte, error) {
+	combos := goldenCombos(f)
+	buf := new(bytes.Buffer)
+	for _, combo := range combos {
+		// call
+		call := make([]string, len(combo))
+		for i, v := range combo {
+			call[i] = f.params[i].name + "=" + fmt.Sprintf("%#v", v)
+		}
+		fmt.Fprintf(buf, "-- %s(%s)\n", f.name, strings.Join(call, ", "))
+
+		// build queries
+		var prev string
+		var versions []string
+		flush := func() {
+			if versions != nil {
+				fmt.Fprintf(buf, "-- versions: %s\n%s\n", strings.Join(versions, ", "), prev)
+			}
+		}
+		for _, version := range goldenVersions {
+			s, err := goldenQuery(NewPgDesc(nil, version), f.name, combo)
+			if err != nil {
+				return nil, err
+			}
+			if versions != nil && s != prev {
+				flush()
+				versions = nil
+			}
+			prev, versions = s, append(versions, strconv.Itoa(version))
+		}
+		flush()
+	}
+	return buf.Bytes(), nil
+}
+
+// goldenCombos returns each combination of parameters f is called with.
+func goldenCombos(f goldenFunc) [][]interface{} {
+	combos := [][]interface{}{nil}
+	for _, p := range f.params {
+		var values []interface{}
+		switch {
+		case p.typ == "bool":
+			values = []interface{}{false, true}
+		case goldenValues[p.name] != nil:
+			for _, v := range goldenValues[p.name] {
+				values = append(values, v)
+			}
+		default:
+			values = []interface{}{"foo"}
+		}
+		var next [][]interface{}
+		for _, c := range combos {
+			for _, v := range values {
+				next = append(next, append(append([]interface{}(nil), c...), v))
+			}
+		}
+		combos = next
+	}
+	return combos
+}
+
+// goldenQuery calls the named func, returning the built query and its
+// arguments, or the error returned by the func.
+func goldenQuery(d *PgDesc, name string, params []interface{}) (string, error) {
+	m := reflect.ValueOf(d).MethodByName(name)
+	if !m.IsValid() {
+		return "", fmt.Errorf("unknown method %s", name)
+	}
+	buf := new(QueryBuffer)
+	args := []reflect.Value{reflect.ValueOf(buf)}
+	for _, v := range params {
+		args = append(args, reflect.ValueOf(v))
+	}
+	if err, _ := m.Call(args)[0].Interface().(error); err != nil {
+		return fmt.Sprintf("-- error: %q\n", err.Error()), nil
+	}
+	s := buf.String()
+	if !strings.HasSuffix(s, "\n") {
+		s += "\n"
+	}
+	for i, arg := range buf.Args {
+		s += fmt.Sprintf("-- $%d = %#v\n", i+1, arg)
+	}
+	return s, nil
+}
+
+// diffLine returns the first line that differs between exp and buf.
+func diffLine(exp, buf []byte) string {
+	a, b := strings.Split(string(exp), "\n"), strings.Split(string(buf), "\n")
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y string
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if x != y {
+			return fmt.Sprintf("line %d:\n-%s\n+%s", i+1, x, y)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,165 @@
+package pgdesc
+
+// Code generated by gen.go. DO NOT EDIT.
+
+// HelpSections are the sections of psql's \? meta-command help, generated
+// from help.c.
+var HelpSections = []HelpSection{
+	{
+		Title: "General",
+		Commands: []HelpCommand{
+			{Name: "copyright", Syntax: "\\copyright", Description: "show PostgreSQL usage and distribution terms", Text: "  \\copyright             show PostgreSQL usage and distribution terms\n"},
+			{Name: "crosstabview", Syntax: "\\crosstabview [COLUMNS]", Description: "execute query and display results in crosstab", Text: "  \\crosstabview [COLUMNS] execute query and display results in crosstab\n"},
+			{Name: "errverbose", Syntax: "\\errverbose", Description: "show most recent error message at maximum verbosity", Text: "  \\errverbose            show most recent error message at maximum verbosity\n"},
+			{Name: "g", Syntax: "\\g [FILE] or ;", Description: "execute query (and send results to file or |pipe)", Text: "  \\g [FILE] or ;         execute query (and send results to file or |pipe)\n"},
+			{Name: "gdesc", Syntax: "\\gdesc", Description: "describe result of query, without executing it", Text: "  \\gdesc                 describe result of query, without executing it\n"},
+			{Name: "gexec", Syntax: "\\gexec", Description: "execute query, then execute each value in its result", Text: "  \\gexec                 execute query, then execute each value in its result\n"},
+			{Name: "gset", Syntax: "\\gset [PREFIX]", Description: "execute query and store results in psql variables", Text: "  \\gset [PREFIX]         execute query and store results in psql variables\n"},
+			{Name: "gx", Syntax: "\\gx [FILE]", Description: "as \\g, but forces expanded output mode", Text: "  \\gx [FILE]             as \\g, but forces expanded output mode\n"},
+			{Name: "q", Syntax: "\\q", Description: "quit psql", Text: "  \\q                     quit psql\n"},
+			{Name: "watch", Syntax: "\\watch [SEC]", Description: "execute query every SEC seconds", Text: "  \\watch [SEC]           execute query every SEC seconds\n"},
+		},
+	},
+	{
+		Title: "Help",
+		Commands: []HelpCommand{
+			{Name: "?", Syntax: "\\? [commands]", Description: "show help on backslash commands", Text: "  \\? [commands]          show help on backslash commands\n"},
+			{Name: "?", Syntax: "\\? options", Description: "show help on psql command-line options", Text: "  \\? options             show help on psql command-line options\n"},
+			{Name: "?", Syntax: "\\? variables", Description: "show help on special variables", Text: "  \\? variables           show help on special variables\n"},
+			{Name: "h", Syntax: "\\h [NAME]", Description: "help on syntax of SQL commands, * for all commands", Text: "  \\h [NAME]              help on syntax of SQL commands, * for all commands\n"},
+		},
+	},
+	{
+		Title: "Query Buffer",
+		Commands: []HelpCommand{
+			{Name: "e", Syntax: "\\e [FILE] [LINE]", Description: "edit the query buffer (or file) with external editor", Text: "  \\e [FILE] [LINE]       edit the query buffer (or file) with external editor\n"},
+			{Name: "ef", Syntax: "\\ef [FUNCNAME [LINE]]", Description: "edit function definition with external editor", Text: "  \\ef [FUNCNAME [LINE]]  edit function definition with external editor\n"},
+			{Name: "ev", Syntax: "\\ev [VIEWNAME [LINE]]", Description: "edit view definition with external editor", Text: "  \\ev [VIEWNAME [LINE]]  edit view definition with external editor\n"},
+			{Name: "p", Syntax: "\\p", Description: "show the contents of the query buffer", Text: "  \\p                     show the contents of the query buffer\n"},
+			{Name: "r", Syntax: "\\r", Description: "reset (clear) the query buffer", Text: "  \\r                     reset (clear) the query buffer\n"},
+			{Name: "s", Syntax: "\\s [FILE]", Description: "display history or save it to file", Text: "  \\s [FILE]              display history or save it to file\n"},
+			{Name: "w", Syntax: "\\w FILE", Description: "write query buffer to file", Text: "  \\w FILE                write query buffer to file\n"},
+		},
+	},
+	{
+		Title: "Input/Output",
+		Commands: []HelpCommand{
+			{Name: "copy", Syntax: "\\copy ...", Description: "perform SQL COPY with data stream to the client host", Text: "  \\copy ...              perform SQL COPY with data stream to the client host\n"},
+			{Name: "echo", Syntax: "\\echo [STRING]", Description: "write string to standard output", Text: "  \\echo [STRING]         write string to standard output\n"},
+			{Name: "i", Syntax: "\\i FILE", Description: "execute commands from file", Text: "  \\i FILE                execute commands from file\n"},
+			{Name: "ir", Syntax: "\\ir FILE", Description: "as \\i, but relative to location of current script", Text: "  \\ir FILE               as \\i, but relative to location of current script\n"},
+			{Name: "o", Syntax: "\\o [FILE]", Description: "send all query results to file or |pipe", Text: "  \\o [FILE]              send all query results to file or |pipe\n"},
+			{Name: "qecho", Syntax: "\\qecho [STRING]", Description: "write string to query output stream (see \\o)", Text: "  \\qecho [STRING]        write string to query output stream (see \\o)\n"},
+		},
+	},
+	{
+		Title: "Conditional",
+		Commands: []HelpCommand{
+			{Name: "if", Syntax: "\\if EXPR", Description: "begin conditional block", Text: "  \\if EXPR               begin conditional block\n"},
+			{Name: "elif", Syntax: "\\elif EXPR", Description: "alternative within current conditional block", Text: "  \\elif EXPR             alternative within current conditional block\n"},
+			{Name: "else", Syntax: "\\else", Description: "final alternative within current conditional block", Text: "  \\else                  final alternative within current conditional block\n"},
+			{Name: "endif", Syntax: "\\endif", Description: "end conditional block", Text: "  \\endif                 end conditional block\n"},
+		},
+	},
+	{
+		Title: "Informational",
+		Commands: []HelpCommand{
+			{Name: "", Syntax: "", Description: "(options: S = show system objects, + = additional detail)", Text: "  (options: S = show system objects, + = additional detail)\n"},
+			{Name: "d", Syntax: "\\d[S+]", Description: "list tables, views, and sequences", Text: "  \\d[S+]                 list tables, views, and sequences\n"},
+			{Name: "d", Syntax: "\\d[S+]  NAME", Description: "describe table, view, sequence, or index", Text: "  \\d[S+]  NAME           describe table, view, sequence, or index\n"},
+			{Name: "da", Syntax: "\\da[S]  [PATTERN]", Description: "list aggregates", Text: "  \\da[S]  [PATTERN]      list aggregates\n"},
+			{Name: "dA", Syntax: "\\dA[+]  [PATTERN]", Description: "list access methods", Text: "  \\dA[+]  [PATTERN]      list access methods\n"},
+			{Name: "db", Syntax: "\\db[+]  [PATTERN]", Description: "list tablespaces", Text: "  \\db[+]  [PATTERN]      list tablespaces\n"},
+			{Name: "dc", Syntax: "\\dc[S+] [PATTERN]", Description: "list conversions", Text: "  \\dc[S+] [PATTERN]      list conversions\n"},
+			{Name: "dC", Syntax: "\\dC[+]  [PATTERN]", Description: "list casts", Text: "  \\dC[+]  [PATTERN]      list casts\n"},
+			{Name: "dconfig", Syntax: "\\dconfig[+] [PATTERN]", Description: "list configuration parameters", Text: "  \\dconfig[+] [PATTERN]  list configuration parameters\n"},
+			{Name: "dd", Syntax: "\\dd[S]  [PATTERN]", Description: "show object descriptions not displayed elsewhere", Text: "  \\dd[S]  [PATTERN]      show object descriptions not displayed elsewhere\n"},
+			{Name: "dD", Syntax: "\\dD[S+] [PATTERN]", Description: "list domains", Text: "  \\dD[S+] [PATTERN]      list domains\n"},
+			{Name: "ddp", Syntax: "\\ddp    [PATTERN]", Description: "list default privileges", Text: "  \\ddp    [PATTERN]      list default privileges\n"},
+			{Name: "dE", Syntax: "\\dE[S+] [PATTERN]", Description: "list foreign tables", Text: "  \\dE[S+] [PATTERN]      list foreign tables\n"},
+			{Name: "det", Syntax: "\\det[+] [PATTERN]", Description: "list foreign tables", Text: "  \\det[+] [PATTERN]      list foreign tables\n"},
+			{Name: "des", Syntax: "\\des[+] [PATTERN]", Description: "list foreign servers", Text: "  \\des[+] [PATTERN]      list foreign servers\n"},
+			{Name: "deu", Syntax: "\\deu[+] [PATTERN]", Description: "list user mappings", Text: "  \\deu[+] [PATTERN]      list user mappings\n"},
+			{Name: "dew", Syntax: "\\dew[+] [PATTERN]", Description: "list foreign-data wrappers", Text: "  \\dew[+] [PATTERN]      list foreign-data wrappers\n"},
+			{Name: "df", Syntax: "\\df[anptw][S+] [PATRN]", Description: "list [only agg/normal/procedures/trigger/window] functions", Text: "  \\df[anptw][S+] [PATRN] list [only agg/normal/procedures/trigger/window] functions\n"},
+			{Name: "dF", Syntax: "\\dF[+]  [PATTERN]", Description: "list text search configurations", Text: "  \\dF[+]  [PATTERN]      list text search configurations\n"},
+			{Name: "dFd", Syntax: "\\dFd[+] [PATTERN]", Description: "list text search dictionaries", Text: "  \\dFd[+] [PATTERN]      list text search dictionaries\n"},
+			{Name: "dFp", Syntax: "\\dFp[+] [PATTERN]", Description: "list text search parsers", Text: "  \\dFp[+] [PATTERN]      list text search parsers\n"},
+			{Name: "dFt", Syntax: "\\dFt[+] [PATTERN]", Description: "list text search templates", Text: "  \\dFt[+] [PATTERN]      list text search templates\n"},
+			{Name: "dg", Syntax: "\\dg[S+] [PATTERN]", Description: "list roles", Text: "  \\dg[S+] [PATTERN]      list roles\n"},
+			{Name: "di", Syntax: "\\di[S+] [PATTERN]", Description: "list indexes", Text: "  \\di[S+] [PATTERN]      list indexes\n"},
+			{Name: "dl", Syntax: "\\dl", Description: "list large objects, same as \\lo_list", Text: "  \\dl                    list large objects, same as \\lo_list\n"},
+			{Name: "dL", Syntax: "\\dL[S+] [PATTERN]", Description: "list procedural languages", Text: "  \\dL[S+] [PATTERN]      list procedural languages\n"},
+			{Name: "dm", Syntax: "\\dm[S+] [PATTERN]", Description: "list materialized views", Text: "  \\dm[S+] [PATTERN]      list materialized views\n"},
+			{Name: "dn", Syntax: "\\dn[S+] [PATTERN]", Description: "list schemas", Text: "  \\dn[S+] [PATTERN]      list schemas\n"},
+			{Name: "do", Syntax: "\\do[S]  [PATTERN]", Description: "list operators", Text: "  \\do[S]  [PATTERN]      list operators\n"},
+			{Name: "dO", Syntax: "\\dO[S+] [PATTERN]", Description: "list collations", Text: "  \\dO[S+] [PATTERN]      list collations\n"},
+			{Name: "dp", Syntax: "\\dp     [PATTERN]", Description: "list table, view, and sequence access privileges", Text: "  \\dp     [PATTERN]      list table, view, and sequence access privileges\n"},
+			{Name: "dP", Syntax: "\\dP[itn+] [PATTERN]", Description: "list [only index/table] partitioned relations [n=nested]", Text: "  \\dP[itn+] [PATTERN]    list [only index/table] partitioned relations [n=nested]\n"},
+			{Name: "drds", Syntax: "\\drds [PATRN1 [PATRN2]]", Description: "list per-database role settings", Text: "  \\drds [PATRN1 [PATRN2]] list per-database role settings\n"},
+			{Name: "drg", Syntax: "\\drg[S]  [PATTERN]", Description: "list role grants", Text: "  \\drg[S]  [PATTERN]     list role grants\n"},
+			{Name: "dRp", Syntax: "\\dRp[+] [PATTERN]", Description: "list replication publications", Text: "  \\dRp[+] [PATTERN]      list replication publications\n"},
+			{Name: "dRs", Syntax: "\\dRs[+] [PATTERN]", Description: "list replication subscriptions", Text: "  \\dRs[+] [PATTERN]      list replication subscriptions\n"},
+			{Name: "ds", Syntax: "\\ds[S+] [PATTERN]", Description: "list sequences", Text: "  \\ds[S+] [PATTERN]      list sequences\n"},
+			{Name: "dt", Syntax: "\\dt[S+] [PATTERN]", Description: "list tables", Text: "  \\dt[S+] [PATTERN]      list tables\n"},
+			{Name: "dT", Syntax: "\\dT[S+] [PATTERN]", Description: "list data types", Text: "  \\dT[S+] [PATTERN]      list data types\n"},
+			{Name: "du", Syntax: "\\du[S+] [PATTERN]", Description: "list roles", Text: "  \\du[S+] [PATTERN]      list roles\n"},
+			{Name: "dv", Syntax: "\\dv[S+] [PATTERN]", Description: "list views", Text: "  \\dv[S+] [PATTERN]      list views\n"},
+			{Name: "dx", Syntax: "\\dx[+]  [PATTERN]", Description: "list extensions", Text: "  \\dx[+]  [PATTERN]      list extensions\n"},
+			{Name: "dX", Syntax: "\\dX     [PATTERN]", Description: "list extended statistics", Text: "  \\dX     [PATTERN]      list extended statistics\n"},
+			{Name: "dy", Syntax: "\\dy     [PATTERN]", Description: "list event triggers", Text: "  \\dy     [PATTERN]      list event triggers\n"},
+			{Name: "l", Syntax: "\\l[+]   [PATTERN]", Description: "list databases", Text: "  \\l[+]   [PATTERN]      list databases\n"},
+			{Name: "sf", Syntax: "\\sf[+]  FUNCNAME", Description: "show a function's definition", Text: "  \\sf[+]  FUNCNAME       show a function's definition\n"},
+			{Name: "sv", Syntax: "\\sv[+]  VIEWNAME", Description: "show a view's definition", Text: "  \\sv[+]  VIEWNAME       show a view's definition\n"},
+			{Name: "z", Syntax: "\\z      [PATTERN]", Description: "same as \\dp", Text: "  \\z      [PATTERN]      same as \\dp\n"},
+		},
+	},
+	{
+		Title: "Formatting",
+		Commands: []HelpCommand{
+			{Name: "a", Syntax: "\\a", Description: "toggle between unaligned and aligned output mode", Text: "  \\a                     toggle between unaligned and aligned output mode\n"},
+			{Name: "C", Syntax: "\\C [STRING]", Description: "set table title, or unset if none", Text: "  \\C [STRING]            set table title, or unset if none\n"},
+			{Name: "f", Syntax: "\\f [STRING]", Description: "show or set field separator for unaligned query output", Text: "  \\f [STRING]            show or set field separator for unaligned query output\n"},
+			{Name: "H", Syntax: "\\H", Description: "toggle HTML output mode (currently %s)", Text: "  \\H                     toggle HTML output mode (currently %s)\n"},
+			{Name: "pset", Syntax: "\\pset [NAME [VALUE]]", Description: "set table output option\n(NAME := {border|columns|expanded|fieldsep|fieldsep_zero|\nfooter|format|linestyle|null|numericlocale|pager|\npager_min_lines|recordsep|recordsep_zero|tableattr|title|\ntuples_only|unicode_border_linestyle|\nunicode_column_linestyle|unicode_header_linestyle})", Text: "  \\pset [NAME [VALUE]]   set table output option\n                         (NAME := {border|columns|expanded|fieldsep|fieldsep_zero|\n                         footer|format|linestyle|null|numericlocale|pager|\n                         pager_min_lines|recordsep|recordsep_zero|tableattr|title|\n                         tuples_only|unicode_border_linestyle|\n                         unicode_column_linestyle|unicode_header_linestyle})\n"},
+			{Name: "t", Syntax: "\\t [on|off]", Description: "show only rows (currently %s)", Text: "  \\t [on|off]            show only rows (currently %s)\n"},
+			{Name: "T", Syntax: "\\T [STRING]", Description: "set HTML <table> tag attributes, or unset if none", Text: "  \\T [STRING]            set HTML <table> tag attributes, or unset if none\n"},
+			{Name: "x", Syntax: "\\x [on|off|auto]", Description: "toggle expanded output (currently %s)", Text: "  \\x [on|off|auto]       toggle expanded output (currently %s)\n"},
+		},
+	},
+	{
+		Title: "Connection",
+		Commands: []HelpCommand{
+			{Name: "c", Syntax: "\\c[onnect] {[DBNAME|- USER|- HOST|- PORT|-] | conninfo}", Description: "connect to new database (currently \"%s\")", Text: "  \\c[onnect] {[DBNAME|- USER|- HOST|- PORT|-] | conninfo}\n                         connect to new database (currently \"%s\")\n"},
+			{Name: "conninfo", Syntax: "\\conninfo", Description: "display information about current connection", Text: "  \\conninfo              display information about current connection\n"},
+			{Name: "encoding", Syntax: "\\encoding [ENCODING]", Description: "show or set client encoding", Text: "  \\encoding [ENCODING]   show or set client encoding\n"},
+			{Name: "password", Syntax: "\\password [USERNAME]", Description: "securely change the password for a user", Text: "  \\password [USERNAME]   securely change the password for a user\n"},
+		},
+	},
+	{
+		Title: "Operating System",
+		Commands: []HelpCommand{
+			{Name: "cd", Syntax: "\\cd [DIR]", Description: "change the current working directory", Text: "  \\cd [DIR]              change the current working directory\n"},
+			{Name: "setenv", Syntax: "\\setenv NAME [VALUE]", Description: "set or unset environment variable", Text: "  \\setenv NAME [VALUE]   set or unset environment variable\n"},
+			{Name: "timing", Syntax: "\\timing [on|off]", Description: "toggle timing of commands (currently %s)", Text: "  \\timing [on|off]       toggle timing of commands (currently %s)\n"},
+			{Name: "!", Syntax: "\\! [COMMAND]", Description: "execute command in shell or start interactive shell", Text: "  \\! [COMMAND]           execute command in shell or start interactive shell\n"},
+		},
+	},
+	{
+		Title: "Variables",
+		Commands: []HelpCommand{
+			{Name: "prompt", Syntax: "\\prompt [TEXT] NAME", Description: "prompt user to set internal variable", Text: "  \\prompt [TEXT] NAME    prompt user to set internal variable\n"},
+			{Name: "set", Syntax: "\\set [NAME [VALUE]]", Description: "set internal variable, or list all if no parameters", Text: "  \\set [NAME [VALUE]]    set internal variable, or list all if no parameters\n"},
+			{Name: "unset", Syntax: "\\unset NAME", Description: "unset (delete) internal variable", Text: "  \\unset NAME            unset (delete) internal variable\n"},
+		},
+	},
+	{
+		Title: "Large Objects",
+		Commands: []HelpCommand{
+			{Name: "lo_export", Syntax: "\\lo_export LOBOID FILE", Description: "", Text: "  \\lo_export LOBOID FILE\n"},
+			{Name: "lo_import", Syntax: "\\lo_import FILE [COMMENT]", Description: "", Text: "  \\lo_import FILE [COMMENT]\n"},
+			{Name: "lo_list", Syntax: "\\lo_list", Description: "", Text: "  \\lo_list\n"},
+			{Name: "lo_unlink", Syntax: "\\lo_unlink LOBOID", Description: "large object operations", Text: "  \\lo_unlink LOBOID      large object operations\n"},
+		},
+	},
+}
@@ -0,0 +1,465 @@
+package pgdesc
+
+import (
+	"context"
+	"io"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Query is a planned describe query. A Query carries everything needed to
+// execute a describe command and display its result: the SQL and its bind
+// arguments, the result column descriptors, and the title and footers of the
+// printed table.
+//
+// Queries are created by the Plan methods, executed with Exec, and converted
+// to a printable Table with Table.
+type Query struct {
+	// SQL is the query text, with patterns and other caller supplied values as
+	// $n parameters.
+	SQL string
+
+	// Args are the query arguments.
+	Args []interface{}
+
+	// Columns are the result column descriptors.
+	Columns []Column
+
+	// Title is the (translated) table title.
+	Title string
+
+	// Footers are the (translated) table footers. When nil, the default row
+	// count footer is displayed.
+	Footers []string
+}
+
+// Column is a query result column descriptor.
+type Column struct {
+	// Name is the column name, as returned by the query (untranslated).
+	Name string
+
+	// Translate is whether or not column values are translated when printed.
+	Translate bool
+}
+
+// plan builds a query by calling f, marking the result columns to translate.
+func (d *PgDesc) plan(title string, translateColumns []bool, f func(io.Writer) error) (*Query, error) {
+	buf := new(QueryBuffer)
+	if err := f(buf); err != nil {
+		return nil, err
+	}
+	q := &Query{
+		SQL:   buf.String(),
+		Args:  buf.Args,
+		Title: title,
+	}
+	for i, name := range selectColumns(q.SQL) {
+		q.Columns = append(q.Columns, Column{
+			Name:      name,
+			Translate: i < len(translateColumns) && translateColumns[i],
+		})
+	}
+	return q, nil
+}
+
+// Exec executes the query.
+func (d *PgDesc) Exec(q *Query) (*Result, error) {
+	return d.exec(q.SQL, q.Args...)
+}
+
+// ExecContext is the same as Exec, but with a context.
+func (d *PgDesc) ExecContext(ctx context.Context, q *Query) (*Result, error) {
+	return d.withContext(ctx).Exec(q)
+}
+
+// Table creates a printable table for the query's result.
+func (q *Query) Table(res *Result) *Table {
+	translateColumns := make([]bool, len(q.Columns))
+	for i, c := range q.Columns {
+		translateColumns[i] = c.Translate
+	}
+	t := NewTable(res, q.Title, translateColumns...)
+	t.Footers = q.Footers
+	return t
+}
+
+// printQuery executes and prints the query.
+func (d *PgDesc) printQuery(w io.Writer, q *Query) error {
+	res, err := d.Exec(q)
+	if err != nil {
+		return err
+	}
+	return d.print(w, q.Table(res))
+}
+
+// selectAliasRE matches a trailing column alias in a select list item.
+var selectAliasRE = regexp.MustCompile(`(?is)\s+AS\s+("(?:[^"]|"")*"|\w+)\s*$`)
+
+// selectNameRE matches a trailing column reference in a select list item.
+var selectNameRE = regexp.MustCompile(`(?s)(?:^|\.)("(?:[^"]|"")*"|\w+)\s*$`)
+
+// selectColumns returns the column names of the outermost select list of a
+// query, using each item's alias or, lacking one, its column reference.
+// Items that are neither are named "?column?", as by PostgreSQL.
+func selectColumns(query string) []string {
+	s := strings.TrimLeftFunc(query, unicode.IsSpace)
+	if !hasKeyword(s, 0, "SELECT") {
+		return nil
+	}
+	s = strings.TrimLeftFunc(s[len("SELECT"):], unicode.IsSpace)
+	if hasKeyword(s, 0, "DISTINCT") {
+		s = s[len("DISTINCT"):]
+	}
+
+	var items []string
+	depth, start := 0, 0
+loop:
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\'' || c == '"':
+			// skip literal or quoted identifier
+			for i++; i < len(s); i++ {
+				if s[i] == '\\' && c == '\'' {
+					i++
+				} else if s[i] == c {
+					if i+1 < len(s) && s[i+1] == c {
+						i++
+						continue
+					}
+					break
+				}
+			}
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case depth != 0:
+		case c == ',':
+			items, start = append(items, s[start:i]), i+1
+		case c == ';':
+			break loop
+		case hasKeyword(s, i, "FROM"), hasKeyword(s, i, "WHERE"),
+			hasKeyword(s, i, "UNION"), hasKeyword(s, i, "ORDER"):
+			s = s[:i]
+			break loop
+		}
+	}
+	items = append(items, s[start:])
+
+	names := make([]string, len(items))
+	for i, item := range items {
+		names[i] = "?column?"
+		if m := selectAliasRE.FindStringSubmatch(item); m != nil {
+			names[i] = unquoteIdent(m[1])
+		} else if m := selectNameRE.FindStringSubmatch(item); m != nil {
+			names[i] = unquoteIdent(m[1])
+		}
+	}
+	return names
+}
+
+// hasKeyword returns whether or not s has the keyword kw at position i.
+func hasKeyword(s string, i int, kw string) bool {
+	isIdent := func(c byte) bool {
+		return c == '_' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+	}
+	return len(s) >= i+len(kw) &&
+		strings.EqualFold(s[i:i+len(kw)], kw) &&
+		(i == 0 || !isIdent(s[i-1])) &&
+		(len(s) == i+len(kw) || !isIdent(s[i+len(kw)]))
+}
+
+// unquoteIdent unquotes a quoted identifier, or folds an unquoted identifier
+// to lower case.
+func unquoteIdent(s string) string {
+	if len(s) > 1 && s[0] == '"' {
+		return strings.ReplaceAll(s[1:len(s)-1], `""`, `"`)
+	}
+	return strings.ToLower(s)
+}
+
+// PlanAccessMethods plans \dA.
+func (d *PgDesc) PlanAccessMethods(pattern string, verbose bool) (*Query, error) {
+	return d.plan(Gettext("List of access methods"), []bool{false, true, false, false}, func(w io.Writer) error {
+		return d.AccessMethods(w, pattern, verbose)
+	})
+}
+
+// PlanAggregates plans \da.
+func (d *PgDesc) PlanAggregates(pattern string, verbose, showSystem bool) (*Query, error) {
+	return d.plan(Gettext("List of aggregate functions"), nil, func(w io.Writer) error {
+		return d.Aggregates(w, pattern, verbose, showSystem)
+	})
+}
+
+// PlanCasts plans \dC.
+func (d *PgDesc) PlanCasts(pattern string, verbose bool) (*Query, error) {
+	return d.plan(Gettext("List of casts"), []bool{false, false, false, true, false}, func(w io.Writer) error {
+		return d.Casts(w, pattern, verbose)
+	})
+}
+
+// PlanCollations plans \dO.
+func (d *PgDesc) PlanCollations(pattern string, verbose, showSystem bool) (*Query, error) {
+	var translateColumns []bool
+	if d.version >= 120000 {
+		// "Deterministic?" follows the provider, locale and rules columns
+		n := 5
+		if d.version >= 150000 {
+			n++
+		}
+		if d.version >= 160000 {
+			n++
+		}
+		translateColumns = make([]bool, n+1)
+		translateColumns[n] = true
+	}
+	return d.plan(Gettext("List of collations"), translateColumns, func(w io.Writer) error {
+		return d.Collations(w, pattern, verbose, showSystem)
+	})
+}
+
+// PlanConfigurationParameters plans \dconfig.
+func (d *PgDesc) PlanConfigurationParameters(pattern string, verbose, showSystem bool) (*Query, error) {
+	title := Gettext("List of non-default configuration parameters")
+	if pattern != NULL {
+		title = Gettext("List of configuration parameters")
+	}
+	return d.plan(title, nil, func(w io.Writer) error {
+		return d.ConfigurationParameters(w, pattern, verbose, showSystem)
+	})
+}
+
+// PlanConversions plans \dc.
+func (d *PgDesc) PlanConversions(pattern string, verbose, showSystem bool) (*Query, error) {
+	return d.plan(Gettext("List of conversions"), []bool{false, false, false, false, true, false}, func(w io.Writer) error {
+		return d.Conversions(w, pattern, verbose, showSystem)
+	})
+}
+
+// PlanDatabaseRoleSettings plans \drds.
+func (d *PgDesc) PlanDatabaseRoleSettings(pattern, pattern2 string) (*Query, error) {
+	return d.plan(Gettext("List of settings"), nil, func(w io.Writer) error {
+		return d.DatabaseRoleSettings(w, pattern, pattern2)
+	})
+}
+
+// PlanDatabases plans \l.
+func (d *PgDesc) PlanDatabases(pattern string, verbose bool) (*Query, error) {
+	return d.plan(Gettext("List of databases"), nil, func(w io.Writer) error {
+		return d.Databases(w, pattern, verbose)
+	})
+}
+
+// PlanDefaultACLS plans \ddp.
+func (d *PgDesc) PlanDefaultACLS(pattern string) (*Query, error) {
+	return d.plan(Gettext("Default access privileges"), []bool{false, false, true, false}, func(w io.Writer) error {
+		return d.DefaultACLS(w, pattern)
+	})
+}
+
+// PlanDomains plans \dD.
+func (d *PgDesc) PlanDomains(pattern string, verbose, showSystem bool) (*Query, error) {
+	return d.plan(Gettext("List of domains"), nil, func(w io.Writer) error {
+		return d.Domains(w, pattern, verbose, showSystem)
+	})
+}
+
+// PlanEventTriggers plans \dy.
+func (d *PgDesc) PlanEventTriggers(pattern string, verbose bool) (*Query, error) {
+	return d.plan(Gettext("List of event triggers"), []bool{false, false, false, true, false, false, false}, func(w io.Writer) error {
+		return d.EventTriggers(w, pattern, verbose)
+	})
+}
+
+// PlanExtendedStats plans \dX.
+func (d *PgDesc) PlanExtendedStats(pattern string) (*Query, error) {
+	return d.plan(Gettext("List of extended statistics"), nil, func(w io.Writer) error {
+		return d.ExtendedStats(w, pattern)
+	})
+}
+
+// PlanExtensions plans \dx.
+func (d *PgDesc) PlanExtensions(pattern string) (*Query, error) {
+	return d.plan(Gettext("List of installed extensions"), nil, func(w io.Writer) error {
+		return d.Extensions(w, pattern)
+	})
+}
+
+// PlanForeignDataWrappers plans \dew.
+func (d *PgDesc) PlanForeignDataWrappers(pattern string, verbose bool) (*Query, error) {
+	return d.plan(Gettext("List of foreign-data wrappers"), nil, func(w io.Writer) error {
+		return d.ForeignDataWrappers(w, pattern, verbose)
+	})
+}
+
+// PlanForeignServers plans \des.
+func (d *PgDesc) PlanForeignServers(pattern string, verbose bool) (*Query, error) {
+	return d.plan(Gettext("List of foreign servers"), nil, func(w io.Writer) error {
+		return d.ForeignServers(w, pattern, verbose)
+	})
+}
+
+// PlanForeignTables plans \det.
+func (d *PgDesc) PlanForeignTables(pattern string, verbose bool) (*Query, error) {
+	return d.plan(Gettext("List of foreign tables"), nil, func(w io.Writer) error {
+		return d.ForeignTables(w, pattern, verbose)
+	})
+}
+
+// PlanFunctions plans \df, \dfa, \dfn, \dft, \dfw, etc.
+func (d *PgDesc) PlanFunctions(functypes, pattern string, verbose, showSystem bool) (*Query, error) {
+	translateColumns := []bool{false, false, false, false, true, true, true, false, true, false, false, false, false}
+	if d.version < 90600 {
+		// No "Parallel" column before 9.6
+		translateColumns = []bool{false, false, false, false, true, true, false, true, false, false, false, false}
+	}
+	return d.plan(Gettext("List of functions"), translateColumns, func(w io.Writer) error {
+		return d.Functions(w, functypes, pattern, verbose, showSystem)
+	})
+}
+
+// PlanLanguages plans \dL.
+func (d *PgDesc) PlanLanguages(pattern string, verbose, showSystem bool) (*Query, error) {
+	return d.plan(Gettext("List of languages"), nil, func(w io.Writer) error {
+		return d.Languages(w, pattern, verbose, showSystem)
+	})
+}
+
+// PlanObjectDescription plans \dd.
+func (d *PgDesc) PlanObjectDescription(pattern string, showSystem bool) (*Query, error) {
+	return d.plan(Gettext("Object descriptions"), []bool{false, false, true, false}, func(w io.Writer) error {
+		return d.ObjectDescription(w, pattern, showSystem)
+	})
+}
+
+// PlanOperators plans \do.
+func (d *PgDesc) PlanOperators(pattern string, verbose, showSystem bool) (*Query, error) {
+	return d.plan(Gettext("List of operators"), nil, func(w io.Writer) error {
+		return d.Operators(w, pattern, verbose, showSystem)
+	})
+}
+
+// PlanPartitionedTables plans \dP, \dPt, \dPi, \dPn, etc.
+func (d *PgDesc) PlanPartitionedTables(reltypes, pattern string, verbose bool) (*Query, error) {
+	showTables := strchr(reltypes, 't') != NULL
+	showIndexes := strchr(reltypes, 'i') != NULL
+
+	var title string
+	var translateColumns []bool
+	switch {
+	case showIndexes && !showTables:
+		title = Gettext("List of partitioned indexes")
+	case showTables && !showIndexes:
+		title = Gettext("List of partitioned tables")
+	default:
+		// show all kinds
+		title = Gettext("List of partitioned relations")
+		translateColumns = []bool{false, false, false, true}
+	}
+	return d.plan(title, translateColumns, func(w io.Writer) error {
+		return d.PartitionedTables(w, reltypes, pattern, verbose)
+	})
+}
+
+// PlanPermissions plans \z (or \dp).
+func (d *PgDesc) PlanPermissions(pattern string) (*Query, error) {
+	return d.plan(Gettext("Access privileges"), []bool{false, false, true, false, false, false}, func(w io.Writer) error {
+		return d.Permissions(w, pattern)
+	})
+}
+
+// PlanPublications plans \dRp.
+func (d *PgDesc) PlanPublications(pattern string) (*Query, error) {
+	return d.plan(Gettext("List of publications"), nil, func(w io.Writer) error {
+		return d.Publications(w, pattern)
+	})
+}
+
+// PlanRoleGrants plans \drg.
+func (d *PgDesc) PlanRoleGrants(pattern string, showSystem bool) (*Query, error) {
+	return d.plan(Gettext("List of role grants"), nil, func(w io.Writer) error {
+		return d.RoleGrants(w, pattern, showSystem)
+	})
+}
+
+// PlanRoles plans \du, \dg.
+//
+// The role attributes are combined into a single column when printed, see
+// PrintRoles.
+func (d *PgDesc) PlanRoles(pattern string, verbose, showSystem bool) (*Query, error) {
+	return d.plan(Gettext("List of roles"), nil, func(w io.Writer) error {
+		return d.Roles(w, pattern, verbose, showSystem)
+	})
+}
+
+// PlanSchemas plans \dn.
+func (d *PgDesc) PlanSchemas(pattern string, verbose, showSystem bool) (*Query, error) {
+	return d.plan(Gettext("List of schemas"), nil, func(w io.Writer) error {
+		return d.Schemas(w, pattern, verbose, showSystem)
+	})
+}
+
+// PlanSubscriptions plans \dRs.
+func (d *PgDesc) PlanSubscriptions(pattern string, verbose bool) (*Query, error) {
+	return d.plan(Gettext("List of subscriptions"), nil, func(w io.Writer) error {
+		return d.Subscriptions(w, pattern, verbose)
+	})
+}
+
+// PlanTables plans \dt, \di, \ds, \dS, etc.
+func (d *PgDesc) PlanTables(tabtypes, pattern string, verbose, showSystem bool) (*Query, error) {
+	return d.plan(Gettext("List of relations"), []bool{false, false, true, false, false, false, false}, func(w io.Writer) error {
+		return d.Tables(w, tabtypes, pattern, verbose, showSystem)
+	})
+}
+
+// PlanTablespaces plans \db.
+func (d *PgDesc) PlanTablespaces(pattern string, verbose bool) (*Query, error) {
+	return d.plan(Gettext("List of tablespaces"), nil, func(w io.Writer) error {
+		return d.Tablespaces(w, pattern, verbose)
+	})
+}
+
+// PlanTextSearchConfigs plans \dF.
+func (d *PgDesc) PlanTextSearchConfigs(pattern string) (*Query, error) {
+	return d.plan(Gettext("List of text search configurations"), nil, func(w io.Writer) error {
+		return d.TextSearchConfigs(w, pattern, false)
+	})
+}
+
+// PlanTextSearchDictionaries plans \dFd.
+func (d *PgDesc) PlanTextSearchDictionaries(pattern string, verbose bool) (*Query, error) {
+	return d.plan(Gettext("List of text search dictionaries"), nil, func(w io.Writer) error {
+		return d.TextSearchDictionaries(w, pattern, verbose)
+	})
+}
+
+// PlanTextSearchParsers plans \dFp.
+func (d *PgDesc) PlanTextSearchParsers(pattern string) (*Query, error) {
+	return d.plan(Gettext("List of text search parsers"), nil, func(w io.Writer) error {
+		return d.TextSearchParsers(w, pattern, false)
+	})
+}
+
+// PlanTextSearchTemplates plans \dFt.
+func (d *PgDesc) PlanTextSearchTemplates(pattern string, verbose bool) (*Query, error) {
+	return d.plan(Gettext("List of text search templates"), nil, func(w io.Writer) error {
+		return d.TextSearchTemplates(w, pattern, verbose)
+	})
+}
+
+// PlanTypes plans \dT.
+func (d *PgDesc) PlanTypes(pattern string, verbose, showSystem bool) (*Query, error) {
+	return d.plan(Gettext("List of data types"), nil, func(w io.Writer) error {
+		return d.Types(w, pattern, verbose, showSystem)
+	})
+}
+
+// PlanUserMappings plans \deu.
+func (d *PgDesc) PlanUserMappings(pattern string, verbose bool) (*Query, error) {
+	return d.plan(Gettext("List of user mappings"), nil, func(w io.Writer) error {
+		return d.UserMappings(w, pattern, verbose)
+	})
+}
@@ -0,0 +1,463 @@
+package pgdesc
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Table is the content of a table to print, similar to psql's
+// printTableContent.
+type Table struct {
+	// Title is the table title, printed above the table.
+	Title string
+
+	// Headers are the column headers.
+	Headers []string
+
+	// Aligns are the column alignments, either 'l' or 'r'. Columns without an
+	// alignment are left aligned.
+	Aligns []byte
+
+	// Rows are the table cells.
+	Rows [][]string
+
+	// Footers are the footers printed below the table.
+	Footers []string
+
+	// DefaultFooter toggles printing the row count (ie, "(2 rows)") when
+	// there are no footers.
+	DefaultFooter bool
+
+	// NoExpanded disables expanded display for the table, regardless of the
+	// print options. psql does the same for the \d table descriptions.
+	NoExpanded bool
+}
+
+// NewTable creates a table for the result, similar to psql's printQuery.
+//
+// Headers are translated, as are the values of any column marked in
+// translateColumns. NULL values are printed as the empty string.
+func NewTable(res *Result, title string, translateColumns ...bool) *Table {
+	t := &Table{
+		Title:         title,
+		Headers:       make([]string, len(res.Columns)),
+		Aligns:        make([]byte, len(res.Columns)),
+		DefaultFooter: true,
+	}
+	for i, c := range res.Columns {
+		t.Headers[i] = translate(c)
+		t.Aligns[i] = 'l'
+		if i < len(res.Types) {
+			t.Aligns[i] = columnAlign(res.Types[i])
+		}
+	}
+	for i := 0; i < res.Len(); i++ {
+		row := make([]string, len(res.Columns))
+		for j := range row {
+			row[j] = res.Value(i, j)
+			if j < len(translateColumns) && translateColumns[j] {
+				row[j] = translate(row[j])
+			}
+		}
+		t.Rows = append(t.Rows, row)
+	}
+	return t
+}
+
+// footers returns the table footers, or the default footer when there are
+// no footers and the default footer is enabled.
+func (t *Table) footers() []string {
+	if t.Footers == nil && t.DefaultFooter {
+		n := len(t.Rows)
+		if n == 1 {
+			return []string{Gettext("(%d row)", n)}
+		}
+		return []string{Gettext("(%d rows)", n)}
+	}
+	return t.Footers
+}
+
+// align returns the alignment for column i.
+func (t *Table) align(i int) byte {
+	if i < len(t.Aligns) && t.Aligns[i] == 'r' {
+		return 'r'
+	}
+	return 'l'
+}
+
+// Print writes the table to w in psql's aligned format.
+func (t *Table) Print(w io.Writer) error {
+	return PrintOptions{}.Print(w, t)
+}
+
+// segment is a display line of a cell.
+type segment struct {
+	s     string
+	width int
+	// mark is the line's right hand mark: 0 for none, 'n' for newline, and
+	// 'w' for wrapped.
+	mark byte
+}
+
+// segments splits the lines of a cell into display segments no wider than
+// max. When max is 0, lines are not wrapped.
+func segments(lines []string, max int) []segment {
+	var v []segment
+	for i, line := range lines {
+		for {
+			n, width := len(line), displayWidth(line)
+			if max > 0 && width > max {
+				n, width = 0, 0
+				for j, r := range line {
+					rw := runeWidth(r)
+					if width+rw > max && j != 0 {
+						break
+					}
+					n, width = j+utf8.RuneLen(r), width+rw
+				}
+			}
+			seg := segment{s: line[:n], width: width}
+			line = line[n:]
+			switch {
+			case line != "":
+				seg.mark = 'w'
+			case i < len(lines)-1:
+				seg.mark = 'n'
+			}
+			v = append(v, seg)
+			if line == "" {
+				break
+			}
+		}
+	}
+	return v
+}
+
+// printAligned writes the table to w in psql's aligned format (with border
+// 1). When wrapped is true, and columns is greater than 0, the column
+// widths are reduced to fit the output width. When auto is true, and the
+// table is wider than columns, the table is printed in the expanded format
+// instead.
+func printAligned(w io.Writer, t *Table, wrapped bool, columns int, auto bool) error {
+	ncols := len(t.Headers)
+
+	// split headers and cells into formatted lines, and calculate widths
+	widthHeader, maxWidth, widthAverage := make([]int, ncols), make([]int, ncols), make([]int, ncols)
+	headers := make([][]string, ncols)
+	for i, h := range t.Headers {
+		headers[i] = formatLines(h)
+		for _, l := range headers[i] {
+			if n := displayWidth(l); n > widthHeader[i] {
+				widthHeader[i] = n
+			}
+		}
+		maxWidth[i] = widthHeader[i]
+	}
+	lines := make([][][]string, len(t.Rows))
+	for i, row := range t.Rows {
+		lines[i] = make([][]string, ncols)
+		for j := 0; j < ncols; j++ {
+			var s string
+			if j < len(row) {
+				s = row[j]
+			}
+			lines[i][j] = formatLines(s)
+			var width int
+			for _, l := range lines[i][j] {
+				if n := displayWidth(l); n > width {
+					width = n
+				}
+			}
+			if width > maxWidth[j] {
+				maxWidth[j] = width
+			}
+			widthAverage[j] += width
+		}
+	}
+	if len(t.Rows) != 0 {
+		for i := range widthAverage {
+			widthAverage[i] /= len(t.Rows)
+		}
+	}
+
+	widthTotal := 0
+	if ncols > 0 {
+		widthTotal = ncols*3 - 1
+	}
+	totalHeaderWidth := widthTotal
+	widthWrap := make([]int, ncols)
+	for i := 0; i < ncols; i++ {
+		widthWrap[i] = maxWidth[i]
+		widthTotal += maxWidth[i]
+		totalHeaderWidth += widthHeader[i]
+	}
+
+	// Optional optimized word wrap. Shrink columns with a high max/avg
+	// ratio. Slightly bias against wider columns. (Increases chance a
+	// narrow column will fit in its cell.) If available columns is positive
+	// and greater than the width of the unshrinkable column headers.
+	if wrapped && columns > 0 && columns >= totalHeaderWidth {
+		// While there is still excess width...
+		for widthTotal > columns {
+			maxRatio, worstCol := 0.0, -1
+
+			// Find column that has the highest ratio of its maximum width
+			// compared to its average width.  This tells us which column
+			// will produce the fewest wrapped values if shortened.
+			for i := 0; i < ncols; i++ {
+				if widthAverage[i] != 0 && widthWrap[i] > widthHeader[i] {
+					// Penalize wide columns by 1% of their width
+					ratio := float64(widthWrap[i])/float64(widthAverage[i]) + float64(maxWidth[i])*0.01
+					if ratio > maxRatio {
+						maxRatio, worstCol = ratio, i
+					}
+				}
+			}
+
+			// Exit loop if we can't squeeze any more.
+			if worstCol == -1 {
+				break
+			}
+
+			// Decrease width of target column by one.
+			widthWrap[worstCol]--
+			widthTotal--
+		}
+	}
+
+	// If in expanded auto mode, we have now calculated the expected width,
+	// so we can now escape to vertical mode if necessary. If the output has
+	// only one column, the expanded format would be wider than the regular
+	// format, so don't use it in that case.
+	if auto && columns > 0 && ncols > 1 && (columns < totalHeaderWidth || columns < widthTotal) {
+		return printAlignedVertical(w, t, wrapped, columns)
+	}
+
+	bw := bufio.NewWriter(w)
+
+	// print title
+	if t.Title != "" {
+		var titleWidth int
+		for _, l := range strings.Split(t.Title, "\n") {
+			if n := displayWidth(l); n > titleWidth {
+				titleWidth = n
+			}
+		}
+		if titleWidth >= widthTotal {
+			// aligned
+			fmt.Fprintf(bw, "%s\n", t.Title)
+		} else {
+			// centered
+			fmt.Fprintf(bw, "%s%s\n", spaces((widthTotal-titleWidth)/2), t.Title)
+		}
+	}
+
+	// print headers
+	if ncols > 0 {
+		for l := 0; l < maxLines(headers); l++ {
+			for i := 0; i < ncols; i++ {
+				bw.WriteString(" ")
+				more := l < len(headers[i])-1
+				if l < len(headers[i]) {
+					// centered
+					nbspace := widthWrap[i] - displayWidth(headers[i][l])
+					bw.WriteString(spaces(nbspace / 2))
+					bw.WriteString(headers[i][l])
+					bw.WriteString(spaces((nbspace + 1) / 2))
+				} else {
+					bw.WriteString(spaces(widthWrap[i]))
+				}
+				if more {
+					bw.WriteString("+")
+				} else {
+					bw.WriteString(" ")
+				}
+				if i < ncols-1 {
+					bw.WriteString("|")
+				}
+			}
+			bw.WriteString("\n")
+		}
+
+		// print separator
+		for i := 0; i < ncols; i++ {
+			bw.WriteString(strings.Repeat("-", widthWrap[i]+2))
+			if i < ncols-1 {
+				bw.WriteString("+")
+			}
+		}
+		bw.WriteString("\n")
+	}
+
+	// print cells
+	for _, row := range lines {
+		segs, n := make([][]segment, ncols), 0
+		for j := 0; j < ncols; j++ {
+			max := 0
+			if wrapped {
+				max = widthWrap[j]
+			}
+			segs[j] = segments(row[j], max)
+			if len(segs[j]) > n {
+				n = len(segs[j])
+			}
+		}
+		for l := 0; l < n; l++ {
+			for j := 0; j < ncols; j++ {
+				finalspaces := j < ncols-1
+
+				// print left-hand wrap or newline mark
+				if l > 0 && l <= len(segs[j]) && segs[j][l-1].mark == 'w' {
+					bw.WriteString(".")
+				} else {
+					bw.WriteString(" ")
+				}
+
+				var mark byte
+				if l >= len(segs[j]) {
+					// past newline lines so just pad for other columns
+					if finalspaces {
+						bw.WriteString(spaces(widthWrap[j]))
+					}
+				} else {
+					seg := segs[j][l]
+					mark = seg.mark
+					if t.align(j) == 'r' {
+						bw.WriteString(spaces(widthWrap[j] - seg.width))
+						bw.WriteString(seg.s)
+					} else {
+						bw.WriteString(seg.s)
+						if finalspaces || mark != 0 {
+							bw.WriteString(spaces(widthWrap[j] - seg.width))
+						}
+					}
+				}
+
+				// print right-hand wrap or newline mark
+				switch {
+				case mark == 'w':
+					bw.WriteString(".")
+				case mark == 'n':
+					bw.WriteString("+")
+				case finalspaces:
+					bw.WriteString(" ")
+				}
+
+				// print column divider, if not the last column
+				if j < ncols-1 {
+					bw.WriteString("|")
+				}
+			}
+			bw.WriteString("\n")
+		}
+	}
+
+	// print footers
+	for _, f := range t.footers() {
+		fmt.Fprintf(bw, "%s\n", f)
+	}
+	bw.WriteString("\n")
+
+	return bw.Flush()
+}
+
+// columnAlign returns the alignment for a column of the database type typ,
+// similar to psql's column_type_alignment.
+func columnAlign(typ string) byte {
+	switch strings.ToLower(typ) {
+	case "int2", "int4", "int8", "float4", "float8", "numeric", "oid", "xid", "xid8", "cid", "money":
+		return 'r'
+	}
+	return 'l'
+}
+
+// translate translates s, which is not a format string.
+func translate(s string) string {
+	var v []interface{}
+	return Gettext(strings.Replace(s, "%", "%%", -1), v...)
+}
+
+// formatLines splits s into its display lines, formatting tabs and control
+// characters in the same manner as psql's pg_wcsformat.
+func formatLines(s string) []string {
+	var lines []string
+	var b strings.Builder
+	var width int
+	for _, r := range s {
+		switch {
+		case r == '\n':
+			lines = append(lines, b.String())
+			b.Reset()
+			width = 0
+		case r == '\t':
+			// display as 1-8 spaces
+			n := 8 - width%8
+			b.WriteString(spaces(n))
+			width += n
+		case r == '\r':
+			b.WriteString(`\r`)
+			width += 2
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&b, `\x%02X`, r)
+			width += 4
+		default:
+			b.WriteRune(r)
+			width += runeWidth(r)
+		}
+	}
+	return append(lines, b.String())
+}
+
+// maxLines returns the maximum number of lines in v.
+func maxLines(v [][]string) int {
+	var n int
+	for _, l := range v {
+		if len(l) > n {
+			n = len(l)
+		}
+	}
+	return n
+}
+
+// spaces returns a string of n spaces.
+func spaces(n int) string {
+	if n <= 0 {
+		return ""
+	}
+	return strings.Repeat(" ", n)
+}
+
+// displayWidth returns the display width of s.
+func displayWidth(s string) int {
+	var n int
+	for _, r := range s {
+		n += runeWidth(r)
+	}
+	return n
+}
+
+// runeWidth returns the display width of r, similar to psql's ucs_wcwidth.
+func runeWidth(r rune) int {
+	switch {
+	case r < 0x20 || (r >= 0x7f && r < 0xa0):
+		return 0
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	case r >= 0x1100 &&
+		(r <= 0x115f || // Hangul Jamo init. consonants
+			(r >= 0x2e80 && r <= 0xa4cf && r != 0x303f) || // CJK ... Yi
+			(r >= 0xac00 && r <= 0xd7a3) || // Hangul Syllables
+			(r >= 0xf900 && r <= 0xfaff) || // CJK Compatibility Ideographs
+			(r >= 0xfe30 && r <= 0xfe6f) || // CJK Compatibility Forms
+			(r >= 0xff00 && r <= 0xff5f) || // Fullwidth Forms
+			(r >= 0xffe0 && r <= 0xffe6) ||
+			(r >= 0x20000 && r <= 0x2fffd) ||
+			(r >= 0x30000 && r <= 0x3fffd)):
+		return 2
+	}
+	return 1
+}
@@ -0,0 +1,179 @@
+package pgdesc
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// printTables are the tables printed by the printer tests, by name.
+var printTables = map[string]*Table{
+	"objects": {
+		Title:   "List of objects",
+		Headers: []string{"Schema", "Name", "Size", "Description"},
+		Aligns:  []byte{'l', 'l', 'r', 'l'},
+		Rows: [][]string{
+			{"public", "foo", "8192", "a table"},
+			{"public", "bar_baz", "16384", "two\nlines"},
+			{"pg_catalog", "qux", "0", ""},
+		},
+		DefaultFooter: true,
+	},
+	"one": {
+		Title:         "One row",
+		Headers:       []string{"a", "b"},
+		Aligns:        []byte{'r', 'l'},
+		Rows:          [][]string{{"1", "x"}},
+		DefaultFooter: true,
+	},
+	"empty": {
+		Title:         "No rows",
+		Headers:       []string{"Name", "Owner"},
+		DefaultFooter: true,
+	},
+	"title": {
+		Title:   "A title that is much wider than the table",
+		Headers: []string{"a", "b"},
+		Rows:    [][]string{{"1", "2"}},
+	},
+	"headers": {
+		Headers: []string{"first\nheader", "b", "last\nheader\nlines"},
+		Rows: [][]string{
+			{"x", "y", "z"},
+			{"one\ntwo\nthree", "", "four"},
+		},
+		DefaultFooter: true,
+	},
+	"footers": {
+		Title:   "Table \"public.foo\"",
+		Headers: []string{"Column", "Type", "Collation", "Nullable", "Default"},
+		Rows: [][]string{
+			{"id", "integer", "", "not null", "nextval('foo_id_seq'::regclass)"},
+			{"name", "text", "C", "", ""},
+		},
+		Footers: []string{
+			"Indexes:",
+			"    \"foo_pkey\" PRIMARY KEY, btree (id)",
+			"Number of child tables: 2 (Use \\d+ to list them.)",
+		},
+		DefaultFooter: true,
+	},
+	"wrap": {
+		Title:   "Wrapped",
+		Headers: []string{"id", "text", "n"},
+		Aligns:  []byte{'r', 'l', 'r'},
+		Rows: [][]string{
+			{"1", "the quick brown fox jumps over the lazy dog", "123456789"},
+			{"2", "short", "1"},
+			{"10", "line one\nline two is a bit longer", "22"},
+		},
+		DefaultFooter: true,
+	},
+	"wide": {
+		Headers: []string{"name", "value"},
+		Rows: [][]string{
+			{"alpha", "a value that does not fit in the output width"},
+			{"b", "short"},
+		},
+		DefaultFooter: true,
+	},
+	"unicode": {
+		Title:   "Unicode",
+		Headers: []string{"名前", "tab", "ctl"},
+		Rows: [][]string{
+			{"日本語", "a\tb", "x\ry"},
+			{"é", "\t", "\x01"},
+		},
+		DefaultFooter: true,
+	},
+	"single": {
+		Headers:       []string{"a single column that is wide"},
+		Rows:          [][]string{{"x"}},
+		DefaultFooter: true,
+	},
+	"noexpanded": {
+		Title:      "Sequence \"public.foo_id_seq\"",
+		Headers:    []string{"Type", "Start", "Minimum", "Maximum", "Increment", "Cycles?", "Cache"},
+		Aligns:     []byte{'l', 'r', 'r', 'r', 'r', 'l', 'r'},
+		Rows:       [][]string{{"integer", "1", "1", "2147483647", "1", "no", "1"}},
+		Footers:    []string{"Owned by: public.foo.id"},
+		NoExpanded: true,
+	},
+	"escapes": {
+		Title:   "Escapes & <specials>",
+		Headers: []string{"text", "n", "a|b,c"},
+		Aligns:  []byte{'l', 'r', 'l'},
+		Rows: [][]string{
+			{`a "quoted", value`, "1", "a|b"},
+			{"  leading space", "2", "line\nbreak"},
+			{" \t", "3", ""},
+			{`\.`, "4", `back\slash`},
+			{"#$%&_{}~^", "5", "<tag>"},
+		},
+		DefaultFooter: true,
+	},
+}
+
+// printTest is a printer golden test.
+type printTest struct {
+	name  string
+	table string
+	opts  PrintOptions
+}
+
+// testPrint prints the table of each test with its options, and compares
+// the output with the golden file testdata/print/<name>.out. Run with
+// -update to refresh the golden files.
+func testPrint(t *testing.T, tests []printTest) {
+	t.Helper()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tbl, ok := printTables[test.table]
+			if !ok {
+				t.Fatalf("unknown table %q", test.table)
+			}
+			buf := new(bytes.Buffer)
+			if err := test.opts.Print(buf, tbl); err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			name := filepath.Join("testdata", "print", test.name+".out")
+			if *update {
+				if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(name, buf.Bytes(), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			exp, err := os.ReadFile(name)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v (run with -update to create)", err)
+			}
+			if !bytes.Equal(buf.Bytes(), exp) {
+				t.Errorf("output does not match %s (run with -update to refresh)\n%s", name, diffLine(exp, buf.Bytes()))
+			}
+		})
+	}
+}
+
+func TestPrintAligned(t *testing.T) {
+	testPrint(t, []printTest{
+		{"aligned_objects", "objects", PrintOptions{}},
+		{"aligned_one", "one", PrintOptions{}},
+		{"aligned_empty", "empty", PrintOptions{}},
+		{"aligned_title", "title", PrintOptions{}},
+		{"aligned_headers", "headers", PrintOptions{}},
+		{"aligned_footers", "footers", PrintOptions{}},
+		{"aligned_unicode", "unicode", PrintOptions{}},
+		{"aligned_wrap", "wrap", PrintOptions{}},
+		// the aligned format ignores the output width
+		{"aligned_wrap_columns", "wrap", PrintOptions{Columns: 30}},
+		{"wrapped_wrap_30", "wrap", PrintOptions{Format: FormatWrapped, Columns: 30}},
+		{"wrapped_wrap_20", "wrap", PrintOptions{Format: FormatWrapped, Columns: 20}},
+		// too narrow for the headers, so nothing is wrapped
+		{"wrapped_wrap_5", "wrap", PrintOptions{Format: FormatWrapped, Columns: 5}},
+		{"wrapped_objects_30", "objects", PrintOptions{Format: FormatWrapped, Columns: 30}},
+	})
+}
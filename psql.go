@@ -0,0 +1,80 @@
+package pgdesc
+
+import (
+	"io"
+)
+
+// printTableOpt is the translation of psql's printTableOpt, used by the
+// generated code.
+type printTableOpt struct {
+	defaultFooter bool
+}
+
+// printQueryOpt is the translation of psql's printQueryOpt, used by the
+// generated code.
+type printQueryOpt struct {
+	nullPrint         string
+	title             string
+	footers           []string
+	translateHeader   bool
+	translateColumns  []bool
+	nTranslateColumns int
+	topt              printTableOpt
+}
+
+// popt returns the default print options, similar to psql's pset.popt.
+func (d *PgDesc) popt() printQueryOpt {
+	return printQueryOpt{
+		topt: printTableOpt{
+			defaultFooter: true,
+		},
+	}
+}
+
+// psqlBuffer is the query buffer passed to generated funcs when executing
+// their queries and printing their results, as psql does.
+type psqlBuffer struct {
+	QueryBuffer
+
+	// out is where results are printed.
+	out io.Writer
+}
+
+// psql calls the generated func f, executing its queries and printing the
+// results to w.
+func (d *PgDesc) psql(w io.Writer, f func(io.Writer) error) error {
+	return f(&psqlBuffer{out: w})
+}
+
+// psqlExec executes the query written to w, similar to psql's PSQLexec.
+//
+// When w is not a *psqlBuffer (ie, when only building the query), nil is
+// returned for both the result and error, ending the generated func.
+// Otherwise, the query buffer is reset after executing the query.
+func (d *PgDesc) psqlExec(w io.Writer) (*Result, error) {
+	buf, ok := w.(*psqlBuffer)
+	if !ok {
+		return nil, nil
+	}
+	return d.execBuffer(&buf.QueryBuffer)
+}
+
+// psqlPrintQuery prints res using opt, similar to psql's printQuery.
+func (d *PgDesc) psqlPrintQuery(w io.Writer, res *Result, opt *printQueryOpt) error {
+	buf, ok := w.(*psqlBuffer)
+	if !ok {
+		return nil
+	}
+	t := NewTable(res, opt.title, opt.translateColumns...)
+	t.Footers, t.DefaultFooter = opt.footers, opt.topt.defaultFooter
+	if opt.nullPrint != "" {
+		for i, row := range t.Rows {
+			for j := range row {
+				if res.IsNull(i, j) {
+					row[j] = opt.nullPrint
+				}
+			}
+		}
+	}
+	return d.print(buf.out, t)
+}
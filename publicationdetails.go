@@ -0,0 +1,247 @@
+package pgdesc
+
+import (
+	"context"
+	"fmt"
+)
+
+// PublicationDescription is the description of a single publication, as
+// displayed by \dRp+.
+type PublicationDescription struct {
+	// OID is the publication's oid.
+	OID string
+
+	// Name is the publication's name.
+	Name string
+
+	// Owner is the publication's owner.
+	Owner string
+
+	// AllTables is whether or not the publication is for all tables.
+	AllTables bool
+
+	// Inserts, Updates, Deletes and Truncates are whether or not the
+	// operations are published. Truncates is always false for servers
+	// before 11.
+	Inserts   bool
+	Updates   bool
+	Deletes   bool
+	Truncates bool
+
+	// ViaRoot is whether or not changes to partitions are published using
+	// the identity of the partitioned table. Always false for servers before
+	// 13.
+	ViaRoot bool
+
+	// Tables are the tables explicitly added to the publication. Not set for
+	// publications for all tables.
+	Tables []PublicationTable
+
+	// Schemas are the schemas whose tables are added to the publication.
+	// Only set for servers 15 and later.
+	Schemas []string
+
+	// Title is the title of the table, ie `Publication foo`.
+	Title string
+
+	// Headers are the table headers.
+	Headers []string
+
+	// Rows are the table cells.
+	Rows [][]string
+
+	// Footers are the table footers (tables and schemas).
+	Footers []string
+}
+
+// PublicationTable is a table of a publication, as displayed by \dRp+.
+type PublicationTable struct {
+	Schema string
+	Name   string
+	// Columns is the published column list, ie `a, b`, or empty when all
+	// columns are published. Only set for servers 15 and later.
+	Columns string
+	// RowFilter is the row filter expression, or empty when all rows are
+	// published. Only set for servers 15 and later.
+	RowFilter string
+}
+
+// Table returns the description as a table for printing.
+func (p *PublicationDescription) Table() *Table {
+	headers := make([]string, len(p.Headers))
+	for i, h := range p.Headers {
+		headers[i] = translate(h)
+	}
+	footers := p.Footers
+	if footers == nil {
+		footers = []string{}
+	}
+	return &Table{
+		Title:   p.Title,
+		Headers: headers,
+		Rows:    p.Rows,
+		Footers: footers,
+	}
+}
+
+// addFooter adds a footer.
+func (p *PublicationDescription) addFooter(s string) {
+	p.Footers = append(p.Footers, s)
+}
+
+// GetPublicationDetails executes \dRp+, describing each matching
+// publication's flags, tables and schemas.
+func (d *PgDesc) GetPublicationDetails(pattern string) ([]PublicationDescription, error) {
+	res, err := d.QueryPublicationDetails(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Len() == 0 {
+		if pattern != NULL {
+			return nil, fmt.Errorf("Did not find any publication named \"%s\".\n",
+				pattern)
+		}
+		return nil, fmt.Errorf("Did not find any publications.\n")
+	}
+
+	hasPubtruncate := d.version >= 110000
+	hasPubviaroot := d.version >= 130000
+	var v []PublicationDescription
+	for i := 0; i < res.Len(); i++ {
+		// stop before describing the next publication when cancelled
+		if err := d.context().Err(); err != nil {
+			return nil, err
+		}
+		p := PublicationDescription{
+			OID:       res.named(i, "oid"),
+			Name:      res.named(i, "pubname"),
+			Owner:     res.named(i, "owner"),
+			AllTables: res.named(i, "puballtables") == "t",
+			Inserts:   res.named(i, "pubinsert") == "t",
+			Updates:   res.named(i, "pubupdate") == "t",
+			Deletes:   res.named(i, "pubdelete") == "t",
+			Truncates: res.named(i, "pubtruncate") == "t",
+			ViaRoot:   res.named(i, "pubviaroot") == "t",
+			Title:     Gettext("Publication %s", res.named(i, "pubname")),
+			Headers: []string{
+				GettextNoop("Owner"),
+				GettextNoop("All tables"),
+				GettextNoop("Inserts"),
+				GettextNoop("Updates"),
+				GettextNoop("Deletes"),
+			},
+		}
+		row := []string{
+			res.named(i, "owner"),
+			res.named(i, "puballtables"),
+			res.named(i, "pubinsert"),
+			res.named(i, "pubupdate"),
+			res.named(i, "pubdelete"),
+		}
+		if hasPubtruncate {
+			p.Headers = append(p.Headers, GettextNoop("Truncates"))
+			row = append(row, res.named(i, "pubtruncate"))
+		}
+		if hasPubviaroot {
+			p.Headers = append(p.Headers, GettextNoop("Via root"))
+			row = append(row, res.named(i, "pubviaroot"))
+		}
+		p.Rows = [][]string{row}
+
+		if !p.AllTables {
+			if err := d.addPublicationTables(&p); err != nil {
+				return nil, err
+			}
+			if d.version >= 150000 {
+				if err := d.addPublicationSchemas(&p); err != nil {
+					return nil, err
+				}
+			}
+		}
+		v = append(v, p)
+	}
+	return v, nil
+}
+
+// GetPublicationDetailsContext is the same as GetPublicationDetails, but with a context.
+func (d *PgDesc) GetPublicationDetailsContext(ctx context.Context, pattern string) ([]PublicationDescription, error) {
+	return d.withContext(ctx).GetPublicationDetails(pattern)
+}
+
+// addPublicationTables adds the tables of the publication, and the "Tables:"
+// footer.
+func (d *PgDesc) addPublicationTables(p *PublicationDescription) error {
+	buf := new(QueryBuffer)
+	fmt.Fprint(buf, "SELECT n.nspname, c.relname")
+	if d.version >= 150000 {
+		fmt.Fprint(buf, ", pg_get_expr(pr.prqual, c.oid)")
+		fmt.Fprint(buf, ", (CASE WHEN pr.prattrs IS NOT NULL THEN\n"+
+			"     pg_catalog.array_to_string("+
+			"      ARRAY(SELECT attname\n"+
+			"              FROM\n"+
+			"                pg_catalog.generate_series(0, pg_catalog.array_upper(pr.prattrs::pg_catalog.int2[], 1)) s,\n"+
+			"                pg_catalog.pg_attribute\n"+
+			"        WHERE attrelid = c.oid AND attnum = prattrs[s]), ', ')\n"+
+			"       ELSE NULL END)")
+	} else {
+		fmt.Fprint(buf, ", NULL, NULL")
+	}
+	fmt.Fprintf(buf, "\nFROM pg_catalog.pg_class c,\n"+
+		"     pg_catalog.pg_namespace n,\n"+
+		"     pg_catalog.pg_publication_rel pr\n"+
+		"WHERE c.relnamespace = n.oid\n"+
+		"  AND c.oid = pr.prrelid\n"+
+		"  AND pr.prpubid = %s\n"+
+		"ORDER BY 1,2", literal(buf, p.OID))
+	res, err := d.execBuffer(buf)
+	if err != nil {
+		return err
+	}
+
+	if res.Len() > 0 {
+		p.addFooter(Gettext("Tables:"))
+	}
+	for i := 0; i < res.Len(); i++ {
+		t := PublicationTable{
+			Schema:    res.Value(i, 0),
+			Name:      res.Value(i, 1),
+			RowFilter: res.Value(i, 2),
+			Columns:   res.Value(i, 3),
+		}
+		p.Tables = append(p.Tables, t)
+		s := fmt.Sprintf("    \"%s.%s\"", t.Schema, t.Name)
+		if !res.IsNull(i, 3) {
+			s += fmt.Sprintf(" (%s)", t.Columns)
+		}
+		if !res.IsNull(i, 2) {
+			s += fmt.Sprintf(" WHERE %s", t.RowFilter)
+		}
+		p.addFooter(s)
+	}
+	return nil
+}
+
+// addPublicationSchemas adds the schemas of the publication, and the "Tables
+// from schemas:" footer.
+func (d *PgDesc) addPublicationSchemas(p *PublicationDescription) error {
+	buf := new(QueryBuffer)
+	fmt.Fprintf(buf, "SELECT n.nspname\n"+
+		"FROM pg_catalog.pg_namespace n\n"+
+		"     JOIN pg_catalog.pg_publication_namespace pn ON n.oid = pn.pnnspid\n"+
+		"WHERE pn.pnpubid = %s\n"+
+		"ORDER BY 1", literal(buf, p.OID))
+	res, err := d.execBuffer(buf)
+	if err != nil {
+		return err
+	}
+
+	if res.Len() > 0 {
+		p.addFooter(Gettext("Tables from schemas:"))
+	}
+	for i := 0; i < res.Len(); i++ {
+		p.Schemas = append(p.Schemas, res.Value(i, 0))
+		p.addFooter(fmt.Sprintf("    \"%s\"", res.Value(i, 0)))
+	}
+	return nil
+}
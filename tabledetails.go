@@ -0,0 +1,1827 @@
+package pgdesc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// TableDescription is the description of a single relation, as displayed by
+// \d.
+type TableDescription struct {
+	// OID is the relation's oid.
+	OID string
+
+	// Schema is the relation's schema.
+	Schema string
+
+	// Name is the relation's name.
+	Name string
+
+	// Kind is the relation's relkind.
+	Kind RelKind
+
+	// Title is the title of the table, ie `Table "public.foo"`.
+	Title string
+
+	// Headers are the table headers.
+	Headers []string
+
+	// Aligns are the column alignments.
+	Aligns []byte
+
+	// Rows are the table cells.
+	Rows [][]string
+
+	// Footers are the table footers (indexes, constraints, triggers, etc).
+	Footers []string
+
+	// Columns are the relation's columns. Not set for sequences.
+	Columns []TableColumn
+}
+
+// TableColumn is a column of a relation, as displayed by \d.
+type TableColumn struct {
+	Name            string
+	Type            string
+	Collation       string
+	NotNull         bool
+	Default         string
+	Identity        string
+	Generated       string
+	IndexKey        string
+	IndexDefinition string
+	FDWOptions      string
+	Storage         string
+	Compression     string
+	StatsTarget     string
+	Description     string
+}
+
+// Table returns the description as a table for printing.
+func (t *TableDescription) Table() *Table {
+	headers := make([]string, len(t.Headers))
+	for i, h := range t.Headers {
+		headers[i] = translate(h)
+	}
+	footers := t.Footers
+	if footers == nil {
+		footers = []string{}
+	}
+	return &Table{
+		Title:   t.Title,
+		Headers: headers,
+		Aligns:  t.Aligns,
+		Rows:    t.Rows,
+		Footers: footers,
+		// this output looks confusing in expanded mode
+		NoExpanded: true,
+	}
+}
+
+// addFooter adds a footer.
+func (t *TableDescription) addFooter(s string) {
+	t.Footers = append(t.Footers, s)
+}
+
+// GetTableDetails executes \d, describing each matching relation's columns,
+// indexes, constraints, and other details.
+func (d *PgDesc) GetTableDetails(pattern string, verbose, showSystem bool) ([]TableDescription, error) {
+	res, err := d.QueryTableDetails(pattern, verbose, showSystem)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Len() == 0 {
+		if pattern != NULL {
+			return nil, fmt.Errorf("Did not find any relation named \"%s\".\n",
+				pattern)
+		}
+		return nil, fmt.Errorf("Did not find any relations.\n")
+	}
+
+	var v []TableDescription
+	for i := 0; i < res.Len(); i++ {
+		// stop before describing the next relation when cancelled
+		if err := d.context().Err(); err != nil {
+			return nil, err
+		}
+		oid := res.Value(i, 0)
+		nspname := res.Value(i, 1)
+		relname := res.Value(i, 2)
+
+		t, err := d.describeOneTableDetails(nspname, relname, oid, verbose)
+		if err != nil {
+			return nil, err
+		}
+		v = append(v, *t)
+	}
+	return v, nil
+}
+
+// GetTableDetailsContext is the same as GetTableDetails, but with a context.
+func (d *PgDesc) GetTableDetailsContext(ctx context.Context, pattern string, verbose, showSystem bool) ([]TableDescription, error) {
+	return d.withContext(ctx).GetTableDetails(pattern, verbose, showSystem)
+}
+
+// tableInfo holds general information about a relation.
+type tableInfo struct {
+	checks           int
+	relkind          RelKind
+	hasindex         bool
+	hasrules         bool
+	hastriggers      bool
+	rowsecurity      bool
+	forcerowsecurity bool
+	hasoids          bool
+	tablespace       string
+	reloptions       string
+	reloftype        string
+	relpersistence   RelPersistence
+	relreplident     byte
+	relam            string
+	ispartition      bool
+}
+
+// describeOneTableDetails is manually translated func from the postgres
+// source.
+//
+// It describes a single relation.
+//
+// See: postgres/src/bin/psql/describe.c
+//
+// describeOneTableDetails (for \d)
+//
+// Unfortunately, the information presented here is so complicated that it
+// cannot be done in a single query. So we have to assemble the printed table
+// by hand and pass it to the underlying printTable() function.
+func (d *PgDesc) describeOneTableDetails(schemaname, relationname, oid string, verbose bool) (*TableDescription, error) {
+	buf := new(QueryBuffer)
+
+	// Get general table info
+	if d.version >= 120000 {
+		fmt.Fprintf(buf, "SELECT c.relchecks, c.relkind, c.relhasindex, c.relhasrules, "+
+			"c.relhastriggers, c.relrowsecurity, c.relforcerowsecurity, "+
+			"false AS relhasoids, %s, c.reltablespace, "+
+			"CASE WHEN c.reloftype = 0 THEN '' ELSE c.reloftype::pg_catalog.regtype::pg_catalog.text END, "+
+			"c.relpersistence, c.relreplident, am.amname, c.relispartition\n"+
+			"FROM pg_catalog.pg_class c\n "+
+			"LEFT JOIN pg_catalog.pg_class tc ON (c.reltoastrelid = tc.oid)\n"+
+			"LEFT JOIN pg_catalog.pg_am am ON (c.relam = am.oid)\n"+
+			"WHERE c.oid = %s;",
+			toastOptions(verbose), literal(buf, oid))
+	} else if d.version >= 90500 {
+		fmt.Fprintf(buf, "SELECT c.relchecks, c.relkind, c.relhasindex, c.relhasrules, "+
+			"c.relhastriggers, c.relrowsecurity, c.relforcerowsecurity, "+
+			"c.relhasoids, %s, c.reltablespace, "+
+			"CASE WHEN c.reloftype = 0 THEN '' ELSE c.reloftype::pg_catalog.regtype::pg_catalog.text END, "+
+			"c.relpersistence, c.relreplident\n"+
+			"FROM pg_catalog.pg_class c\n "+
+			"LEFT JOIN pg_catalog.pg_class tc ON (c.reltoastrelid = tc.oid)\n"+
+			"WHERE c.oid = %s;",
+			toastOptions(verbose), literal(buf, oid))
+	} else if d.version >= 90400 {
+		fmt.Fprintf(buf, "SELECT c.relchecks, c.relkind, c.relhasindex, c.relhasrules, "+
+			"c.relhastriggers, false, false, c.relhasoids, "+
+			"%s, c.reltablespace, "+
+			"CASE WHEN c.reloftype = 0 THEN '' ELSE c.reloftype::pg_catalog.regtype::pg_catalog.text END, "+
+			"c.relpersistence, c.relreplident\n"+
+			"FROM pg_catalog.pg_class c\n "+
+			"LEFT JOIN pg_catalog.pg_class tc ON (c.reltoastrelid = tc.oid)\n"+
+			"WHERE c.oid = %s;",
+			toastOptions(verbose), literal(buf, oid))
+	} else if d.version >= 90100 {
+		fmt.Fprintf(buf, "SELECT c.relchecks, c.relkind, c.relhasindex, c.relhasrules, "+
+			"c.relhastriggers, false, false, c.relhasoids, "+
+			"%s, c.reltablespace, "+
+			"CASE WHEN c.reloftype = 0 THEN '' ELSE c.reloftype::pg_catalog.regtype::pg_catalog.text END, "+
+			"c.relpersistence\n"+
+			"FROM pg_catalog.pg_class c\n "+
+			"LEFT JOIN pg_catalog.pg_class tc ON (c.reltoastrelid = tc.oid)\n"+
+			"WHERE c.oid = %s;",
+			toastOptions(verbose), literal(buf, oid))
+	} else if d.version >= 90000 {
+		fmt.Fprintf(buf, "SELECT c.relchecks, c.relkind, c.relhasindex, c.relhasrules, "+
+			"c.relhastriggers, false, false, c.relhasoids, "+
+			"%s, c.reltablespace, "+
+			"CASE WHEN c.reloftype = 0 THEN '' ELSE c.reloftype::pg_catalog.regtype::pg_catalog.text END\n"+
+			"FROM pg_catalog.pg_class c\n "+
+			"LEFT JOIN pg_catalog.pg_class tc ON (c.reltoastrelid = tc.oid)\n"+
+			"WHERE c.oid = %s;",
+			toastOptions(verbose), literal(buf, oid))
+	} else if d.version >= 80400 {
+		fmt.Fprintf(buf, "SELECT c.relchecks, c.relkind, c.relhasindex, c.relhasrules, "+
+			"c.relhastriggers, false, false, c.relhasoids, "+
+			"%s, c.reltablespace\n"+
+			"FROM pg_catalog.pg_class c\n "+
+			"LEFT JOIN pg_catalog.pg_class tc ON (c.reltoastrelid = tc.oid)\n"+
+			"WHERE c.oid = %s;",
+			toastOptions(verbose), literal(buf, oid))
+	} else if d.version >= 80200 {
+		reloptions := "''"
+		if verbose {
+			reloptions = "pg_catalog.array_to_string(reloptions, E', ')"
+		}
+		fmt.Fprintf(buf, "SELECT relchecks, relkind, relhasindex, relhasrules, "+
+			"reltriggers <> 0, false, false, relhasoids, "+
+			"%s, reltablespace\n"+
+			"FROM pg_catalog.pg_class WHERE oid = %s;",
+			reloptions, literal(buf, oid))
+	} else if d.version >= 80000 {
+		fmt.Fprintf(buf, "SELECT relchecks, relkind, relhasindex, relhasrules, "+
+			"reltriggers <> 0, false, false, relhasoids, "+
+			"'', reltablespace\n"+
+			"FROM pg_catalog.pg_class WHERE oid = %s;",
+			literal(buf, oid))
+	} else {
+		fmt.Fprintf(buf, "SELECT relchecks, relkind, relhasindex, relhasrules, "+
+			"reltriggers <> 0, false, false, relhasoids, "+
+			"'', ''\n"+
+			"FROM pg_catalog.pg_class WHERE oid = %s;",
+			literal(buf, oid))
+	}
+
+	res, err := d.execBuffer(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	// Did we get anything?
+	if res.Len() == 0 {
+		return nil, fmt.Errorf("Did not find any relation with OID %s.\n", oid)
+	}
+
+	var tableinfo tableInfo
+	tableinfo.checks, _ = strconv.Atoi(res.Value(0, 0))
+	tableinfo.relkind = RelKind(firstByte(res.Value(0, 1)))
+	tableinfo.hasindex = res.Value(0, 2) == "t"
+	tableinfo.hasrules = res.Value(0, 3) == "t"
+	tableinfo.hastriggers = res.Value(0, 4) == "t"
+	tableinfo.rowsecurity = res.Value(0, 5) == "t"
+	tableinfo.forcerowsecurity = res.Value(0, 6) == "t"
+	tableinfo.hasoids = res.Value(0, 7) == "t"
+	if d.version >= 80200 {
+		tableinfo.reloptions = res.Value(0, 8)
+	}
+	if d.version >= 80000 {
+		tableinfo.tablespace = res.Value(0, 9)
+	}
+	if d.version >= 90000 {
+		tableinfo.reloftype = res.Value(0, 10)
+	}
+	if d.version >= 90100 {
+		tableinfo.relpersistence = RelPersistence(firstByte(res.Value(0, 11)))
+	}
+	tableinfo.relreplident = 'd'
+	if d.version >= 90400 {
+		tableinfo.relreplident = firstByte(res.Value(0, 12))
+	}
+	if d.version >= 120000 {
+		tableinfo.relam = res.Value(0, 13)
+		tableinfo.ispartition = res.Value(0, 14) == "t"
+	}
+
+	t := &TableDescription{
+		OID:    oid,
+		Schema: schemaname,
+		Name:   relationname,
+		Kind:   tableinfo.relkind,
+	}
+
+	// If it's a sequence, deal with it here separately.
+	if tableinfo.relkind == RELKIND_SEQUENCE {
+		if err := d.describeSequence(t, buf); err != nil {
+			return nil, err
+		}
+		return t, nil
+	}
+
+	// Get per-column info
+	//
+	// Since the set of query columns we need varies depending on relkind and
+	// server version, we compute all the column numbers on-the-fly.  Column
+	// number variables for columns not fetched are left as -1; this avoids
+	// duplicative test logic below.
+	relkind := tableinfo.relkind
+	showColumnDetails := relkind == RELKIND_RELATION || relkind == RELKIND_VIEW || relkind == RELKIND_MATVIEW ||
+		relkind == RELKIND_FOREIGN_TABLE || relkind == RELKIND_COMPOSITE_TYPE || relkind == RELKIND_PARTITIONED_TABLE
+
+	attnameCol, atttypeCol, attrdefCol, attnotnullCol := -1, -1, -1, -1
+	attcollCol, attidentityCol, attgeneratedCol, isindexkeyCol := -1, -1, -1, -1
+	indexdefCol, fdwoptsCol, attstorageCol, attcompressionCol := -1, -1, -1, -1
+	attstattargetCol, attdescrCol := -1, -1
+
+	cols := 0
+	buf.Reset()
+	fmt.Fprint(buf, "SELECT a.attname")
+	attnameCol, cols = cols, cols+1
+	fmt.Fprint(buf, ",\n  pg_catalog.format_type(a.atttypid, a.atttypmod)")
+	atttypeCol, cols = cols, cols+1
+
+	if showColumnDetails {
+		fmt.Fprint(buf, ",\n  (SELECT substring(pg_catalog.pg_get_expr(d.adbin, d.adrelid) for 128)"+
+			"\n   FROM pg_catalog.pg_attrdef d"+
+			"\n   WHERE d.adrelid = a.attrelid AND d.adnum = a.attnum AND a.atthasdef)"+
+			",\n  a.attnotnull")
+		attrdefCol, cols = cols, cols+1
+		attnotnullCol, cols = cols, cols+1
+		if d.version >= 90100 {
+			fmt.Fprint(buf, ",\n  (SELECT c.collname FROM pg_catalog.pg_collation c, pg_catalog.pg_type t\n"+
+				"   WHERE c.oid = a.attcollation AND t.oid = a.atttypid AND a.attcollation <> t.typcollation) AS attcollation")
+		} else {
+			fmt.Fprint(buf, ",\n  NULL AS attcollation")
+		}
+		attcollCol, cols = cols, cols+1
+		if d.version >= 100000 {
+			fmt.Fprint(buf, ",\n  a.attidentity")
+		} else {
+			fmt.Fprint(buf, ",\n  ''::pg_catalog.char AS attidentity")
+		}
+		attidentityCol, cols = cols, cols+1
+		if d.version >= 120000 {
+			fmt.Fprint(buf, ",\n  a.attgenerated")
+		} else {
+			fmt.Fprint(buf, ",\n  ''::pg_catalog.char AS attgenerated")
+		}
+		attgeneratedCol, cols = cols, cols+1
+	}
+	if relkind == RELKIND_INDEX || relkind == RELKIND_PARTITIONED_INDEX {
+		if d.version >= 110000 {
+			fmt.Fprintf(buf, ",\n  CASE WHEN a.attnum <= (SELECT i.indnkeyatts FROM pg_catalog.pg_index i WHERE i.indexrelid = %s) THEN '%s' ELSE '%s' END AS is_key",
+				literal(buf, oid),
+				GettextNoop("yes"),
+				GettextNoop("no"))
+			isindexkeyCol, cols = cols, cols+1
+		}
+		fmt.Fprint(buf, ",\n  pg_catalog.pg_get_indexdef(a.attrelid, a.attnum, TRUE) AS indexdef")
+		indexdefCol, cols = cols, cols+1
+	}
+	// FDW options for foreign table column, only for 9.2 or later
+	if relkind == RELKIND_FOREIGN_TABLE && d.version >= 90200 {
+		fmt.Fprint(buf, ",\n  CASE WHEN attfdwoptions IS NULL THEN '' ELSE "+
+			"  '(' || pg_catalog.array_to_string(ARRAY(SELECT pg_catalog.quote_ident(option_name) || ' ' || pg_catalog.quote_literal(option_value)  FROM "+
+			"  pg_catalog.pg_options_to_table(attfdwoptions)), ', ') || ')' END AS attfdwoptions")
+		fdwoptsCol, cols = cols, cols+1
+	}
+	if verbose {
+		fmt.Fprint(buf, ",\n  a.attstorage")
+		attstorageCol, cols = cols, cols+1
+
+		// compression info, if relevant to relkind
+		if d.version >= 140000 &&
+			(relkind == RELKIND_RELATION || relkind == RELKIND_PARTITIONED_TABLE || relkind == RELKIND_MATVIEW) {
+			fmt.Fprint(buf, ",\n  a.attcompression AS attcompression")
+			attcompressionCol, cols = cols, cols+1
+		}
+
+		// stats target, if relevant to relkind
+		if relkind == RELKIND_RELATION || relkind == RELKIND_INDEX || relkind == RELKIND_PARTITIONED_INDEX ||
+			relkind == RELKIND_MATVIEW || relkind == RELKIND_FOREIGN_TABLE || relkind == RELKIND_PARTITIONED_TABLE {
+			fmt.Fprint(buf, ",\n  CASE WHEN a.attstattarget=-1 THEN NULL ELSE a.attstattarget END AS attstattarget")
+			attstattargetCol, cols = cols, cols+1
+		}
+
+		// In 9.0+, we have column comments for: relations, views, composite
+		// types, and foreign tables (cf. CommentObject() in comment.c).
+		if relkind == RELKIND_RELATION || relkind == RELKIND_VIEW || relkind == RELKIND_MATVIEW ||
+			relkind == RELKIND_FOREIGN_TABLE || relkind == RELKIND_COMPOSITE_TYPE || relkind == RELKIND_PARTITIONED_TABLE {
+			fmt.Fprint(buf, ",\n  pg_catalog.col_description(a.attrelid, a.attnum)")
+			attdescrCol, cols = cols, cols+1
+		}
+	}
+
+	fmt.Fprint(buf, "\nFROM pg_catalog.pg_attribute a")
+	fmt.Fprintf(buf, "\nWHERE a.attrelid = %s AND a.attnum > 0 AND NOT a.attisdropped", literal(buf, oid))
+	fmt.Fprint(buf, "\nORDER BY a.attnum;")
+
+	res, err = d.execBuffer(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make title
+	switch relkind {
+	case RELKIND_RELATION:
+		if tableinfo.relpersistence == RELPERSISTENCE_UNLOGGED {
+			t.Title = Gettext("Unlogged table \"%s.%s\"", schemaname, relationname)
+		} else {
+			t.Title = Gettext("Table \"%s.%s\"", schemaname, relationname)
+		}
+	case RELKIND_VIEW:
+		t.Title = Gettext("View \"%s.%s\"", schemaname, relationname)
+	case RELKIND_MATVIEW:
+		if tableinfo.relpersistence == RELPERSISTENCE_UNLOGGED {
+			t.Title = Gettext("Unlogged materialized view \"%s.%s\"", schemaname, relationname)
+		} else {
+			t.Title = Gettext("Materialized view \"%s.%s\"", schemaname, relationname)
+		}
+	case RELKIND_INDEX:
+		if tableinfo.relpersistence == RELPERSISTENCE_UNLOGGED {
+			t.Title = Gettext("Unlogged index \"%s.%s\"", schemaname, relationname)
+		} else {
+			t.Title = Gettext("Index \"%s.%s\"", schemaname, relationname)
+		}
+	case RELKIND_PARTITIONED_INDEX:
+		if tableinfo.relpersistence == RELPERSISTENCE_UNLOGGED {
+			t.Title = Gettext("Unlogged partitioned index \"%s.%s\"", schemaname, relationname)
+		} else {
+			t.Title = Gettext("Partitioned index \"%s.%s\"", schemaname, relationname)
+		}
+	case 's':
+		// not used as of 8.2, but keep it for backwards compatibility
+		t.Title = Gettext("Special relation \"%s.%s\"", schemaname, relationname)
+	case RELKIND_TOASTVALUE:
+		t.Title = Gettext("TOAST table \"%s.%s\"", schemaname, relationname)
+	case RELKIND_COMPOSITE_TYPE:
+		t.Title = Gettext("Composite type \"%s.%s\"", schemaname, relationname)
+	case RELKIND_FOREIGN_TABLE:
+		t.Title = Gettext("Foreign table \"%s.%s\"", schemaname, relationname)
+	case RELKIND_PARTITIONED_TABLE:
+		if tableinfo.relpersistence == RELPERSISTENCE_UNLOGGED {
+			t.Title = Gettext("Unlogged partitioned table \"%s.%s\"", schemaname, relationname)
+		} else {
+			t.Title = Gettext("Partitioned table \"%s.%s\"", schemaname, relationname)
+		}
+	default:
+		// untranslated unknown relkind
+		t.Title = fmt.Sprintf("?%c? \"%s.%s\"", relkind, schemaname, relationname)
+	}
+
+	t.Headers = append(t.Headers, GettextNoop("Column"), GettextNoop("Type"))
+	if showColumnDetails {
+		t.Headers = append(t.Headers, GettextNoop("Collation"), GettextNoop("Nullable"), GettextNoop("Default"))
+	}
+	if isindexkeyCol >= 0 {
+		t.Headers = append(t.Headers, GettextNoop("Key?"))
+	}
+	if indexdefCol >= 0 {
+		t.Headers = append(t.Headers, GettextNoop("Definition"))
+	}
+	if fdwoptsCol >= 0 {
+		t.Headers = append(t.Headers, GettextNoop("FDW options"))
+	}
+	if attstorageCol >= 0 {
+		t.Headers = append(t.Headers, GettextNoop("Storage"))
+	}
+	if attcompressionCol >= 0 {
+		t.Headers = append(t.Headers, GettextNoop("Compression"))
+	}
+	if attstattargetCol >= 0 {
+		t.Headers = append(t.Headers, GettextNoop("Stats target"))
+	}
+	if attdescrCol >= 0 {
+		t.Headers = append(t.Headers, GettextNoop("Description"))
+	}
+
+	// Generate table cells to be printed
+	for i := 0; i < res.Len(); i++ {
+		col := TableColumn{
+			Name: res.Value(i, attnameCol),
+			Type: res.Value(i, atttypeCol),
+		}
+		row := []string{col.Name, col.Type}
+
+		// Collation, Nullable, Default
+		if showColumnDetails {
+			col.Collation = res.Value(i, attcollCol)
+			col.NotNull = res.Value(i, attnotnullCol) == "t"
+			col.Identity = res.Value(i, attidentityCol)
+			col.Generated = res.Value(i, attgeneratedCol)
+
+			var nullable, defaultStr string
+			if col.NotNull {
+				nullable = "not null"
+			}
+			// (note: above we cut off the 'default' string at 128)
+			switch {
+			case col.Identity == string(ATTRIBUTE_IDENTITY_ALWAYS):
+				defaultStr = "generated always as identity"
+			case col.Identity == string(ATTRIBUTE_IDENTITY_BY_DEFAULT):
+				defaultStr = "generated by default as identity"
+			case col.Generated == string(ATTRIBUTE_GENERATED_STORED):
+				col.Default = res.Value(i, attrdefCol)
+				defaultStr = fmt.Sprintf("generated always as (%s) stored", col.Default)
+			default:
+				col.Default = res.Value(i, attrdefCol)
+				defaultStr = col.Default
+			}
+
+			row = append(row, col.Collation, nullable, defaultStr)
+		}
+
+		// Info for index columns
+		if isindexkeyCol >= 0 {
+			col.IndexKey = res.Value(i, isindexkeyCol)
+			row = append(row, col.IndexKey)
+		}
+		if indexdefCol >= 0 {
+			col.IndexDefinition = res.Value(i, indexdefCol)
+			row = append(row, col.IndexDefinition)
+		}
+
+		// FDW options for foreign table columns
+		if fdwoptsCol >= 0 {
+			col.FDWOptions = res.Value(i, fdwoptsCol)
+			row = append(row, col.FDWOptions)
+		}
+
+		// Storage and Description
+		if attstorageCol >= 0 {
+			// these strings are literal in our syntax, so not translated.
+			switch res.Value(i, attstorageCol) {
+			case "p":
+				col.Storage = "plain"
+			case "m":
+				col.Storage = "main"
+			case "x":
+				col.Storage = "extended"
+			case "e":
+				col.Storage = "external"
+			default:
+				col.Storage = "???"
+			}
+			row = append(row, col.Storage)
+		}
+
+		// Column compression, if relevant
+		if attcompressionCol >= 0 {
+			// these strings are literal in our syntax, so not translated.
+			switch res.Value(i, attcompressionCol) {
+			case "p":
+				col.Compression = "pglz"
+			case "l":
+				col.Compression = "lz4"
+			}
+			row = append(row, col.Compression)
+		}
+
+		// Statistics target, if the relkind supports this feature
+		if attstattargetCol >= 0 {
+			col.StatsTarget = res.Value(i, attstattargetCol)
+			row = append(row, col.StatsTarget)
+		}
+
+		// Column comments, if the relkind supports this feature
+		if attdescrCol >= 0 {
+			col.Description = res.Value(i, attdescrCol)
+			row = append(row, col.Description)
+		}
+
+		t.Columns = append(t.Columns, col)
+		t.Rows = append(t.Rows, row)
+	}
+
+	// Make footers
+	if d.version >= 100000 {
+		// Get the partition information
+		buf.Reset()
+		fmt.Fprint(buf, "SELECT inhparent::pg_catalog.regclass,\n"+
+			"  pg_catalog.pg_get_expr(c.relpartbound, inhrelid)")
+		if d.version >= 140000 {
+			fmt.Fprint(buf, ",\n  inhdetachpending")
+		} else {
+			fmt.Fprint(buf, ",\n  false AS inhdetachpending")
+		}
+		// If verbose, also request the partition constraint definition
+		if verbose {
+			fmt.Fprint(buf, ",\n  pg_catalog.pg_get_partition_constraintdef(inhrelid)")
+		}
+		fmt.Fprintf(buf, "\nFROM pg_catalog.pg_class c"+
+			" JOIN pg_catalog.pg_inherits i"+
+			" ON c.oid = inhrelid"+
+			"\nWHERE c.oid = %s AND c.relispartition;", literal(buf, oid))
+		result, err := d.execBuffer(buf)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Len() > 0 {
+			parentName := result.Value(0, 0)
+			partdef := result.Value(0, 1)
+			var detached string
+			if result.Value(0, 2) == "t" {
+				detached = " DETACH PENDING"
+			}
+
+			var partconstraintdef string
+			if len(result.Columns) == 4 {
+				partconstraintdef = result.Value(0, 3)
+			}
+
+			t.addFooter(Gettext("Partition of: %s %s%s", parentName, partdef, detached))
+
+			if verbose {
+				// If there isn't any constraint, show that explicitly
+				if partconstraintdef == "" {
+					t.addFooter(Gettext("No partition constraint"))
+				} else {
+					t.addFooter(Gettext("Partition constraint: %s", partconstraintdef))
+				}
+			}
+		}
+	}
+
+	if relkind == RELKIND_PARTITIONED_TABLE {
+		// Get the partition key information
+		buf.Reset()
+		fmt.Fprintf(buf, "SELECT pg_catalog.pg_get_partkeydef(%s::pg_catalog.oid);",
+			literal(buf, oid))
+		result, err := d.execBuffer(buf)
+		if err != nil {
+			return nil, err
+		}
+		if result.Len() != 1 {
+			return nil, fmt.Errorf("could not get partition key for relation with OID %s", oid)
+		}
+
+		t.addFooter(Gettext("Partition key: %s", result.Value(0, 0)))
+	}
+
+	if relkind == RELKIND_INDEX || relkind == RELKIND_PARTITIONED_INDEX {
+		if err := d.indexFooters(t, &tableinfo, buf); err != nil {
+			return nil, err
+		}
+	} else if relkind == RELKIND_RELATION || relkind == RELKIND_MATVIEW || relkind == RELKIND_FOREIGN_TABLE || relkind == RELKIND_PARTITIONED_TABLE {
+		if err := d.tableFooters(t, &tableinfo, buf); err != nil {
+			return nil, err
+		}
+	}
+
+	// Get view_def if table is a view or materialized view
+	var viewDef string
+	if (relkind == RELKIND_VIEW || relkind == RELKIND_MATVIEW) && verbose {
+		buf.Reset()
+		fmt.Fprintf(buf, "SELECT pg_catalog.pg_get_viewdef(%s::pg_catalog.oid, true);",
+			literal(buf, oid))
+		result, err := d.execBuffer(buf)
+		if err != nil {
+			return nil, err
+		}
+		if result.Len() > 0 {
+			viewDef = result.Value(0, 0)
+		}
+	}
+
+	if viewDef != "" {
+		// Footer information about a view
+		t.addFooter(Gettext("View definition:"))
+		t.addFooter(viewDef)
+
+		// print rules
+		if tableinfo.hasrules {
+			buf.Reset()
+			fmt.Fprintf(buf, "SELECT r.rulename, trim(trailing ';' from pg_catalog.pg_get_ruledef(r.oid, true))\n"+
+				"FROM pg_catalog.pg_rewrite r\n"+
+				"WHERE r.ev_class = %s AND r.rulename != '_RETURN' ORDER BY 1;",
+				literal(buf, oid))
+			result, err := d.execBuffer(buf)
+			if err != nil {
+				return nil, err
+			}
+
+			if result.Len() > 0 {
+				t.addFooter(Gettext("Rules:"))
+				for i := 0; i < result.Len(); i++ {
+					// Everything after "CREATE RULE" is echoed verbatim
+					t.addFooter(fmt.Sprintf(" %s", afterPrefix(result.Value(i, 1), 12)))
+				}
+			}
+		}
+	}
+
+	// Print triggers next, if any (but only user-defined triggers).  This
+	// could apply to either a table or a view.
+	if tableinfo.hastriggers {
+		if err := d.triggerFooters(t, buf); err != nil {
+			return nil, err
+		}
+	}
+
+	// Finish printing the footer information about a table.
+	if relkind == RELKIND_RELATION || relkind == RELKIND_MATVIEW || relkind == RELKIND_FOREIGN_TABLE || relkind == RELKIND_PARTITIONED_TABLE {
+		if err := d.inheritanceFooters(t, &tableinfo, verbose, buf); err != nil {
+			return nil, err
+		}
+	}
+
+	// reloptions, if verbose
+	if verbose && tableinfo.reloptions != "" {
+		t.addFooter(fmt.Sprintf("%s: %s", Gettext("Options"), tableinfo.reloptions))
+	}
+
+	return t, nil
+}
+
+// describeSequence adds the sequence details to t.
+func (d *PgDesc) describeSequence(t *TableDescription, buf *QueryBuffer) error {
+	buf.Reset()
+	if d.version >= 100000 {
+		fmt.Fprintf(buf, "SELECT pg_catalog.format_type(seqtypid, NULL) AS \"%s\",\n"+
+			"       seqstart AS \"%s\",\n"+
+			"       seqmin AS \"%s\",\n"+
+			"       seqmax AS \"%s\",\n"+
+			"       seqincrement AS \"%s\",\n"+
+			"       CASE WHEN seqcycle THEN '%s' ELSE '%s' END AS \"%s\",\n"+
+			"       seqcache AS \"%s\"\n",
+			GettextNoop("Type"),
+			GettextNoop("Start"),
+			GettextNoop("Minimum"),
+			GettextNoop("Maximum"),
+			GettextNoop("Increment"),
+			GettextNoop("yes"),
+			GettextNoop("no"),
+			GettextNoop("Cycles?"),
+			GettextNoop("Cache"))
+		fmt.Fprintf(buf, "FROM pg_catalog.pg_sequence\n"+
+			"WHERE seqrelid = %s;",
+			literal(buf, t.OID))
+	} else {
+		fmt.Fprintf(buf, "SELECT 'bigint' AS \"%s\",\n"+
+			"       start_value AS \"%s\",\n"+
+			"       min_value AS \"%s\",\n"+
+			"       max_value AS \"%s\",\n"+
+			"       increment_by AS \"%s\",\n"+
+			"       CASE WHEN is_cycled THEN '%s' ELSE '%s' END AS \"%s\",\n"+
+			"       cache_value AS \"%s\"\n",
+			GettextNoop("Type"),
+			GettextNoop("Start"),
+			GettextNoop("Minimum"),
+			GettextNoop("Maximum"),
+			GettextNoop("Increment"),
+			GettextNoop("yes"),
+			GettextNoop("no"),
+			GettextNoop("Cycles?"),
+			GettextNoop("Cache"))
+		fmt.Fprintf(buf, "FROM %s.%s;", fmtId(t.Schema), fmtId(t.Name))
+	}
+
+	res, err := d.execBuffer(buf)
+	if err != nil {
+		return err
+	}
+
+	t.Headers = res.Columns
+	for _, typ := range res.Types {
+		t.Aligns = append(t.Aligns, columnAlign(typ))
+	}
+	for i := 0; i < res.Len(); i++ {
+		row := make([]string, len(res.Columns))
+		for j := range row {
+			row[j] = res.Value(i, j)
+		}
+		t.Rows = append(t.Rows, row)
+	}
+
+	// Footer information about a sequence
+
+	// Get the column that owns this sequence
+	buf.Reset()
+	fmt.Fprintf(buf, "SELECT pg_catalog.quote_ident(nspname) || '.' ||"+
+		"\n   pg_catalog.quote_ident(relname) || '.' ||"+
+		"\n   pg_catalog.quote_ident(attname),"+
+		"\n   d.deptype"+
+		"\nFROM pg_catalog.pg_class c"+
+		"\nINNER JOIN pg_catalog.pg_depend d ON c.oid=d.refobjid"+
+		"\nINNER JOIN pg_catalog.pg_namespace n ON n.oid=c.relnamespace"+
+		"\nINNER JOIN pg_catalog.pg_attribute a ON ("+
+		"\n a.attrelid=c.oid AND"+
+		"\n a.attnum=d.refobjsubid)"+
+		"\nWHERE d.classid='pg_catalog.pg_class'::pg_catalog.regclass"+
+		"\n AND d.refclassid='pg_catalog.pg_class'::pg_catalog.regclass"+
+		"\n AND d.objid=%s"+
+		"\n AND d.deptype IN ('a', 'i')",
+		literal(buf, t.OID))
+	result, err := d.execBuffer(buf)
+	if err != nil {
+		return err
+	}
+	if result.Len() == 1 {
+		switch result.Value(0, 1) {
+		case "a":
+			t.addFooter(Gettext("Owned by: %s", result.Value(0, 0)))
+		case "i":
+			t.addFooter(Gettext("Sequence for identity column: %s", result.Value(0, 0)))
+		}
+	}
+
+	t.Title = Gettext("Sequence \"%s.%s\"", t.Schema, t.Name)
+
+	return nil
+}
+
+// indexFooters adds the footer information about an index to t.
+func (d *PgDesc) indexFooters(t *TableDescription, tableinfo *tableInfo, buf *QueryBuffer) error {
+	buf.Reset()
+	fmt.Fprint(buf, "SELECT i.indisunique, i.indisprimary, i.indisclustered, ")
+	if d.version >= 80200 {
+		fmt.Fprint(buf, "i.indisvalid,\n")
+	} else {
+		fmt.Fprint(buf, "true AS indisvalid,\n")
+	}
+	if d.version >= 90000 {
+		fmt.Fprint(buf, "  (NOT i.indimmediate) AND "+
+			"EXISTS (SELECT 1 FROM pg_catalog.pg_constraint "+
+			"WHERE conrelid = i.indrelid AND "+
+			"conindid = i.indexrelid AND "+
+			"contype IN ('"+string(CONSTRAINT_PRIMARY)+"','"+string(CONSTRAINT_UNIQUE)+"','"+string(CONSTRAINT_EXCLUSION)+"') AND "+
+			"condeferrable) AS condeferrable,\n"+
+			"  (NOT i.indimmediate) AND "+
+			"EXISTS (SELECT 1 FROM pg_catalog.pg_constraint "+
+			"WHERE conrelid = i.indrelid AND "+
+			"conindid = i.indexrelid AND "+
+			"contype IN ('"+string(CONSTRAINT_PRIMARY)+"','"+string(CONSTRAINT_UNIQUE)+"','"+string(CONSTRAINT_EXCLUSION)+"') AND "+
+			"condeferred) AS condeferred,\n")
+	} else {
+		fmt.Fprint(buf, "  false AS condeferrable, false AS condeferred,\n")
+	}
+	if d.version >= 90400 {
+		fmt.Fprint(buf, "i.indisreplident,\n")
+	} else {
+		fmt.Fprint(buf, "false AS indisreplident,\n")
+	}
+	if d.version >= 150000 {
+		fmt.Fprint(buf, "i.indnullsnotdistinct,\n")
+	} else {
+		fmt.Fprint(buf, "false AS indnullsnotdistinct,\n")
+	}
+	fmt.Fprintf(buf, "  a.amname, c2.relname, "+
+		"pg_catalog.pg_get_expr(i.indpred, i.indrelid, true)\n"+
+		"FROM pg_catalog.pg_index i, pg_catalog.pg_class c, pg_catalog.pg_class c2, pg_catalog.pg_am a\n"+
+		"WHERE i.indexrelid = c.oid AND c.oid = %s AND c.relam = a.oid\n"+
+		"AND i.indrelid = c2.oid;",
+		literal(buf, t.OID))
+
+	result, err := d.execBuffer(buf)
+	if err != nil {
+		return err
+	}
+	if result.Len() != 1 {
+		return fmt.Errorf("could not get index information for relation with OID %s", t.OID)
+	}
+
+	indisunique := result.Value(0, 0)
+	indisprimary := result.Value(0, 1)
+	indisclustered := result.Value(0, 2)
+	indisvalid := result.Value(0, 3)
+	deferrable := result.Value(0, 4)
+	deferred := result.Value(0, 5)
+	indisreplident := result.Value(0, 6)
+	indnullsnotdistinct := result.Value(0, 7)
+	indamname := result.Value(0, 8)
+	indtable := result.Value(0, 9)
+	indpred := result.Value(0, 10)
+
+	var s string
+	if indisprimary == "t" {
+		s = Gettext("primary key, ")
+	} else if indisunique == "t" {
+		s = Gettext("unique")
+		if indnullsnotdistinct == "t" {
+			s += Gettext(" nulls not distinct")
+		}
+		s += Gettext(", ")
+	}
+	s += fmt.Sprintf("%s, ", indamname)
+
+	// we assume here that index and table are in same schema
+	s += Gettext("for table \"%s.%s\"", t.Schema, indtable)
+
+	if indpred != "" {
+		s += Gettext(", predicate (%s)", indpred)
+	}
+	if indisclustered == "t" {
+		s += Gettext(", clustered")
+	}
+	if indisvalid != "t" {
+		s += Gettext(", invalid")
+	}
+	if deferrable == "t" {
+		s += Gettext(", deferrable")
+	}
+	if deferred == "t" {
+		s += Gettext(", initially deferred")
+	}
+	if indisreplident == "t" {
+		s += Gettext(", replica identity")
+	}
+
+	t.addFooter(s)
+	return d.addTablespaceFooter(t, tableinfo.relkind, tableinfo.tablespace, true)
+}
+
+// tableFooters adds the footer information about a table to t.
+func (d *PgDesc) tableFooters(t *TableDescription, tableinfo *tableInfo, buf *QueryBuffer) error {
+	oid := t.OID
+
+	// print indexes
+	if tableinfo.hasindex {
+		buf.Reset()
+		fmt.Fprint(buf, "SELECT c2.relname, i.indisprimary, i.indisunique, i.indisclustered, ")
+		if d.version >= 80200 {
+			fmt.Fprint(buf, "i.indisvalid, ")
+		} else {
+			fmt.Fprint(buf, "true as indisvalid, ")
+		}
+		fmt.Fprint(buf, "pg_catalog.pg_get_indexdef(i.indexrelid, 0, true),\n  ")
+		if d.version >= 90000 {
+			fmt.Fprint(buf, "pg_catalog.pg_get_constraintdef(con.oid, true), "+
+				"contype, condeferrable, condeferred")
+		} else {
+			fmt.Fprint(buf, "null AS constraintdef, null AS contype, "+
+				"false AS condeferrable, false AS condeferred")
+		}
+		if d.version >= 90400 {
+			fmt.Fprint(buf, ", i.indisreplident")
+		} else {
+			fmt.Fprint(buf, ", false AS indisreplident")
+		}
+		if d.version >= 80000 {
+			fmt.Fprint(buf, ", c2.reltablespace")
+		}
+		fmt.Fprint(buf, "\nFROM pg_catalog.pg_class c, pg_catalog.pg_class c2, pg_catalog.pg_index i\n")
+		if d.version >= 90000 {
+			fmt.Fprint(buf, "  LEFT JOIN pg_catalog.pg_constraint con ON (conrelid = i.indrelid AND conindid = i.indexrelid AND contype IN ('"+string(CONSTRAINT_PRIMARY)+"','"+string(CONSTRAINT_UNIQUE)+"','"+string(CONSTRAINT_EXCLUSION)+"'))\n")
+		}
+		fmt.Fprintf(buf, "WHERE c.oid = %s AND c.oid = i.indrelid AND i.indexrelid = c2.oid\n"+
+			"ORDER BY i.indisprimary DESC, i.indisunique DESC, c2.relname;",
+			literal(buf, oid))
+
+		result, err := d.execBuffer(buf)
+		if err != nil {
+			return err
+		}
+
+		if result.Len() > 0 {
+			t.addFooter(Gettext("Indexes:"))
+			for i := 0; i < result.Len(); i++ {
+				// untranslated index name
+				s := fmt.Sprintf("    \"%s\"", result.Value(i, 0))
+
+				// If exclusion constraint, print the constraintdef
+				if result.Value(i, 7) == "x" {
+					s += fmt.Sprintf(" %s", result.Value(i, 6))
+				} else {
+					// Label as primary key or unique (but not both)
+					if result.Value(i, 1) == "t" {
+						s += " PRIMARY KEY,"
+					} else if result.Value(i, 2) == "t" {
+						if result.Value(i, 7) == "u" {
+							s += " UNIQUE CONSTRAINT,"
+						} else {
+							s += " UNIQUE,"
+						}
+					}
+
+					// Everything after "USING" is echoed verbatim
+					indexdef := result.Value(i, 5)
+					if j := strings.Index(indexdef, " USING "); j != -1 {
+						indexdef = indexdef[j+7:]
+					}
+					s += fmt.Sprintf(" %s", indexdef)
+
+					// Need these for deferrable PK/UNIQUE indexes
+					if result.Value(i, 8) == "t" {
+						s += " DEFERRABLE"
+					}
+					if result.Value(i, 9) == "t" {
+						s += " INITIALLY DEFERRED"
+					}
+				}
+
+				// Add these for all cases
+				if result.Value(i, 3) == "t" {
+					s += " CLUSTER"
+				}
+				if result.Value(i, 4) != "t" {
+					s += " INVALID"
+				}
+				if result.Value(i, 10) == "t" {
+					s += " REPLICA IDENTITY"
+				}
+
+				t.addFooter(s)
+
+				// Print tablespace of the index on the same line
+				if d.version >= 80000 {
+					if err := d.addTablespaceFooter(t, RELKIND_INDEX, result.Value(i, 11), false); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	// print table (and column) check constraints
+	if tableinfo.checks != 0 {
+		buf.Reset()
+		fmt.Fprintf(buf, "SELECT r.conname, "+
+			"pg_catalog.pg_get_constraintdef(r.oid, true)\n"+
+			"FROM pg_catalog.pg_constraint r\n"+
+			"WHERE r.conrelid = %s AND r.contype = '"+string(CONSTRAINT_CHECK)+"'\n"+
+			"ORDER BY 1;",
+			literal(buf, oid))
+		result, err := d.execBuffer(buf)
+		if err != nil {
+			return err
+		}
+
+		if result.Len() > 0 {
+			t.addFooter(Gettext("Check constraints:"))
+			for i := 0; i < result.Len(); i++ {
+				// untranslated constraint name and def
+				t.addFooter(fmt.Sprintf("    \"%s\" %s",
+					result.Value(i, 0),
+					result.Value(i, 1)))
+			}
+		}
+	}
+
+	// print foreign-key constraints (there are none if no triggers)
+	if tableinfo.hastriggers || tableinfo.relkind == RELKIND_PARTITIONED_TABLE {
+		buf.Reset()
+		if d.version >= 120000 &&
+			(tableinfo.ispartition || tableinfo.relkind == RELKIND_PARTITIONED_TABLE) {
+			// Put the constraints defined in this table first, followed by
+			// the constraints defined in ancestor partitioned tables.
+			fmt.Fprintf(buf, "SELECT conrelid = %s::pg_catalog.regclass AS sametable,\n"+
+				"       conname,\n"+
+				"       pg_catalog.pg_get_constraintdef(oid, true) AS condef,\n"+
+				"       conrelid::pg_catalog.regclass AS ontable\n"+
+				"  FROM pg_catalog.pg_constraint,\n"+
+				"       pg_catalog.pg_partition_ancestors(%s)\n"+
+				" WHERE conrelid = relid AND contype = '"+string(CONSTRAINT_FOREIGN)+"' AND conparentid = 0\n"+
+				"ORDER BY sametable DESC, conname;",
+				literal(buf, oid), literal(buf, oid))
+		} else {
+			fmt.Fprintf(buf, "SELECT true as sametable, conname,\n"+
+				"  pg_catalog.pg_get_constraintdef(r.oid, true) as condef,\n"+
+				"  conrelid::pg_catalog.regclass AS ontable\n"+
+				"FROM pg_catalog.pg_constraint r\n"+
+				"WHERE r.conrelid = %s AND r.contype = '"+string(CONSTRAINT_FOREIGN)+"'\n",
+				literal(buf, oid))
+			if d.version >= 120000 {
+				fmt.Fprint(buf, "     AND conparentid = 0\n")
+			}
+			fmt.Fprint(buf, "ORDER BY conname")
+		}
+
+		result, err := d.execBuffer(buf)
+		if err != nil {
+			return err
+		}
+
+		if result.Len() > 0 {
+			t.addFooter(Gettext("Foreign-key constraints:"))
+			for i := 0; i < result.Len(); i++ {
+				// Print untranslated constraint name and definition. Use a
+				// "TABLE tab" prefix when the constraint is defined in a
+				// parent partitioned table.
+				if result.Value(i, 0) == "f" {
+					t.addFooter(fmt.Sprintf("    TABLE \"%s\" CONSTRAINT \"%s\" %s",
+						result.Value(i, 3),
+						result.Value(i, 1),
+						result.Value(i, 2)))
+				} else {
+					t.addFooter(fmt.Sprintf("    \"%s\" %s",
+						result.Value(i, 1),
+						result.Value(i, 2)))
+				}
+			}
+		}
+	}
+
+	// print incoming foreign-key references
+	if tableinfo.hastriggers || tableinfo.relkind == RELKIND_PARTITIONED_TABLE {
+		buf.Reset()
+		if d.version >= 120000 {
+			fmt.Fprintf(buf, "SELECT conname, conrelid::pg_catalog.regclass AS ontable,\n"+
+				"       pg_catalog.pg_get_constraintdef(oid, true) AS condef\n"+
+				"  FROM pg_catalog.pg_constraint c\n"+
+				" WHERE confrelid IN (SELECT pg_catalog.pg_partition_ancestors(%s)\n"+
+				"                     UNION ALL VALUES (%s::pg_catalog.regclass))\n"+
+				"       AND contype = '"+string(CONSTRAINT_FOREIGN)+"' AND conparentid = 0\n"+
+				"ORDER BY conname;",
+				literal(buf, oid), literal(buf, oid))
+		} else {
+			fmt.Fprintf(buf, "SELECT conname, conrelid::pg_catalog.regclass AS ontable,\n"+
+				"  pg_catalog.pg_get_constraintdef(c.oid, true) as condef\n"+
+				"FROM pg_catalog.pg_constraint c\n"+
+				"WHERE c.confrelid = %s AND c.contype = '"+string(CONSTRAINT_FOREIGN)+"' ORDER BY 1;",
+				literal(buf, oid))
+		}
+
+		result, err := d.execBuffer(buf)
+		if err != nil {
+			return err
+		}
+
+		if result.Len() > 0 {
+			t.addFooter(Gettext("Referenced by:"))
+			for i := 0; i < result.Len(); i++ {
+				t.addFooter(fmt.Sprintf("    TABLE \"%s\" CONSTRAINT \"%s\" %s",
+					result.Value(i, 1),
+					result.Value(i, 0),
+					result.Value(i, 2)))
+			}
+		}
+	}
+
+	// print any row-level policies
+	if d.version >= 90500 {
+		buf.Reset()
+		fmt.Fprint(buf, "SELECT pol.polname,")
+		if d.version >= 100000 {
+			fmt.Fprint(buf, " pol.polpermissive,\n")
+		} else {
+			fmt.Fprint(buf, " 't' as polpermissive,\n")
+		}
+		fmt.Fprintf(buf, "  CASE WHEN pol.polroles = '{0}' THEN NULL ELSE pg_catalog.array_to_string(array(select rolname from pg_catalog.pg_roles where oid = any (pol.polroles) order by 1),',') END,\n"+
+			"  pg_catalog.pg_get_expr(pol.polqual, pol.polrelid),\n"+
+			"  pg_catalog.pg_get_expr(pol.polwithcheck, pol.polrelid),\n"+
+			"  CASE pol.polcmd\n"+
+			"    WHEN 'r' THEN 'SELECT'\n"+
+			"    WHEN 'a' THEN 'INSERT'\n"+
+			"    WHEN 'w' THEN 'UPDATE'\n"+
+			"    WHEN 'd' THEN 'DELETE'\n"+
+			"    END AS cmd\n"+
+			"FROM pg_catalog.pg_policy pol\n"+
+			"WHERE pol.polrelid = %s ORDER BY 1;",
+			literal(buf, oid))
+
+		result, err := d.execBuffer(buf)
+		if err != nil {
+			return err
+		}
+		tuples := result.Len()
+
+		// Handle cases where RLS is enabled and there are policies, or there
+		// aren't policies, or RLS isn't enabled but there are policies
+		switch {
+		case tableinfo.rowsecurity && !tableinfo.forcerowsecurity && tuples > 0:
+			t.addFooter(Gettext("Policies:"))
+		case tableinfo.rowsecurity && tableinfo.forcerowsecurity && tuples > 0:
+			t.addFooter(Gettext("Policies (forced row security enabled):"))
+		case tableinfo.rowsecurity && !tableinfo.forcerowsecurity && tuples == 0:
+			t.addFooter(Gettext("Policies (row security enabled): (none)"))
+		case tableinfo.rowsecurity && tableinfo.forcerowsecurity && tuples == 0:
+			t.addFooter(Gettext("Policies (forced row security enabled): (none)"))
+		case !tableinfo.rowsecurity && tuples > 0:
+			t.addFooter(Gettext("Policies (row security disabled):"))
+		}
+
+		// Might be an empty set - that's ok
+		for i := 0; i < tuples; i++ {
+			s := fmt.Sprintf("    POLICY \"%s\"", result.Value(i, 0))
+			if firstByte(result.Value(i, 1)) == 'f' {
+				s += " AS RESTRICTIVE"
+			}
+			if !result.IsNull(i, 5) {
+				s += fmt.Sprintf(" FOR %s", result.Value(i, 5))
+			}
+			if !result.IsNull(i, 2) {
+				s += fmt.Sprintf("\n      TO %s", result.Value(i, 2))
+			}
+			if !result.IsNull(i, 3) {
+				s += fmt.Sprintf("\n      USING (%s)", result.Value(i, 3))
+			}
+			if !result.IsNull(i, 4) {
+				s += fmt.Sprintf("\n      WITH CHECK (%s)", result.Value(i, 4))
+			}
+			t.addFooter(s)
+		}
+	}
+
+	// print any extended statistics
+	if d.version >= 140000 {
+		buf.Reset()
+		fmt.Fprintf(buf, "SELECT oid, "+
+			"stxrelid::pg_catalog.regclass, "+
+			"stxnamespace::pg_catalog.regnamespace::pg_catalog.text AS nsp, "+
+			"stxname,\n"+
+			"pg_catalog.pg_get_statisticsobjdef_columns(oid) AS columns,\n"+
+			"  '"+string(STATS_EXT_NDISTINCT)+"' = any(stxkind) AS ndist_enabled,\n"+
+			"  '"+string(STATS_EXT_DEPENDENCIES)+"' = any(stxkind) AS deps_enabled,\n"+
+			"  '"+string(STATS_EXT_MCV)+"' = any(stxkind) AS mcv_enabled,\n"+
+			"stxstattarget\n"+
+			"FROM pg_catalog.pg_statistic_ext\n"+
+			"WHERE stxrelid = %s\n"+
+			"ORDER BY nsp, stxname;",
+			literal(buf, oid))
+		result, err := d.execBuffer(buf)
+		if err != nil {
+			return err
+		}
+
+		if result.Len() > 0 {
+			t.addFooter(Gettext("Statistics objects:"))
+			for i := 0; i < result.Len(); i++ {
+				hasNdistinct := result.Value(i, 5) == "t"
+				hasDependencies := result.Value(i, 6) == "t"
+				hasMCV := result.Value(i, 7) == "t"
+				hasAll := hasNdistinct && hasDependencies && hasMCV
+				hasSome := hasNdistinct || hasDependencies || hasMCV
+
+				// statistics object name (qualified with namespace)
+				s := fmt.Sprintf("    \"%s.%s\"",
+					result.Value(i, 2),
+					result.Value(i, 3))
+
+				// do we have an explicit kind list, or all or none?
+				if !hasAll && hasSome {
+					s += " (" + statisticsKinds(hasNdistinct, hasDependencies, hasMCV) + ")"
+				}
+
+				s += fmt.Sprintf(" ON %s FROM %s",
+					result.Value(i, 4),
+					result.Value(i, 1))
+
+				// Show the stats target if it's not default
+				if !result.IsNull(i, 8) && result.Value(i, 8) != "-1" {
+					s += fmt.Sprintf("; STATISTICS %s", result.Value(i, 8))
+				}
+
+				t.addFooter(s)
+			}
+		}
+	} else if d.version >= 100000 {
+		buf.Reset()
+		fmt.Fprint(buf, "SELECT oid, "+
+			"stxrelid::pg_catalog.regclass, "+
+			"stxnamespace::pg_catalog.regnamespace AS nsp, "+
+			"stxname,\n"+
+			"  (SELECT pg_catalog.string_agg(pg_catalog.quote_ident(attname),', ')\n"+
+			"   FROM pg_catalog.unnest(stxkeys) s(attnum)\n"+
+			"   JOIN pg_catalog.pg_attribute a ON (stxrelid = a.attrelid AND\n"+
+			"        a.attnum = s.attnum AND NOT attisdropped)) AS columns,\n"+
+			"  '"+string(STATS_EXT_NDISTINCT)+"' = any(stxkind) AS ndist_enabled,\n"+
+			"  '"+string(STATS_EXT_DEPENDENCIES)+"' = any(stxkind) AS deps_enabled")
+		if d.version >= 120000 {
+			fmt.Fprint(buf, ",\n  '"+string(STATS_EXT_MCV)+"' = any(stxkind) AS mcv_enabled")
+		} else {
+			fmt.Fprint(buf, ",\n  false AS mcv_enabled")
+		}
+		if d.version >= 130000 {
+			fmt.Fprint(buf, ",\n  stxstattarget")
+		} else {
+			fmt.Fprint(buf, ",\n  -1 AS stxstattarget")
+		}
+		fmt.Fprintf(buf, "\nFROM pg_catalog.pg_statistic_ext stat "+
+			"WHERE stxrelid = %s\n"+
+			"ORDER BY 1;",
+			literal(buf, oid))
+
+		result, err := d.execBuffer(buf)
+		if err != nil {
+			return err
+		}
+
+		if result.Len() > 0 {
+			t.addFooter(Gettext("Statistics objects:"))
+			for i := 0; i < result.Len(); i++ {
+				// statistics object name (qualified with namespace)
+				s := fmt.Sprintf("    \"%s\".\"%s\" (",
+					result.Value(i, 2),
+					result.Value(i, 3))
+
+				// options
+				s += statisticsKinds(result.Value(i, 5) == "t",
+					result.Value(i, 6) == "t",
+					result.Value(i, 7) == "t")
+
+				s += fmt.Sprintf(") ON %s FROM %s",
+					result.Value(i, 4),
+					result.Value(i, 1))
+
+				// Show the stats target if it's not default
+				if result.Value(i, 8) != "-1" {
+					s += fmt.Sprintf("; STATISTICS %s", result.Value(i, 8))
+				}
+
+				t.addFooter(s)
+			}
+		}
+	}
+
+	// print rules
+	if tableinfo.hasrules && tableinfo.relkind != RELKIND_MATVIEW {
+		ev := "ev_enabled"
+		if d.version < 80300 {
+			ev = "'O'::char AS ev_enabled"
+		}
+		buf.Reset()
+		fmt.Fprintf(buf, "SELECT r.rulename, trim(trailing ';' from pg_catalog.pg_get_ruledef(r.oid, true)), "+
+			"%s\n"+
+			"FROM pg_catalog.pg_rewrite r\n"+
+			"WHERE r.ev_class = %s ORDER BY 1;",
+			ev, literal(buf, oid))
+		result, err := d.execBuffer(buf)
+		if err != nil {
+			return err
+		}
+
+		headings := []struct {
+			enabled byte
+			heading string
+		}{
+			{'O', Gettext("Rules:")},
+			{'D', Gettext("Disabled rules:")},
+			{'A', Gettext("Rules firing always:")},
+			{'R', Gettext("Rules firing on replica only:")},
+		}
+		for _, category := range headings {
+			haveHeading := false
+			for i := 0; i < result.Len(); i++ {
+				if firstByte(result.Value(i, 2)) != category.enabled {
+					continue
+				}
+				if !haveHeading {
+					t.addFooter(category.heading)
+					haveHeading = true
+				}
+
+				// Everything after "CREATE RULE" is echoed verbatim
+				t.addFooter(fmt.Sprintf("    %s", afterPrefix(result.Value(i, 1), 12)))
+			}
+		}
+	}
+
+	// print any publications
+	if d.version >= 100000 {
+		buf.Reset()
+		if d.version >= 150000 {
+			fmt.Fprintf(buf, "SELECT pubname\n"+
+				"     , NULL\n"+
+				"     , NULL\n"+
+				"FROM pg_catalog.pg_publication p\n"+
+				"     JOIN pg_catalog.pg_publication_namespace pn ON p.oid = pn.pnpubid\n"+
+				"     JOIN pg_catalog.pg_class pc ON pc.relnamespace = pn.pnnspid\n"+
+				"WHERE pc.oid =%s and pg_catalog.pg_relation_is_publishable(%s)\n"+
+				"UNION\n"+
+				"SELECT pubname\n"+
+				"     , pg_get_expr(pr.prqual, c.oid)\n"+
+				"     , (CASE WHEN pr.prattrs IS NOT NULL THEN\n"+
+				"         (SELECT string_agg(attname, ', ')\n"+
+				"           FROM pg_catalog.generate_series(0, pg_catalog.array_upper(pr.prattrs::pg_catalog.int2[], 1)) s,\n"+
+				"                pg_catalog.pg_attribute\n"+
+				"          WHERE attrelid = pr.prrelid AND attnum = prattrs[s])\n"+
+				"        ELSE NULL END) "+
+				"FROM pg_catalog.pg_publication p\n"+
+				"     JOIN pg_catalog.pg_publication_rel pr ON p.oid = pr.prpubid\n"+
+				"     JOIN pg_catalog.pg_class c ON c.oid = pr.prrelid\n"+
+				"WHERE pr.prrelid = %s\n"+
+				"UNION\n"+
+				"SELECT pubname\n"+
+				"     , NULL\n"+
+				"     , NULL\n"+
+				"FROM pg_catalog.pg_publication p\n"+
+				"WHERE p.puballtables AND pg_catalog.pg_relation_is_publishable(%s)\n"+
+				"ORDER BY 1;",
+				literal(buf, oid), literal(buf, oid), literal(buf, oid), literal(buf, oid))
+		} else {
+			fmt.Fprintf(buf, "SELECT pubname\n"+
+				"     , NULL\n"+
+				"     , NULL\n"+
+				"FROM pg_catalog.pg_publication p\n"+
+				"JOIN pg_catalog.pg_publication_rel pr ON p.oid = pr.prpubid\n"+
+				"WHERE pr.prrelid = %s\n"+
+				"UNION ALL\n"+
+				"SELECT pubname\n"+
+				"     , NULL\n"+
+				"     , NULL\n"+
+				"FROM pg_catalog.pg_publication p\n"+
+				"WHERE p.puballtables AND pg_catalog.pg_relation_is_publishable(%s)\n"+
+				"ORDER BY 1;",
+				literal(buf, oid), literal(buf, oid))
+		}
+
+		result, err := d.execBuffer(buf)
+		if err != nil {
+			return err
+		}
+
+		if result.Len() > 0 {
+			t.addFooter(Gettext("Publications:"))
+		}
+
+		// Might be an empty set - that's ok
+		for i := 0; i < result.Len(); i++ {
+			s := fmt.Sprintf("    \"%s\"", result.Value(i, 0))
+
+			// column list (if any)
+			if !result.IsNull(i, 2) {
+				s += fmt.Sprintf(" (%s)", result.Value(i, 2))
+			}
+
+			// row filter (if any)
+			if !result.IsNull(i, 1) {
+				s += fmt.Sprintf(" WHERE %s", result.Value(i, 1))
+			}
+
+			t.addFooter(s)
+		}
+	}
+
+	return nil
+}
+
+// triggerFooters adds the user-defined triggers to t.
+func (d *PgDesc) triggerFooters(t *TableDescription, buf *QueryBuffer) error {
+	tgdefArg, tgisinternal := "", "false AS tgisinternal"
+	switch {
+	case d.version >= 90000:
+		tgdefArg, tgisinternal = ", true", "t.tgisinternal"
+	case d.version >= 80300:
+		tgisinternal = "t.tgconstraint <> 0 AS tgisinternal"
+	}
+
+	// Triggers inherited from a partitioned table are shown with the name
+	// of the table they are defined on.
+	parent := "NULL AS parent"
+	if d.version >= 130000 {
+		parent = "CASE WHEN t.tgparentid != 0 THEN\n" +
+			"  (SELECT u.tgrelid::pg_catalog.regclass\n" +
+			"   FROM pg_catalog.pg_trigger AS u,\n" +
+			"        pg_catalog.pg_partition_ancestors(t.tgrelid) WITH ORDINALITY AS a(relid, depth)\n" +
+			"   WHERE u.tgname = t.tgname AND u.tgrelid = a.relid\n" +
+			"         AND u.tgparentid = 0\n" +
+			"   ORDER BY a.depth LIMIT 1)\n" +
+			"END AS parent"
+	}
+
+	buf.Reset()
+	fmt.Fprintf(buf, "SELECT t.tgname, "+
+		"pg_catalog.pg_get_triggerdef(t.oid%s), "+
+		"t.tgenabled, %s,\n"+
+		"  %s\n"+
+		"FROM pg_catalog.pg_trigger t\n"+
+		"WHERE t.tgrelid = %s AND ",
+		tgdefArg, tgisinternal, parent, literal(buf, t.OID))
+	if d.version >= 110000 {
+		fmt.Fprint(buf, "(NOT t.tgisinternal OR (t.tgisinternal AND t.tgenabled = 'D') \n"+
+			"    OR EXISTS (SELECT 1 FROM pg_catalog.pg_depend WHERE objid = t.oid \n"+
+			"        AND refclassid = 'pg_catalog.pg_trigger'::pg_catalog.regclass))")
+	} else if d.version >= 90000 {
+		// display/warn about disabled internal triggers
+		fmt.Fprint(buf, "(NOT t.tgisinternal OR (t.tgisinternal AND t.tgenabled = 'D'))")
+	} else if d.version >= 80300 {
+		fmt.Fprint(buf, "(t.tgconstraint = 0 OR (t.tgconstraint <> 0 AND t.tgenabled = 'D'))")
+	} else {
+		fmt.Fprint(buf, "(NOT tgisconstraint "+
+			" OR NOT EXISTS"+
+			"  (SELECT 1 FROM pg_catalog.pg_depend d "+
+			"   JOIN pg_catalog.pg_constraint c ON (d.refclassid = c.tableoid AND d.refobjid = c.oid) "+
+			"   WHERE d.classid = t.tableoid AND d.objid = t.oid AND d.deptype = 'i' AND c.contype = '"+string(CONSTRAINT_FOREIGN)+"'))")
+	}
+	fmt.Fprint(buf, "\nORDER BY 1;")
+
+	result, err := d.execBuffer(buf)
+	if err != nil {
+		return err
+	}
+
+	// split the output into 4 different categories. Enabled triggers,
+	// disabled triggers and the two special ALWAYS and REPLICA
+	// configurations.
+	for category := 0; category <= 4; category++ {
+		haveHeading := false
+		for i := 0; i < result.Len(); i++ {
+			// Check if this trigger falls into the current category
+			tgenabled := firstByte(result.Value(i, 2))
+			tgisinternal := firstByte(result.Value(i, 3))
+			listTrigger := false
+			switch category {
+			case 0:
+				listTrigger = tgenabled == 'O' || tgenabled == 't'
+			case 1:
+				listTrigger = (tgenabled == 'D' || tgenabled == 'f') && tgisinternal == 'f'
+			case 2:
+				listTrigger = (tgenabled == 'D' || tgenabled == 'f') && tgisinternal == 't'
+			case 3:
+				listTrigger = tgenabled == 'A'
+			case 4:
+				listTrigger = tgenabled == 'R'
+			}
+			if !listTrigger {
+				continue
+			}
+
+			// Print the category heading once
+			if !haveHeading {
+				switch category {
+				case 0:
+					t.addFooter(Gettext("Triggers:"))
+				case 1:
+					if d.version >= 80300 {
+						t.addFooter(Gettext("Disabled user triggers:"))
+					} else {
+						t.addFooter(Gettext("Disabled triggers:"))
+					}
+				case 2:
+					t.addFooter(Gettext("Disabled internal triggers:"))
+				case 3:
+					t.addFooter(Gettext("Triggers firing always:"))
+				case 4:
+					t.addFooter(Gettext("Triggers firing on replica only:"))
+				}
+				haveHeading = true
+			}
+
+			// Everything after "TRIGGER" is echoed verbatim
+			tgdef := result.Value(i, 1)
+			if j := strings.Index(tgdef, " TRIGGER "); j != -1 {
+				tgdef = tgdef[j+9:]
+			}
+			s := fmt.Sprintf("    %s", tgdef)
+
+			// the table the trigger is inherited from, if any
+			if !result.IsNull(i, 4) {
+				s += Gettext(", ON TABLE %s", result.Value(i, 4))
+			}
+			t.addFooter(s)
+		}
+	}
+
+	return nil
+}
+
+// inheritanceFooters adds the foreign server, inheritance, partitions, and
+// remaining table footers to t.
+func (d *PgDesc) inheritanceFooters(t *TableDescription, tableinfo *tableInfo, verbose bool, buf *QueryBuffer) error {
+	oid := t.OID
+
+	// print foreign server name
+	if tableinfo.relkind == RELKIND_FOREIGN_TABLE {
+		// Footer information about foreign table
+		buf.Reset()
+		fmt.Fprintf(buf, "SELECT s.srvname,\n"+
+			"  pg_catalog.array_to_string(ARRAY(\n"+
+			"    SELECT pg_catalog.quote_ident(option_name)"+
+			" || ' ' || pg_catalog.quote_literal(option_value)\n"+
+			"    FROM pg_catalog.pg_options_to_table(ftoptions)),  ', ')\n"+
+			"FROM pg_catalog.pg_foreign_table f,\n"+
+			"     pg_catalog.pg_foreign_server s\n"+
+			"WHERE f.ftrelid = %s AND s.oid = f.ftserver;",
+			literal(buf, oid))
+		result, err := d.execBuffer(buf)
+		if err != nil {
+			return err
+		}
+		if result.Len() != 1 {
+			return fmt.Errorf("could not get foreign server for relation with OID %s", oid)
+		}
+
+		// Print server name
+		t.addFooter(Gettext("Server: %s", result.Value(0, 0)))
+
+		// Print per-table FDW options, if any
+		if ftoptions := result.Value(0, 1); ftoptions != "" {
+			t.addFooter(Gettext("FDW options: (%s)", ftoptions))
+		}
+	}
+
+	// print inherited tables (exclude, if parent is a partitioned table)
+	buf.Reset()
+	fmt.Fprintf(buf, "SELECT c.oid::pg_catalog.regclass"+
+		" FROM pg_catalog.pg_class c, pg_catalog.pg_inherits i"+
+		" WHERE c.oid=i.inhparent AND i.inhrelid = %s"+
+		" AND c.relkind != '"+string(RELKIND_PARTITIONED_TABLE)+"'"+
+		" ORDER BY inhseqno;", literal(buf, oid))
+	result, err := d.execBuffer(buf)
+	if err != nil {
+		return err
+	}
+
+	s := Gettext("Inherits")
+	sw := utf8.RuneCountInString(s)
+	tuples := result.Len()
+	for i := 0; i < tuples; i++ {
+		var footer string
+		if i == 0 {
+			footer = fmt.Sprintf("%s: %s", s, result.Value(i, 0))
+		} else {
+			footer = fmt.Sprintf("%*s  %s", sw, "", result.Value(i, 0))
+		}
+		if i < tuples-1 {
+			footer += ","
+		}
+		t.addFooter(footer)
+	}
+
+	// print child tables (with additional info if partitions)
+	buf.Reset()
+	if d.version >= 140000 {
+		fmt.Fprintf(buf, "SELECT c.oid::pg_catalog.regclass,"+
+			"       pg_catalog.pg_get_expr(c.relpartbound, c.oid),"+
+			"       c.relkind,"+
+			"       i.inhdetachpending"+
+			" FROM pg_catalog.pg_class c, pg_catalog.pg_inherits i"+
+			" WHERE c.oid=i.inhrelid AND i.inhparent = %s"+
+			" ORDER BY pg_catalog.pg_get_expr(c.relpartbound, c.oid) = 'DEFAULT',"+
+			"          c.oid::pg_catalog.regclass::pg_catalog.text;", literal(buf, oid))
+	} else if d.version >= 100000 {
+		fmt.Fprintf(buf, "SELECT c.oid::pg_catalog.regclass,"+
+			"       pg_catalog.pg_get_expr(c.relpartbound, c.oid),"+
+			"       c.relkind,"+
+			"       false AS inhdetachpending"+
+			" FROM pg_catalog.pg_class c, pg_catalog.pg_inherits i"+
+			" WHERE c.oid=i.inhrelid AND i.inhparent = %s"+
+			" ORDER BY pg_catalog.pg_get_expr(c.relpartbound, c.oid) = 'DEFAULT',"+
+			"          c.oid::pg_catalog.regclass::pg_catalog.text;", literal(buf, oid))
+	} else if d.version >= 80300 {
+		fmt.Fprintf(buf, "SELECT c.oid::pg_catalog.regclass"+
+			" FROM pg_catalog.pg_class c, pg_catalog.pg_inherits i"+
+			" WHERE c.oid=i.inhrelid AND i.inhparent = %s"+
+			" ORDER BY c.oid::pg_catalog.regclass::pg_catalog.text;", literal(buf, oid))
+	} else {
+		fmt.Fprintf(buf, "SELECT c.oid::pg_catalog.regclass"+
+			" FROM pg_catalog.pg_class c, pg_catalog.pg_inherits i"+
+			" WHERE c.oid=i.inhrelid AND i.inhparent = %s"+
+			" ORDER BY c.relname;", literal(buf, oid))
+	}
+
+	result, err = d.execBuffer(buf)
+	if err != nil {
+		return err
+	}
+	tuples = result.Len()
+
+	// For a partitioned table with no partitions, always print the number of
+	// partitions as zero, even when verbose output is expected. Otherwise, we
+	// will not print "Partitions" section for a partitioned table without any
+	// partitions.
+	if tableinfo.relkind == RELKIND_PARTITIONED_TABLE && tuples == 0 {
+		t.addFooter(Gettext("Number of partitions: %d", tuples))
+	} else if !verbose {
+		// print the number of child tables, if any
+		if tuples > 0 {
+			if tableinfo.relkind != RELKIND_PARTITIONED_TABLE {
+				t.addFooter(Gettext("Number of child tables: %d (Use \\d+ to list them.)", tuples))
+			} else {
+				t.addFooter(Gettext("Number of partitions: %d (Use \\d+ to list them.)", tuples))
+			}
+		}
+	} else {
+		// display the list of child tables
+		ct := Gettext("Child tables")
+		if tableinfo.relkind == RELKIND_PARTITIONED_TABLE {
+			ct = Gettext("Partitions")
+		}
+		ctw := utf8.RuneCountInString(ct)
+
+		for i := 0; i < tuples; i++ {
+			var footer string
+			if tableinfo.relkind != RELKIND_PARTITIONED_TABLE {
+				if i == 0 {
+					footer = fmt.Sprintf("%s: %s", ct, result.Value(i, 0))
+				} else {
+					footer = fmt.Sprintf("%*s  %s", ctw, "", result.Value(i, 0))
+				}
+			} else {
+				var partitionedNote string
+				switch RelKind(firstByte(result.Value(i, 2))) {
+				case RELKIND_PARTITIONED_TABLE, RELKIND_PARTITIONED_INDEX:
+					partitionedNote = ", PARTITIONED"
+				case RELKIND_FOREIGN_TABLE:
+					partitionedNote = ", FOREIGN"
+				}
+				if result.Value(i, 3) == "t" {
+					partitionedNote += " (DETACH PENDING)"
+				}
+				if i == 0 {
+					footer = fmt.Sprintf("%s: %s %s%s",
+						ct, result.Value(i, 0), result.Value(i, 1),
+						partitionedNote)
+				} else {
+					footer = fmt.Sprintf("%*s  %s %s%s",
+						ctw, "", result.Value(i, 0), result.Value(i, 1),
+						partitionedNote)
+				}
+			}
+			if i < tuples-1 {
+				footer += ","
+			}
+			t.addFooter(footer)
+		}
+	}
+
+	// Table type
+	if tableinfo.reloftype != "" {
+		t.addFooter(Gettext("Typed table of type: %s", tableinfo.reloftype))
+	}
+
+	// No need to display default values; we already display a REPLICA
+	// IDENTITY marker on indexes.
+	if verbose &&
+		(tableinfo.relkind == RELKIND_RELATION || tableinfo.relkind == RELKIND_MATVIEW) &&
+		tableinfo.relreplident != 'i' &&
+		((t.Schema != "pg_catalog" && tableinfo.relreplident != 'd') ||
+			(t.Schema == "pg_catalog" && tableinfo.relreplident != 'n')) {
+		ident := "???"
+		switch tableinfo.relreplident {
+		case 'f':
+			ident = "FULL"
+		case 'n':
+			ident = "NOTHING"
+		}
+		t.addFooter(fmt.Sprintf("%s: %s", Gettext("Replica Identity"), ident))
+	}
+
+	// OIDs, if verbose and not a materialized view
+	if verbose && tableinfo.relkind != RELKIND_MATVIEW && tableinfo.hasoids {
+		t.addFooter(Gettext("Has OIDs: yes"))
+	}
+
+	// Access method info
+	if verbose && tableinfo.relam != "" {
+		t.addFooter(Gettext("Access method: %s", tableinfo.relam))
+	}
+
+	// Tablespace info
+	return d.addTablespaceFooter(t, tableinfo.relkind, tableinfo.tablespace, true)
+}
+
+// addTablespaceFooter is manually translated func from the postgres source.
+//
+// See: postgres/src/bin/psql/describe.c
+//
+// Add a tablespace description to a footer.  If 'newline' is true, it is
+// added in a new line; otherwise it's appended to the current value of the
+// last footer.
+func (d *PgDesc) addTablespaceFooter(t *TableDescription, relkind RelKind, tablespace string, newline bool) error {
+	// relkinds for which we support tablespaces
+	if relkind != RELKIND_RELATION && relkind != RELKIND_MATVIEW && relkind != RELKIND_INDEX && relkind != RELKIND_PARTITIONED_TABLE && relkind != RELKIND_PARTITIONED_INDEX {
+		return nil
+	}
+
+	// We ignore the database default tablespace so that users not using
+	// tablespaces don't need to know about them.  This case also covers
+	// pre-8.0 servers, for which tablespace will always be 0.
+	if tablespace == "" || tablespace == "0" {
+		return nil
+	}
+
+	buf := new(QueryBuffer)
+	fmt.Fprintf(buf, "SELECT spcname FROM pg_catalog.pg_tablespace\n"+
+		"WHERE oid = %s;", literal(buf, tablespace))
+	result, err := d.execBuffer(buf)
+	if err != nil {
+		return err
+	}
+
+	// Should always be the case, but....
+	if result.Len() > 0 {
+		if newline || len(t.Footers) == 0 {
+			// Add the tablespace as a new footer
+			t.addFooter(Gettext("Tablespace: \"%s\"", result.Value(0, 0)))
+		} else {
+			// Append the tablespace to the latest footer
+			t.Footers[len(t.Footers)-1] += Gettext(", tablespace \"%s\"", result.Value(0, 0))
+		}
+	}
+
+	return nil
+}
+
+// toastOptions returns the reloptions expression for the general table info
+// query.
+func toastOptions(verbose bool) string {
+	if !verbose {
+		return "''"
+	}
+	return "pg_catalog.array_to_string(c.reloptions || " +
+		"array(select 'toast.' || x from pg_catalog.unnest(tc.reloptions) x), ', ')\n"
+}
+
+// statisticsKinds returns the comma separated list of enabled extended
+// statistics kinds.
+func statisticsKinds(ndistinct, dependencies, mcv bool) string {
+	var kinds []string
+	if ndistinct {
+		kinds = append(kinds, "ndistinct")
+	}
+	if dependencies {
+		kinds = append(kinds, "dependencies")
+	}
+	if mcv {
+		kinds = append(kinds, "mcv")
+	}
+	return strings.Join(kinds, ", ")
+}
+
+// firstByte returns the first byte of s, or 0 when s is empty.
+func firstByte(s string) byte {
+	if s == "" {
+		return 0
+	}
+	return s[0]
+}
+
+// afterPrefix returns s with the first n bytes removed.
+func afterPrefix(s string, n int) string {
+	if len(s) < n {
+		return ""
+	}
+	return s[n:]
+}
@@ -0,0 +1,15 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd
+
+package pgdesc
+
+import (
+	"io"
+)
+
+// termWidth returns the width of the terminal for w, and whether w is a
+// terminal.
+//
+// Terminal detection is not supported on this platform.
+func termWidth(w io.Writer) (int, bool) {
+	return 0, false
+}
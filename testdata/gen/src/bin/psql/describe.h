@@ -0,0 +1,24 @@
+/*
+ *	psql - the PostgreSQL interactive terminal
+ *
+ *	Copyright (c) 2000-2024, PostgreSQL Global Development Group
+ *
+ *	src/bin/psql/describe.h
+ */
+#ifndef DESCRIBE_H
+#define DESCRIBE_H
+
+
+/* \dA */
+extern bool describeAccessMethods(const char *pattern, bool verbose);
+
+/* \dD */
+extern bool listDomains(const char *pattern, bool verbose, bool showSystem);
+
+/* \dL */
+extern bool listLanguages(const char *pattern, bool verbose, bool showSystem);
+
+/* \dn */
+extern bool listSchemas(const char *pattern, bool verbose, bool showSystem);
+
+#endif							/* DESCRIBE_H */
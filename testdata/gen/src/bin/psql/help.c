@@ -0,0 +1,9 @@
+void
+slashUsage(unsigned short int pager)
+{
+	fprintf(output, _("Informational\n"));
+	fprintf(output, _("  \\dA[+]  [PATTERN]      list access methods\n"));
+	fprintf(output, _("  \\dD[S+] [PATTERN]      list domains\n"));
+	fprintf(output, _("  \\dL[S+] [PATTERN]      list procedural languages\n"));
+	fprintf(output, _("  \\dn[S+] [PATTERN]      list schemas\n"));
+}
@@ -0,0 +1,9 @@
+#ifdef EXPOSE_TO_CLIENT_CODE
+
+/*
+ * Allowed values for amtype
+ */
+#define AMTYPE_INDEX					'i' /* index access method */
+#define AMTYPE_TABLE					't' /* table access method */
+
+#endif							/* EXPOSE_TO_CLIENT_CODE */
@@ -0,0 +1,4 @@
+#define		  ATTRIBUTE_IDENTITY_ALWAYS		'a'
+#define		  ATTRIBUTE_IDENTITY_BY_DEFAULT 'd'
+
+#define		  ATTRIBUTE_GENERATED_STORED	's'
@@ -0,0 +1,5 @@
+typedef enum CoercionCodes
+{
+	COERCION_CODE_IMPLICIT = 'i',	/* coercion in context of expression */
+	COERCION_CODE_ASSIGNMENT = 'a', /* coercion in context of assignment */
+} CoercionCodes;
@@ -0,0 +1,4 @@
+#define COLLPROVIDER_DEFAULT	'd'
+#define COLLPROVIDER_BUILTIN	'b'
+#define COLLPROVIDER_ICU		'i'
+#define COLLPROVIDER_LIBC		'c'
@@ -0,0 +1,8 @@
+/* Valid values for contype */
+#define CONSTRAINT_CHECK			'c'
+#define CONSTRAINT_FOREIGN			'f'
+#define CONSTRAINT_NOTNULL			'n'
+#define CONSTRAINT_PRIMARY			'p'
+#define CONSTRAINT_UNIQUE			'u'
+#define CONSTRAINT_TRIGGER			't'
+#define CONSTRAINT_EXCLUSION		'x'
@@ -0,0 +1,2 @@
+#define DEFACLOBJ_RELATION		'r' /* table, view */
+#define DEFACLOBJ_SEQUENCE		'S' /* sequence */
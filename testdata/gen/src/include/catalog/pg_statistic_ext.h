@@ -0,0 +1,4 @@
+#define STATS_EXT_NDISTINCT			'd'
+#define STATS_EXT_DEPENDENCIES		'f'
+#define STATS_EXT_MCV				'm'
+#define STATS_EXT_EXPRESSIONS		'e'
@@ -0,0 +1,26 @@
+/* Bits within tgtype */
+#define TRIGGER_TYPE_ROW				(1 << 0)
+#define TRIGGER_TYPE_BEFORE				(1 << 1)
+#define TRIGGER_TYPE_INSERT				(1 << 2)
+#define TRIGGER_TYPE_DELETE				(1 << 3)
+#define TRIGGER_TYPE_UPDATE				(1 << 4)
+#define TRIGGER_TYPE_TRUNCATE			(1 << 5)
+#define TRIGGER_TYPE_INSTEAD			(1 << 6)
+
+#define TRIGGER_TYPE_LEVEL_MASK			(TRIGGER_TYPE_ROW)
+#define TRIGGER_TYPE_STATEMENT			0
+
+/* Note bits for TRIGGER_TYPE_TIMING_MASK */
+#define TRIGGER_TYPE_TIMING_MASK \
+	(TRIGGER_TYPE_BEFORE | TRIGGER_TYPE_INSTEAD)
+#define TRIGGER_TYPE_AFTER				0
+
+#define TRIGGER_TYPE_EVENT_MASK \
+	(TRIGGER_TYPE_INSERT | TRIGGER_TYPE_DELETE | TRIGGER_TYPE_UPDATE | TRIGGER_TYPE_TRUNCATE)
+
+/* Macros for manipulating tgtype */
+#define TRIGGER_CLEAR_TYPE(type)		((type) = 0)
+
+#define TRIGGER_SETT_ROW(type)			((type) |= TRIGGER_TYPE_ROW)
+#define TRIGGER_TYPE_MATCHES(type, level, timing, event) \
+	(((type) & (TRIGGER_TYPE_LEVEL_MASK | TRIGGER_TYPE_TIMING_MASK | (event))) == ((level) | (timing) | (event)))
@@ -0,0 +1,156 @@
+-- AccessMethods(pattern="", verbose=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support access methods.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support access methods.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support access methods.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support access methods.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support access methods.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support access methods.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support access methods.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support access methods.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support access methods.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support access methods.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support access methods.\n"
+
+-- versions: 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT amname AS "Name",
+  CASE amtype WHEN 'i' THEN 'Index' WHEN 't' THEN 'Table' END AS "Type"
+FROM pg_catalog.pg_am
+ORDER BY 1;
+
+-- AccessMethods(pattern="", verbose=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support access methods.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support access methods.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support access methods.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support access methods.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support access methods.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support access methods.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support access methods.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support access methods.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support access methods.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support access methods.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support access methods.\n"
+
+-- versions: 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT amname AS "Name",
+  CASE amtype WHEN 'i' THEN 'Index' WHEN 't' THEN 'Table' END AS "Type",
+  amhandler AS "Handler",
+  pg_catalog.obj_description(oid, 'pg_am') AS "Description"
+FROM pg_catalog.pg_am
+ORDER BY 1;
+
+-- AccessMethods(pattern="public.foo*", verbose=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support access methods.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support access methods.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support access methods.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support access methods.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support access methods.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support access methods.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support access methods.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support access methods.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support access methods.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support access methods.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support access methods.\n"
+
+-- versions: 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+-- error: "improper qualified name (too many dotted names): public.foo*"
+
+-- AccessMethods(pattern="public.foo*", verbose=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support access methods.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support access methods.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support access methods.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support access methods.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support access methods.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support access methods.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support access methods.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support access methods.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support access methods.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support access methods.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support access methods.\n"
+
+-- versions: 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+-- error: "improper qualified name (too many dotted names): public.foo*"
+
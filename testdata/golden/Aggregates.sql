@@ -0,0 +1,648 @@
+-- Aggregates(pattern="", verbose=false, showSystem=false)
+-- versions: 80000, 80100
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  pg_catalog.format_type(p.proargtypes[0], NULL) AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+  AND pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- versions: 80200, 80300
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        pg_catalog.format_type(p.proargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(0, pg_catalog.array_upper(p.proargtypes, 1)) AS s(i)
+    ), ', ')
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+  AND pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE pg_catalog.pg_get_function_arguments(p.oid)
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+  AND pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- versions: 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE pg_catalog.pg_get_function_arguments(p.oid)
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.prokind = 'a'
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+  AND pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- Aggregates(pattern="", verbose=false, showSystem=true)
+-- versions: 80000, 80100
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  pg_catalog.format_type(p.proargtypes[0], NULL) AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+  AND pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- versions: 80200, 80300
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        pg_catalog.format_type(p.proargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(0, pg_catalog.array_upper(p.proargtypes, 1)) AS s(i)
+    ), ', ')
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+  AND pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE pg_catalog.pg_get_function_arguments(p.oid)
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+  AND pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- versions: 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE pg_catalog.pg_get_function_arguments(p.oid)
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.prokind = 'a'
+  AND pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- Aggregates(pattern="", verbose=true, showSystem=false)
+-- versions: 80000, 80100
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  pg_catalog.format_type(p.proargtypes[0], NULL) AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+  AND pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- versions: 80200, 80300
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        pg_catalog.format_type(p.proargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(0, pg_catalog.array_upper(p.proargtypes, 1)) AS s(i)
+    ), ', ')
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+  AND pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE pg_catalog.pg_get_function_arguments(p.oid)
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+  AND pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- versions: 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE pg_catalog.pg_get_function_arguments(p.oid)
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.prokind = 'a'
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+  AND pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- Aggregates(pattern="", verbose=true, showSystem=true)
+-- versions: 80000, 80100
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  pg_catalog.format_type(p.proargtypes[0], NULL) AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+  AND pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- versions: 80200, 80300
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        pg_catalog.format_type(p.proargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(0, pg_catalog.array_upper(p.proargtypes, 1)) AS s(i)
+    ), ', ')
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+  AND pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE pg_catalog.pg_get_function_arguments(p.oid)
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+  AND pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- versions: 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE pg_catalog.pg_get_function_arguments(p.oid)
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.prokind = 'a'
+  AND pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- Aggregates(pattern="public.foo*", verbose=false, showSystem=false)
+-- versions: 80000, 80100
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  pg_catalog.format_type(p.proargtypes[0], NULL) AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+  AND p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 80200, 80300
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        pg_catalog.format_type(p.proargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(0, pg_catalog.array_upper(p.proargtypes, 1)) AS s(i)
+    ), ', ')
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+  AND p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE pg_catalog.pg_get_function_arguments(p.oid)
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+  AND p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 110000
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE pg_catalog.pg_get_function_arguments(p.oid)
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.prokind = 'a'
+  AND p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE pg_catalog.pg_get_function_arguments(p.oid)
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.prokind = 'a'
+  AND p.proname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- Aggregates(pattern="public.foo*", verbose=false, showSystem=true)
+-- versions: 80000, 80100
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  pg_catalog.format_type(p.proargtypes[0], NULL) AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+  AND p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 80200, 80300
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        pg_catalog.format_type(p.proargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(0, pg_catalog.array_upper(p.proargtypes, 1)) AS s(i)
+    ), ', ')
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+  AND p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE pg_catalog.pg_get_function_arguments(p.oid)
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+  AND p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 110000
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE pg_catalog.pg_get_function_arguments(p.oid)
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.prokind = 'a'
+  AND p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE pg_catalog.pg_get_function_arguments(p.oid)
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.prokind = 'a'
+  AND p.proname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- Aggregates(pattern="public.foo*", verbose=true, showSystem=false)
+-- versions: 80000, 80100
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  pg_catalog.format_type(p.proargtypes[0], NULL) AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+  AND p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 80200, 80300
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        pg_catalog.format_type(p.proargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(0, pg_catalog.array_upper(p.proargtypes, 1)) AS s(i)
+    ), ', ')
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+  AND p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE pg_catalog.pg_get_function_arguments(p.oid)
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+  AND p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 110000
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE pg_catalog.pg_get_function_arguments(p.oid)
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.prokind = 'a'
+  AND p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE pg_catalog.pg_get_function_arguments(p.oid)
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.prokind = 'a'
+  AND p.proname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- Aggregates(pattern="public.foo*", verbose=true, showSystem=true)
+-- versions: 80000, 80100
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  pg_catalog.format_type(p.proargtypes[0], NULL) AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+  AND p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 80200, 80300
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        pg_catalog.format_type(p.proargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(0, pg_catalog.array_upper(p.proargtypes, 1)) AS s(i)
+    ), ', ')
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+  AND p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE pg_catalog.pg_get_function_arguments(p.oid)
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proisagg
+  AND p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 110000
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE pg_catalog.pg_get_function_arguments(p.oid)
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.prokind = 'a'
+  AND p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname AS "Name",
+  pg_catalog.format_type(p.prorettype, NULL) AS "Result data type",
+  CASE WHEN p.pronargs = 0
+    THEN CAST('*' AS pg_catalog.text)
+    ELSE pg_catalog.pg_get_function_arguments(p.oid)
+  END AS "Argument data types",
+  pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.prokind = 'a'
+  AND p.proname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
@@ -0,0 +1,335 @@
+-- Casts(pattern="", verbose=false)
+-- versions: 80000, 80100, 80200, 80300
+SELECT pg_catalog.format_type(castsource, NULL) AS "Source type",
+       pg_catalog.format_type(casttarget, NULL) AS "Target type",
+       CASE WHEN c.castfunc = 0 THEN '(binary coercible)'
+            ELSE p.proname
+       END AS "Function",
+       CASE WHEN c.castcontext = 'e' THEN 'no'
+            WHEN c.castcontext = 'a' THEN 'in assignment'
+            ELSE 'yes'
+       END AS "Implicit?"
+FROM pg_catalog.pg_cast c LEFT JOIN pg_catalog.pg_proc p
+     ON c.castfunc = p.oid
+     LEFT JOIN pg_catalog.pg_type ts
+     ON c.castsource = ts.oid
+     LEFT JOIN pg_catalog.pg_namespace ns
+     ON ns.oid = ts.typnamespace
+     LEFT JOIN pg_catalog.pg_type tt
+     ON c.casttarget = tt.oid
+     LEFT JOIN pg_catalog.pg_namespace nt
+     ON nt.oid = tt.typnamespace
+WHERE ( (true  AND pg_catalog.pg_type_is_visible(ts.oid)
+) OR (true  AND pg_catalog.pg_type_is_visible(tt.oid)
+) )
+ORDER BY 1, 2;
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT pg_catalog.format_type(castsource, NULL) AS "Source type",
+       pg_catalog.format_type(casttarget, NULL) AS "Target type",
+       CASE WHEN c.castmethod = 'b' THEN '(binary coercible)'
+            WHEN c.castmethod = 'i' THEN '(with inout)'
+            ELSE p.proname
+       END AS "Function",
+       CASE WHEN c.castcontext = 'e' THEN 'no'
+            WHEN c.castcontext = 'a' THEN 'in assignment'
+            ELSE 'yes'
+       END AS "Implicit?"
+FROM pg_catalog.pg_cast c LEFT JOIN pg_catalog.pg_proc p
+     ON c.castfunc = p.oid
+     LEFT JOIN pg_catalog.pg_type ts
+     ON c.castsource = ts.oid
+     LEFT JOIN pg_catalog.pg_namespace ns
+     ON ns.oid = ts.typnamespace
+     LEFT JOIN pg_catalog.pg_type tt
+     ON c.casttarget = tt.oid
+     LEFT JOIN pg_catalog.pg_namespace nt
+     ON nt.oid = tt.typnamespace
+WHERE ( (true  AND pg_catalog.pg_type_is_visible(ts.oid)
+) OR (true  AND pg_catalog.pg_type_is_visible(tt.oid)
+) )
+ORDER BY 1, 2;
+
+-- Casts(pattern="", verbose=true)
+-- versions: 80000, 80100, 80200, 80300
+SELECT pg_catalog.format_type(castsource, NULL) AS "Source type",
+       pg_catalog.format_type(casttarget, NULL) AS "Target type",
+       CASE WHEN c.castfunc = 0 THEN '(binary coercible)'
+            ELSE p.proname
+       END AS "Function",
+       CASE WHEN c.castcontext = 'e' THEN 'no'
+            WHEN c.castcontext = 'a' THEN 'in assignment'
+            ELSE 'yes'
+       END AS "Implicit?",
+       d.description AS "Description"
+FROM pg_catalog.pg_cast c LEFT JOIN pg_catalog.pg_proc p
+     ON c.castfunc = p.oid
+     LEFT JOIN pg_catalog.pg_type ts
+     ON c.castsource = ts.oid
+     LEFT JOIN pg_catalog.pg_namespace ns
+     ON ns.oid = ts.typnamespace
+     LEFT JOIN pg_catalog.pg_type tt
+     ON c.casttarget = tt.oid
+     LEFT JOIN pg_catalog.pg_namespace nt
+     ON nt.oid = tt.typnamespace
+     LEFT JOIN pg_catalog.pg_description d
+     ON d.classoid = c.tableoid AND d.objoid = c.oid AND d.objsubid = 0
+WHERE ( (true  AND pg_catalog.pg_type_is_visible(ts.oid)
+) OR (true  AND pg_catalog.pg_type_is_visible(tt.oid)
+) )
+ORDER BY 1, 2;
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT pg_catalog.format_type(castsource, NULL) AS "Source type",
+       pg_catalog.format_type(casttarget, NULL) AS "Target type",
+       CASE WHEN c.castmethod = 'b' THEN '(binary coercible)'
+            WHEN c.castmethod = 'i' THEN '(with inout)'
+            ELSE p.proname
+       END AS "Function",
+       CASE WHEN c.castcontext = 'e' THEN 'no'
+            WHEN c.castcontext = 'a' THEN 'in assignment'
+            ELSE 'yes'
+       END AS "Implicit?",
+       d.description AS "Description"
+FROM pg_catalog.pg_cast c LEFT JOIN pg_catalog.pg_proc p
+     ON c.castfunc = p.oid
+     LEFT JOIN pg_catalog.pg_type ts
+     ON c.castsource = ts.oid
+     LEFT JOIN pg_catalog.pg_namespace ns
+     ON ns.oid = ts.typnamespace
+     LEFT JOIN pg_catalog.pg_type tt
+     ON c.casttarget = tt.oid
+     LEFT JOIN pg_catalog.pg_namespace nt
+     ON nt.oid = tt.typnamespace
+     LEFT JOIN pg_catalog.pg_description d
+     ON d.classoid = c.tableoid AND d.objoid = c.oid AND d.objsubid = 0
+WHERE ( (true  AND pg_catalog.pg_type_is_visible(ts.oid)
+) OR (true  AND pg_catalog.pg_type_is_visible(tt.oid)
+) )
+ORDER BY 1, 2;
+
+-- Casts(pattern="public.foo*", verbose=false)
+-- versions: 80000, 80100, 80200, 80300
+SELECT pg_catalog.format_type(castsource, NULL) AS "Source type",
+       pg_catalog.format_type(casttarget, NULL) AS "Target type",
+       CASE WHEN c.castfunc = 0 THEN '(binary coercible)'
+            ELSE p.proname
+       END AS "Function",
+       CASE WHEN c.castcontext = 'e' THEN 'no'
+            WHEN c.castcontext = 'a' THEN 'in assignment'
+            ELSE 'yes'
+       END AS "Implicit?"
+FROM pg_catalog.pg_cast c LEFT JOIN pg_catalog.pg_proc p
+     ON c.castfunc = p.oid
+     LEFT JOIN pg_catalog.pg_type ts
+     ON c.castsource = ts.oid
+     LEFT JOIN pg_catalog.pg_namespace ns
+     ON ns.oid = ts.typnamespace
+     LEFT JOIN pg_catalog.pg_type tt
+     ON c.casttarget = tt.oid
+     LEFT JOIN pg_catalog.pg_namespace nt
+     ON nt.oid = tt.typnamespace
+WHERE ( (true  AND (ts.typname OPERATOR(pg_catalog.~) $1
+        OR pg_catalog.format_type(ts.oid, NULL) OPERATOR(pg_catalog.~) $2)
+  AND ns.nspname OPERATOR(pg_catalog.~) $3
+) OR (true  AND (tt.typname OPERATOR(pg_catalog.~) $4
+        OR pg_catalog.format_type(tt.oid, NULL) OPERATOR(pg_catalog.~) $5)
+  AND nt.nspname OPERATOR(pg_catalog.~) $6
+) )
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(foo.*)$"
+-- $3 = "^(public)$"
+-- $4 = "^(foo.*)$"
+-- $5 = "^(foo.*)$"
+-- $6 = "^(public)$"
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000
+SELECT pg_catalog.format_type(castsource, NULL) AS "Source type",
+       pg_catalog.format_type(casttarget, NULL) AS "Target type",
+       CASE WHEN c.castmethod = 'b' THEN '(binary coercible)'
+            WHEN c.castmethod = 'i' THEN '(with inout)'
+            ELSE p.proname
+       END AS "Function",
+       CASE WHEN c.castcontext = 'e' THEN 'no'
+            WHEN c.castcontext = 'a' THEN 'in assignment'
+            ELSE 'yes'
+       END AS "Implicit?"
+FROM pg_catalog.pg_cast c LEFT JOIN pg_catalog.pg_proc p
+     ON c.castfunc = p.oid
+     LEFT JOIN pg_catalog.pg_type ts
+     ON c.castsource = ts.oid
+     LEFT JOIN pg_catalog.pg_namespace ns
+     ON ns.oid = ts.typnamespace
+     LEFT JOIN pg_catalog.pg_type tt
+     ON c.casttarget = tt.oid
+     LEFT JOIN pg_catalog.pg_namespace nt
+     ON nt.oid = tt.typnamespace
+WHERE ( (true  AND (ts.typname OPERATOR(pg_catalog.~) $1
+        OR pg_catalog.format_type(ts.oid, NULL) OPERATOR(pg_catalog.~) $2)
+  AND ns.nspname OPERATOR(pg_catalog.~) $3
+) OR (true  AND (tt.typname OPERATOR(pg_catalog.~) $4
+        OR pg_catalog.format_type(tt.oid, NULL) OPERATOR(pg_catalog.~) $5)
+  AND nt.nspname OPERATOR(pg_catalog.~) $6
+) )
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(foo.*)$"
+-- $3 = "^(public)$"
+-- $4 = "^(foo.*)$"
+-- $5 = "^(foo.*)$"
+-- $6 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT pg_catalog.format_type(castsource, NULL) AS "Source type",
+       pg_catalog.format_type(casttarget, NULL) AS "Target type",
+       CASE WHEN c.castmethod = 'b' THEN '(binary coercible)'
+            WHEN c.castmethod = 'i' THEN '(with inout)'
+            ELSE p.proname
+       END AS "Function",
+       CASE WHEN c.castcontext = 'e' THEN 'no'
+            WHEN c.castcontext = 'a' THEN 'in assignment'
+            ELSE 'yes'
+       END AS "Implicit?"
+FROM pg_catalog.pg_cast c LEFT JOIN pg_catalog.pg_proc p
+     ON c.castfunc = p.oid
+     LEFT JOIN pg_catalog.pg_type ts
+     ON c.castsource = ts.oid
+     LEFT JOIN pg_catalog.pg_namespace ns
+     ON ns.oid = ts.typnamespace
+     LEFT JOIN pg_catalog.pg_type tt
+     ON c.casttarget = tt.oid
+     LEFT JOIN pg_catalog.pg_namespace nt
+     ON nt.oid = tt.typnamespace
+WHERE ( (true  AND (ts.typname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+        OR pg_catalog.format_type(ts.oid, NULL) OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default)
+  AND ns.nspname OPERATOR(pg_catalog.~) $3 COLLATE pg_catalog.default
+) OR (true  AND (tt.typname OPERATOR(pg_catalog.~) $4 COLLATE pg_catalog.default
+        OR pg_catalog.format_type(tt.oid, NULL) OPERATOR(pg_catalog.~) $5 COLLATE pg_catalog.default)
+  AND nt.nspname OPERATOR(pg_catalog.~) $6 COLLATE pg_catalog.default
+) )
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(foo.*)$"
+-- $3 = "^(public)$"
+-- $4 = "^(foo.*)$"
+-- $5 = "^(foo.*)$"
+-- $6 = "^(public)$"
+
+-- Casts(pattern="public.foo*", verbose=true)
+-- versions: 80000, 80100, 80200, 80300
+SELECT pg_catalog.format_type(castsource, NULL) AS "Source type",
+       pg_catalog.format_type(casttarget, NULL) AS "Target type",
+       CASE WHEN c.castfunc = 0 THEN '(binary coercible)'
+            ELSE p.proname
+       END AS "Function",
+       CASE WHEN c.castcontext = 'e' THEN 'no'
+            WHEN c.castcontext = 'a' THEN 'in assignment'
+            ELSE 'yes'
+       END AS "Implicit?",
+       d.description AS "Description"
+FROM pg_catalog.pg_cast c LEFT JOIN pg_catalog.pg_proc p
+     ON c.castfunc = p.oid
+     LEFT JOIN pg_catalog.pg_type ts
+     ON c.castsource = ts.oid
+     LEFT JOIN pg_catalog.pg_namespace ns
+     ON ns.oid = ts.typnamespace
+     LEFT JOIN pg_catalog.pg_type tt
+     ON c.casttarget = tt.oid
+     LEFT JOIN pg_catalog.pg_namespace nt
+     ON nt.oid = tt.typnamespace
+     LEFT JOIN pg_catalog.pg_description d
+     ON d.classoid = c.tableoid AND d.objoid = c.oid AND d.objsubid = 0
+WHERE ( (true  AND (ts.typname OPERATOR(pg_catalog.~) $1
+        OR pg_catalog.format_type(ts.oid, NULL) OPERATOR(pg_catalog.~) $2)
+  AND ns.nspname OPERATOR(pg_catalog.~) $3
+) OR (true  AND (tt.typname OPERATOR(pg_catalog.~) $4
+        OR pg_catalog.format_type(tt.oid, NULL) OPERATOR(pg_catalog.~) $5)
+  AND nt.nspname OPERATOR(pg_catalog.~) $6
+) )
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(foo.*)$"
+-- $3 = "^(public)$"
+-- $4 = "^(foo.*)$"
+-- $5 = "^(foo.*)$"
+-- $6 = "^(public)$"
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000
+SELECT pg_catalog.format_type(castsource, NULL) AS "Source type",
+       pg_catalog.format_type(casttarget, NULL) AS "Target type",
+       CASE WHEN c.castmethod = 'b' THEN '(binary coercible)'
+            WHEN c.castmethod = 'i' THEN '(with inout)'
+            ELSE p.proname
+       END AS "Function",
+       CASE WHEN c.castcontext = 'e' THEN 'no'
+            WHEN c.castcontext = 'a' THEN 'in assignment'
+            ELSE 'yes'
+       END AS "Implicit?",
+       d.description AS "Description"
+FROM pg_catalog.pg_cast c LEFT JOIN pg_catalog.pg_proc p
+     ON c.castfunc = p.oid
+     LEFT JOIN pg_catalog.pg_type ts
+     ON c.castsource = ts.oid
+     LEFT JOIN pg_catalog.pg_namespace ns
+     ON ns.oid = ts.typnamespace
+     LEFT JOIN pg_catalog.pg_type tt
+     ON c.casttarget = tt.oid
+     LEFT JOIN pg_catalog.pg_namespace nt
+     ON nt.oid = tt.typnamespace
+     LEFT JOIN pg_catalog.pg_description d
+     ON d.classoid = c.tableoid AND d.objoid = c.oid AND d.objsubid = 0
+WHERE ( (true  AND (ts.typname OPERATOR(pg_catalog.~) $1
+        OR pg_catalog.format_type(ts.oid, NULL) OPERATOR(pg_catalog.~) $2)
+  AND ns.nspname OPERATOR(pg_catalog.~) $3
+) OR (true  AND (tt.typname OPERATOR(pg_catalog.~) $4
+        OR pg_catalog.format_type(tt.oid, NULL) OPERATOR(pg_catalog.~) $5)
+  AND nt.nspname OPERATOR(pg_catalog.~) $6
+) )
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(foo.*)$"
+-- $3 = "^(public)$"
+-- $4 = "^(foo.*)$"
+-- $5 = "^(foo.*)$"
+-- $6 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT pg_catalog.format_type(castsource, NULL) AS "Source type",
+       pg_catalog.format_type(casttarget, NULL) AS "Target type",
+       CASE WHEN c.castmethod = 'b' THEN '(binary coercible)'
+            WHEN c.castmethod = 'i' THEN '(with inout)'
+            ELSE p.proname
+       END AS "Function",
+       CASE WHEN c.castcontext = 'e' THEN 'no'
+            WHEN c.castcontext = 'a' THEN 'in assignment'
+            ELSE 'yes'
+       END AS "Implicit?",
+       d.description AS "Description"
+FROM pg_catalog.pg_cast c LEFT JOIN pg_catalog.pg_proc p
+     ON c.castfunc = p.oid
+     LEFT JOIN pg_catalog.pg_type ts
+     ON c.castsource = ts.oid
+     LEFT JOIN pg_catalog.pg_namespace ns
+     ON ns.oid = ts.typnamespace
+     LEFT JOIN pg_catalog.pg_type tt
+     ON c.casttarget = tt.oid
+     LEFT JOIN pg_catalog.pg_namespace nt
+     ON nt.oid = tt.typnamespace
+     LEFT JOIN pg_catalog.pg_description d
+     ON d.classoid = c.tableoid AND d.objoid = c.oid AND d.objsubid = 0
+WHERE ( (true  AND (ts.typname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+        OR pg_catalog.format_type(ts.oid, NULL) OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default)
+  AND ns.nspname OPERATOR(pg_catalog.~) $3 COLLATE pg_catalog.default
+) OR (true  AND (tt.typname OPERATOR(pg_catalog.~) $4 COLLATE pg_catalog.default
+        OR pg_catalog.format_type(tt.oid, NULL) OPERATOR(pg_catalog.~) $5 COLLATE pg_catalog.default)
+  AND nt.nspname OPERATOR(pg_catalog.~) $6 COLLATE pg_catalog.default
+) )
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(foo.*)$"
+-- $3 = "^(public)$"
+-- $4 = "^(foo.*)$"
+-- $5 = "^(foo.*)$"
+-- $6 = "^(public)$"
+
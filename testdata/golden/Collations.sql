@@ -0,0 +1,912 @@
+-- Collations(pattern="", verbose=false, showSystem=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support collations.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support collations.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support collations.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support collations.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support collations.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support collations.\n"
+
+-- versions: 90100, 90200, 90300, 90400, 90500, 90600
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- versions: 100000, 110000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- versions: 120000, 130000, 140000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- versions: 150000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colliculocale AS "Locale",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- versions: 160000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colliculocale AS "Locale",
+       c.collicurules AS "ICU Rules",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- versions: 170000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colllocale AS "Locale",
+       c.collicurules AS "ICU Rules",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- Collations(pattern="", verbose=false, showSystem=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support collations.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support collations.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support collations.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support collations.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support collations.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support collations.\n"
+
+-- versions: 90100, 90200, 90300, 90400, 90500, 90600
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- versions: 100000, 110000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- versions: 120000, 130000, 140000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- versions: 150000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colliculocale AS "Locale",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- versions: 160000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colliculocale AS "Locale",
+       c.collicurules AS "ICU Rules",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- versions: 170000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colllocale AS "Locale",
+       c.collicurules AS "ICU Rules",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- Collations(pattern="", verbose=true, showSystem=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support collations.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support collations.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support collations.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support collations.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support collations.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support collations.\n"
+
+-- versions: 90100, 90200, 90300, 90400, 90500, 90600
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- versions: 100000, 110000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- versions: 120000, 130000, 140000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- versions: 150000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colliculocale AS "Locale",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- versions: 160000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colliculocale AS "Locale",
+       c.collicurules AS "ICU Rules",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- versions: 170000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colllocale AS "Locale",
+       c.collicurules AS "ICU Rules",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- Collations(pattern="", verbose=true, showSystem=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support collations.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support collations.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support collations.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support collations.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support collations.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support collations.\n"
+
+-- versions: 90100, 90200, 90300, 90400, 90500, 90600
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- versions: 100000, 110000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- versions: 120000, 130000, 140000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- versions: 150000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colliculocale AS "Locale",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- versions: 160000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colliculocale AS "Locale",
+       c.collicurules AS "ICU Rules",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- versions: 170000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colllocale AS "Locale",
+       c.collicurules AS "ICU Rules",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND pg_catalog.pg_collation_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- Collations(pattern="public.foo*", verbose=false, showSystem=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support collations.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support collations.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support collations.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support collations.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support collations.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support collations.\n"
+
+-- versions: 90100, 90200, 90300, 90400, 90500, 90600
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 100000, 110000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 150000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colliculocale AS "Locale",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 160000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colliculocale AS "Locale",
+       c.collicurules AS "ICU Rules",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 170000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colllocale AS "Locale",
+       c.collicurules AS "ICU Rules",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- Collations(pattern="public.foo*", verbose=false, showSystem=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support collations.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support collations.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support collations.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support collations.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support collations.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support collations.\n"
+
+-- versions: 90100, 90200, 90300, 90400, 90500, 90600
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 100000, 110000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 150000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colliculocale AS "Locale",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 160000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colliculocale AS "Locale",
+       c.collicurules AS "ICU Rules",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 170000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colllocale AS "Locale",
+       c.collicurules AS "ICU Rules",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- Collations(pattern="public.foo*", verbose=true, showSystem=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support collations.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support collations.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support collations.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support collations.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support collations.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support collations.\n"
+
+-- versions: 90100, 90200, 90300, 90400, 90500, 90600
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 100000, 110000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 150000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colliculocale AS "Locale",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 160000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colliculocale AS "Locale",
+       c.collicurules AS "ICU Rules",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 170000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colllocale AS "Locale",
+       c.collicurules AS "ICU Rules",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- Collations(pattern="public.foo*", verbose=true, showSystem=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support collations.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support collations.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support collations.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support collations.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support collations.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support collations.\n"
+
+-- versions: 90100, 90200, 90300, 90400, 90500, 90600
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 100000, 110000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 150000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colliculocale AS "Locale",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 160000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colliculocale AS "Locale",
+       c.collicurules AS "ICU Rules",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 170000
+SELECT n.nspname AS "Schema",
+       c.collname AS "Name",
+       c.collcollate AS "Collate",
+       c.collctype AS "Ctype",
+       CASE c.collprovider WHEN 'b' THEN 'builtin' WHEN 'd' THEN 'default' WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Provider",
+       c.colllocale AS "Locale",
+       c.collicurules AS "ICU Rules",
+       CASE WHEN c.collisdeterministic THEN 'yes' ELSE 'no' END AS "Deterministic?",
+       pg_catalog.obj_description(c.oid, 'pg_collation') AS "Description"
+FROM pg_catalog.pg_collation c, pg_catalog.pg_namespace n
+WHERE n.oid = c.collnamespace
+      AND c.collencoding IN (-1, pg_catalog.pg_char_to_encoding(pg_catalog.getdatabaseencoding()))
+  AND c.collname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
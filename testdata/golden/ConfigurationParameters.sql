@@ -0,0 +1,128 @@
+-- ConfigurationParameters(pattern="", verbose=false, showSystem=false)
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT s.name AS "Parameter", pg_catalog.current_setting(s.name) AS "Value"
+FROM pg_catalog.pg_settings s
+WHERE s.source <> 'default' AND
+      s.setting IS DISTINCT FROM s.boot_val
+ORDER BY 1;
+
+-- ConfigurationParameters(pattern="", verbose=false, showSystem=true)
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT s.name AS "Parameter", pg_catalog.current_setting(s.name) AS "Value"
+FROM pg_catalog.pg_settings s
+WHERE s.source <> 'default' AND
+      s.setting IS DISTINCT FROM s.boot_val
+ORDER BY 1;
+
+-- ConfigurationParameters(pattern="", verbose=true, showSystem=false)
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000
+SELECT s.name AS "Parameter", pg_catalog.current_setting(s.name) AS "Value", s.vartype AS "Type", s.context AS "Context", NULL AS "Access privileges"
+FROM pg_catalog.pg_settings s
+WHERE s.source <> 'default' AND
+      s.setting IS DISTINCT FROM s.boot_val
+ORDER BY 1;
+
+-- versions: 150000, 160000, 170000
+SELECT s.name AS "Parameter", pg_catalog.current_setting(s.name) AS "Value", s.vartype AS "Type", s.context AS "Context", pg_catalog.array_to_string(p.paracl, E'\n') AS "Access privileges"
+FROM pg_catalog.pg_settings s
+  LEFT JOIN pg_catalog.pg_parameter_acl p
+  ON pg_catalog.lower(s.name) = p.parname
+WHERE s.source <> 'default' AND
+      s.setting IS DISTINCT FROM s.boot_val
+ORDER BY 1;
+
+-- ConfigurationParameters(pattern="", verbose=true, showSystem=true)
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000
+SELECT s.name AS "Parameter", pg_catalog.current_setting(s.name) AS "Value", s.vartype AS "Type", s.context AS "Context", NULL AS "Access privileges"
+FROM pg_catalog.pg_settings s
+WHERE s.source <> 'default' AND
+      s.setting IS DISTINCT FROM s.boot_val
+ORDER BY 1;
+
+-- versions: 150000, 160000, 170000
+SELECT s.name AS "Parameter", pg_catalog.current_setting(s.name) AS "Value", s.vartype AS "Type", s.context AS "Context", pg_catalog.array_to_string(p.paracl, E'\n') AS "Access privileges"
+FROM pg_catalog.pg_settings s
+  LEFT JOIN pg_catalog.pg_parameter_acl p
+  ON pg_catalog.lower(s.name) = p.parname
+WHERE s.source <> 'default' AND
+      s.setting IS DISTINCT FROM s.boot_val
+ORDER BY 1;
+
+-- ConfigurationParameters(pattern="public.foo*", verbose=false, showSystem=false)
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000
+SELECT s.name AS "Parameter", pg_catalog.current_setting(s.name) AS "Value"
+FROM pg_catalog.pg_settings s
+WHERE pg_catalog.lower(s.name) OPERATOR(pg_catalog.~) $1
+ORDER BY 1;
+-- $1 = "^(public.foo.*)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT s.name AS "Parameter", pg_catalog.current_setting(s.name) AS "Value"
+FROM pg_catalog.pg_settings s
+WHERE pg_catalog.lower(s.name) OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+ORDER BY 1;
+-- $1 = "^(public.foo.*)$"
+
+-- ConfigurationParameters(pattern="public.foo*", verbose=false, showSystem=true)
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000
+SELECT s.name AS "Parameter", pg_catalog.current_setting(s.name) AS "Value"
+FROM pg_catalog.pg_settings s
+WHERE pg_catalog.lower(s.name) OPERATOR(pg_catalog.~) $1
+ORDER BY 1;
+-- $1 = "^(public.foo.*)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT s.name AS "Parameter", pg_catalog.current_setting(s.name) AS "Value"
+FROM pg_catalog.pg_settings s
+WHERE pg_catalog.lower(s.name) OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+ORDER BY 1;
+-- $1 = "^(public.foo.*)$"
+
+-- ConfigurationParameters(pattern="public.foo*", verbose=true, showSystem=false)
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000
+SELECT s.name AS "Parameter", pg_catalog.current_setting(s.name) AS "Value", s.vartype AS "Type", s.context AS "Context", NULL AS "Access privileges"
+FROM pg_catalog.pg_settings s
+WHERE pg_catalog.lower(s.name) OPERATOR(pg_catalog.~) $1
+ORDER BY 1;
+-- $1 = "^(public.foo.*)$"
+
+-- versions: 120000, 130000, 140000
+SELECT s.name AS "Parameter", pg_catalog.current_setting(s.name) AS "Value", s.vartype AS "Type", s.context AS "Context", NULL AS "Access privileges"
+FROM pg_catalog.pg_settings s
+WHERE pg_catalog.lower(s.name) OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+ORDER BY 1;
+-- $1 = "^(public.foo.*)$"
+
+-- versions: 150000, 160000, 170000
+SELECT s.name AS "Parameter", pg_catalog.current_setting(s.name) AS "Value", s.vartype AS "Type", s.context AS "Context", pg_catalog.array_to_string(p.paracl, E'\n') AS "Access privileges"
+FROM pg_catalog.pg_settings s
+  LEFT JOIN pg_catalog.pg_parameter_acl p
+  ON pg_catalog.lower(s.name) = p.parname
+WHERE pg_catalog.lower(s.name) OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+ORDER BY 1;
+-- $1 = "^(public.foo.*)$"
+
+-- ConfigurationParameters(pattern="public.foo*", verbose=true, showSystem=true)
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000
+SELECT s.name AS "Parameter", pg_catalog.current_setting(s.name) AS "Value", s.vartype AS "Type", s.context AS "Context", NULL AS "Access privileges"
+FROM pg_catalog.pg_settings s
+WHERE pg_catalog.lower(s.name) OPERATOR(pg_catalog.~) $1
+ORDER BY 1;
+-- $1 = "^(public.foo.*)$"
+
+-- versions: 120000, 130000, 140000
+SELECT s.name AS "Parameter", pg_catalog.current_setting(s.name) AS "Value", s.vartype AS "Type", s.context AS "Context", NULL AS "Access privileges"
+FROM pg_catalog.pg_settings s
+WHERE pg_catalog.lower(s.name) OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+ORDER BY 1;
+-- $1 = "^(public.foo.*)$"
+
+-- versions: 150000, 160000, 170000
+SELECT s.name AS "Parameter", pg_catalog.current_setting(s.name) AS "Value", s.vartype AS "Type", s.context AS "Context", pg_catalog.array_to_string(p.paracl, E'\n') AS "Access privileges"
+FROM pg_catalog.pg_settings s
+  LEFT JOIN pg_catalog.pg_parameter_acl p
+  ON pg_catalog.lower(s.name) = p.parname
+WHERE pg_catalog.lower(s.name) OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+ORDER BY 1;
+-- $1 = "^(public.foo.*)$"
+
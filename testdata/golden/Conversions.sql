@@ -0,0 +1,210 @@
+-- Conversions(pattern="", verbose=false, showSystem=false)
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname AS "Schema",
+       c.conname AS "Name",
+       pg_catalog.pg_encoding_to_char(c.conforencoding) AS "Source",
+       pg_catalog.pg_encoding_to_char(c.contoencoding) AS "Destination",
+       CASE WHEN c.condefault THEN 'yes'
+       ELSE 'no' END AS "Default?"
+FROM pg_catalog.pg_conversion c
+     JOIN pg_catalog.pg_namespace n ON n.oid = c.connamespace
+WHERE true
+  AND n.nspname <> 'pg_catalog'
+  AND n.nspname <> 'information_schema'
+  AND pg_catalog.pg_conversion_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- Conversions(pattern="", verbose=false, showSystem=true)
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname AS "Schema",
+       c.conname AS "Name",
+       pg_catalog.pg_encoding_to_char(c.conforencoding) AS "Source",
+       pg_catalog.pg_encoding_to_char(c.contoencoding) AS "Destination",
+       CASE WHEN c.condefault THEN 'yes'
+       ELSE 'no' END AS "Default?"
+FROM pg_catalog.pg_conversion c
+     JOIN pg_catalog.pg_namespace n ON n.oid = c.connamespace
+WHERE true
+  AND pg_catalog.pg_conversion_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- Conversions(pattern="", verbose=true, showSystem=false)
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname AS "Schema",
+       c.conname AS "Name",
+       pg_catalog.pg_encoding_to_char(c.conforencoding) AS "Source",
+       pg_catalog.pg_encoding_to_char(c.contoencoding) AS "Destination",
+       CASE WHEN c.condefault THEN 'yes'
+       ELSE 'no' END AS "Default?",
+       d.description AS "Description"
+FROM pg_catalog.pg_conversion c
+     JOIN pg_catalog.pg_namespace n ON n.oid = c.connamespace
+LEFT JOIN pg_catalog.pg_description d ON d.classoid = c.tableoid
+          AND d.objoid = c.oid AND d.objsubid = 0
+WHERE true
+  AND n.nspname <> 'pg_catalog'
+  AND n.nspname <> 'information_schema'
+  AND pg_catalog.pg_conversion_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- Conversions(pattern="", verbose=true, showSystem=true)
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname AS "Schema",
+       c.conname AS "Name",
+       pg_catalog.pg_encoding_to_char(c.conforencoding) AS "Source",
+       pg_catalog.pg_encoding_to_char(c.contoencoding) AS "Destination",
+       CASE WHEN c.condefault THEN 'yes'
+       ELSE 'no' END AS "Default?",
+       d.description AS "Description"
+FROM pg_catalog.pg_conversion c
+     JOIN pg_catalog.pg_namespace n ON n.oid = c.connamespace
+LEFT JOIN pg_catalog.pg_description d ON d.classoid = c.tableoid
+          AND d.objoid = c.oid AND d.objsubid = 0
+WHERE true
+  AND pg_catalog.pg_conversion_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- Conversions(pattern="public.foo*", verbose=false, showSystem=false)
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000
+SELECT n.nspname AS "Schema",
+       c.conname AS "Name",
+       pg_catalog.pg_encoding_to_char(c.conforencoding) AS "Source",
+       pg_catalog.pg_encoding_to_char(c.contoencoding) AS "Destination",
+       CASE WHEN c.condefault THEN 'yes'
+       ELSE 'no' END AS "Default?"
+FROM pg_catalog.pg_conversion c
+     JOIN pg_catalog.pg_namespace n ON n.oid = c.connamespace
+WHERE true
+  AND c.conname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname AS "Schema",
+       c.conname AS "Name",
+       pg_catalog.pg_encoding_to_char(c.conforencoding) AS "Source",
+       pg_catalog.pg_encoding_to_char(c.contoencoding) AS "Destination",
+       CASE WHEN c.condefault THEN 'yes'
+       ELSE 'no' END AS "Default?"
+FROM pg_catalog.pg_conversion c
+     JOIN pg_catalog.pg_namespace n ON n.oid = c.connamespace
+WHERE true
+  AND c.conname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- Conversions(pattern="public.foo*", verbose=false, showSystem=true)
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000
+SELECT n.nspname AS "Schema",
+       c.conname AS "Name",
+       pg_catalog.pg_encoding_to_char(c.conforencoding) AS "Source",
+       pg_catalog.pg_encoding_to_char(c.contoencoding) AS "Destination",
+       CASE WHEN c.condefault THEN 'yes'
+       ELSE 'no' END AS "Default?"
+FROM pg_catalog.pg_conversion c
+     JOIN pg_catalog.pg_namespace n ON n.oid = c.connamespace
+WHERE true
+  AND c.conname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname AS "Schema",
+       c.conname AS "Name",
+       pg_catalog.pg_encoding_to_char(c.conforencoding) AS "Source",
+       pg_catalog.pg_encoding_to_char(c.contoencoding) AS "Destination",
+       CASE WHEN c.condefault THEN 'yes'
+       ELSE 'no' END AS "Default?"
+FROM pg_catalog.pg_conversion c
+     JOIN pg_catalog.pg_namespace n ON n.oid = c.connamespace
+WHERE true
+  AND c.conname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- Conversions(pattern="public.foo*", verbose=true, showSystem=false)
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000
+SELECT n.nspname AS "Schema",
+       c.conname AS "Name",
+       pg_catalog.pg_encoding_to_char(c.conforencoding) AS "Source",
+       pg_catalog.pg_encoding_to_char(c.contoencoding) AS "Destination",
+       CASE WHEN c.condefault THEN 'yes'
+       ELSE 'no' END AS "Default?",
+       d.description AS "Description"
+FROM pg_catalog.pg_conversion c
+     JOIN pg_catalog.pg_namespace n ON n.oid = c.connamespace
+LEFT JOIN pg_catalog.pg_description d ON d.classoid = c.tableoid
+          AND d.objoid = c.oid AND d.objsubid = 0
+WHERE true
+  AND c.conname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname AS "Schema",
+       c.conname AS "Name",
+       pg_catalog.pg_encoding_to_char(c.conforencoding) AS "Source",
+       pg_catalog.pg_encoding_to_char(c.contoencoding) AS "Destination",
+       CASE WHEN c.condefault THEN 'yes'
+       ELSE 'no' END AS "Default?",
+       d.description AS "Description"
+FROM pg_catalog.pg_conversion c
+     JOIN pg_catalog.pg_namespace n ON n.oid = c.connamespace
+LEFT JOIN pg_catalog.pg_description d ON d.classoid = c.tableoid
+          AND d.objoid = c.oid AND d.objsubid = 0
+WHERE true
+  AND c.conname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- Conversions(pattern="public.foo*", verbose=true, showSystem=true)
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000
+SELECT n.nspname AS "Schema",
+       c.conname AS "Name",
+       pg_catalog.pg_encoding_to_char(c.conforencoding) AS "Source",
+       pg_catalog.pg_encoding_to_char(c.contoencoding) AS "Destination",
+       CASE WHEN c.condefault THEN 'yes'
+       ELSE 'no' END AS "Default?",
+       d.description AS "Description"
+FROM pg_catalog.pg_conversion c
+     JOIN pg_catalog.pg_namespace n ON n.oid = c.connamespace
+LEFT JOIN pg_catalog.pg_description d ON d.classoid = c.tableoid
+          AND d.objoid = c.oid AND d.objsubid = 0
+WHERE true
+  AND c.conname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname AS "Schema",
+       c.conname AS "Name",
+       pg_catalog.pg_encoding_to_char(c.conforencoding) AS "Source",
+       pg_catalog.pg_encoding_to_char(c.contoencoding) AS "Destination",
+       CASE WHEN c.condefault THEN 'yes'
+       ELSE 'no' END AS "Default?",
+       d.description AS "Description"
+FROM pg_catalog.pg_conversion c
+     JOIN pg_catalog.pg_namespace n ON n.oid = c.connamespace
+LEFT JOIN pg_catalog.pg_description d ON d.classoid = c.tableoid
+          AND d.objoid = c.oid AND d.objsubid = 0
+WHERE true
+  AND c.conname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
@@ -0,0 +1,98 @@
+-- DatabaseRoleSettings(pattern="", pattern2="")
+-- versions: 80000
+-- error: "The server (version 8.0) does not support per-database role settings.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support per-database role settings.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support per-database role settings.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support per-database role settings.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support per-database role settings.\n"
+
+-- versions: 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT rolname AS "Role", datname AS "Database",
+pg_catalog.array_to_string(setconfig, E'\n') AS "Settings"
+FROM pg_catalog.pg_db_role_setting s
+LEFT JOIN pg_catalog.pg_database d ON d.oid = setdatabase
+LEFT JOIN pg_catalog.pg_roles r ON r.oid = setrole
+ORDER BY 1, 2;
+
+-- DatabaseRoleSettings(pattern="", pattern2="bar?")
+-- versions: 80000
+-- error: "The server (version 8.0) does not support per-database role settings.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support per-database role settings.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support per-database role settings.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support per-database role settings.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support per-database role settings.\n"
+
+-- versions: 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000
+SELECT rolname AS "Role", datname AS "Database",
+pg_catalog.array_to_string(setconfig, E'\n') AS "Settings"
+FROM pg_catalog.pg_db_role_setting s
+LEFT JOIN pg_catalog.pg_database d ON d.oid = setdatabase
+LEFT JOIN pg_catalog.pg_roles r ON r.oid = setrole
+WHERE d.datname OPERATOR(pg_catalog.~) $1
+ORDER BY 1, 2;
+-- $1 = "^(bar.)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT rolname AS "Role", datname AS "Database",
+pg_catalog.array_to_string(setconfig, E'\n') AS "Settings"
+FROM pg_catalog.pg_db_role_setting s
+LEFT JOIN pg_catalog.pg_database d ON d.oid = setdatabase
+LEFT JOIN pg_catalog.pg_roles r ON r.oid = setrole
+WHERE d.datname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(bar.)$"
+
+-- DatabaseRoleSettings(pattern="public.foo*", pattern2="")
+-- versions: 80000
+-- error: "The server (version 8.0) does not support per-database role settings.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support per-database role settings.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support per-database role settings.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support per-database role settings.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support per-database role settings.\n"
+
+-- versions: 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+-- error: "improper qualified name (too many dotted names): public.foo*"
+
+-- DatabaseRoleSettings(pattern="public.foo*", pattern2="bar?")
+-- versions: 80000
+-- error: "The server (version 8.0) does not support per-database role settings.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support per-database role settings.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support per-database role settings.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support per-database role settings.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support per-database role settings.\n"
+
+-- versions: 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+-- error: "improper qualified name (too many dotted names): public.foo*"
+
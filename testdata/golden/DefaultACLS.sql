@@ -0,0 +1,67 @@
+-- DefaultACLS(pattern="")
+-- versions: 80000
+-- error: "The server (version 8.0) does not support altering default privileges.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support altering default privileges.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support altering default privileges.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support altering default privileges.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support altering default privileges.\n"
+
+-- versions: 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT pg_catalog.pg_get_userbyid(d.defaclrole) AS "Owner",
+  n.nspname AS "Schema",
+  CASE d.defaclobjtype WHEN 'r' THEN 'table' WHEN 'S' THEN 'sequence' WHEN 'f' THEN 'function' WHEN 'T' THEN 'type' WHEN 'n' THEN 'schema' END AS "Type",
+  pg_catalog.array_to_string(d.defaclacl, E'\n') AS "Access privileges"
+FROM pg_catalog.pg_default_acl d
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = d.defaclnamespace
+ORDER BY 1, 2, 3;
+
+-- DefaultACLS(pattern="public.foo*")
+-- versions: 80000
+-- error: "The server (version 8.0) does not support altering default privileges.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support altering default privileges.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support altering default privileges.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support altering default privileges.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support altering default privileges.\n"
+
+-- versions: 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000
+SELECT pg_catalog.pg_get_userbyid(d.defaclrole) AS "Owner",
+  n.nspname AS "Schema",
+  CASE d.defaclobjtype WHEN 'r' THEN 'table' WHEN 'S' THEN 'sequence' WHEN 'f' THEN 'function' WHEN 'T' THEN 'type' WHEN 'n' THEN 'schema' END AS "Type",
+  pg_catalog.array_to_string(d.defaclacl, E'\n') AS "Access privileges"
+FROM pg_catalog.pg_default_acl d
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = d.defaclnamespace
+WHERE (n.nspname OPERATOR(pg_catalog.~) $1
+        OR pg_catalog.pg_get_userbyid(d.defaclrole) OPERATOR(pg_catalog.~) $2)
+ORDER BY 1, 2, 3;
+-- $1 = "^(public.foo.*)$"
+-- $2 = "^(public.foo.*)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT pg_catalog.pg_get_userbyid(d.defaclrole) AS "Owner",
+  n.nspname AS "Schema",
+  CASE d.defaclobjtype WHEN 'r' THEN 'table' WHEN 'S' THEN 'sequence' WHEN 'f' THEN 'function' WHEN 'T' THEN 'type' WHEN 'n' THEN 'schema' END AS "Type",
+  pg_catalog.array_to_string(d.defaclacl, E'\n') AS "Access privileges"
+FROM pg_catalog.pg_default_acl d
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = d.defaclnamespace
+WHERE (n.nspname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+        OR pg_catalog.pg_get_userbyid(d.defaclrole) OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default)
+ORDER BY 1, 2, 3;
+-- $1 = "^(public.foo.*)$"
+-- $2 = "^(public.foo.*)$"
+
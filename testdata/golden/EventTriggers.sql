@@ -0,0 +1,23 @@
+-- EventTriggers(pattern="", verbose=false)
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT evtname as "Name", evtevent as "Event", pg_catalog.pg_get_userbyid(e.evtowner) as "Owner",
+ case evtenabled when 'O' then 'enabled'  when 'R' then 'replica'  when 'A' then 'always'  when 'D' then 'disabled' end as "Enabled",
+ e.evtfoid::pg_catalog.regproc as "Function", pg_catalog.array_to_string(array(select x from pg_catalog.unnest(evttags) as t(x)), ', ') as "Tags"
+FROM pg_catalog.pg_event_trigger e ORDER BY 1
+
+-- EventTriggers(pattern="", verbose=true)
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT evtname as "Name", evtevent as "Event", pg_catalog.pg_get_userbyid(e.evtowner) as "Owner",
+ case evtenabled when 'O' then 'enabled'  when 'R' then 'replica'  when 'A' then 'always'  when 'D' then 'disabled' end as "Enabled",
+ e.evtfoid::pg_catalog.regproc as "Function", pg_catalog.array_to_string(array(select x from pg_catalog.unnest(evttags) as t(x)), ', ') as "Tags",
+pg_catalog.obj_description(e.oid, 'pg_event_trigger') as "Description"
+FROM pg_catalog.pg_event_trigger e ORDER BY 1
+
+-- EventTriggers(pattern="public.foo*", verbose=false)
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+-- error: "improper qualified name (too many dotted names): public.foo*"
+
+-- EventTriggers(pattern="public.foo*", verbose=true)
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+-- error: "improper qualified name (too many dotted names): public.foo*"
+
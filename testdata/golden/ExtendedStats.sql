@@ -0,0 +1,201 @@
+-- ExtendedStats(pattern="")
+-- versions: 80000
+-- error: "The server (version 8.0) does not support extended statistics.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support extended statistics.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support extended statistics.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support extended statistics.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support extended statistics.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support extended statistics.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support extended statistics.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support extended statistics.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support extended statistics.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support extended statistics.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support extended statistics.\n"
+
+-- versions: 90600
+-- error: "The server (version 9.6) does not support extended statistics.\n"
+
+-- versions: 100000, 110000
+SELECT 
+es.stxnamespace::pg_catalog.regnamespace::pg_catalog.text AS "Schema", 
+es.stxname AS "Name", 
+pg_catalog.format('%s FROM %s', 
+  (SELECT pg_catalog.string_agg(pg_catalog.quote_ident(a.attname),', ') 
+   FROM pg_catalog.unnest(es.stxkeys) s(attnum) 
+   JOIN pg_catalog.pg_attribute a 
+   ON (es.stxrelid = a.attrelid 
+   AND a.attnum = s.attnum 
+   AND NOT a.attisdropped)), 
+es.stxrelid::pg_catalog.regclass) AS "Definition",
+CASE WHEN 'd' = any(es.stxkind) THEN 'defined' 
+END AS "Ndistinct", 
+CASE WHEN 'f' = any(es.stxkind) THEN 'defined' 
+END AS "Dependencies" 
+FROM pg_catalog.pg_statistic_ext es 
+WHERE pg_catalog.pg_statistics_obj_is_visible(es.oid)
+ORDER BY 1, 2;
+
+-- versions: 120000, 130000
+SELECT 
+es.stxnamespace::pg_catalog.regnamespace::pg_catalog.text AS "Schema", 
+es.stxname AS "Name", 
+pg_catalog.format('%s FROM %s', 
+  (SELECT pg_catalog.string_agg(pg_catalog.quote_ident(a.attname),', ') 
+   FROM pg_catalog.unnest(es.stxkeys) s(attnum) 
+   JOIN pg_catalog.pg_attribute a 
+   ON (es.stxrelid = a.attrelid 
+   AND a.attnum = s.attnum 
+   AND NOT a.attisdropped)), 
+es.stxrelid::pg_catalog.regclass) AS "Definition",
+CASE WHEN 'd' = any(es.stxkind) THEN 'defined' 
+END AS "Ndistinct", 
+CASE WHEN 'f' = any(es.stxkind) THEN 'defined' 
+END AS "Dependencies",
+CASE WHEN 'm' = any(es.stxkind) THEN 'defined' 
+END AS "MCV"  
+FROM pg_catalog.pg_statistic_ext es 
+WHERE pg_catalog.pg_statistics_obj_is_visible(es.oid)
+ORDER BY 1, 2;
+
+-- versions: 140000, 150000, 160000, 170000
+SELECT 
+es.stxnamespace::pg_catalog.regnamespace::pg_catalog.text AS "Schema", 
+es.stxname AS "Name", 
+pg_catalog.format('%s FROM %s', 
+  pg_catalog.pg_get_statisticsobjdef_columns(es.oid), 
+  es.stxrelid::pg_catalog.regclass) AS "Definition",
+CASE WHEN 'd' = any(es.stxkind) THEN 'defined' 
+END AS "Ndistinct", 
+CASE WHEN 'f' = any(es.stxkind) THEN 'defined' 
+END AS "Dependencies",
+CASE WHEN 'm' = any(es.stxkind) THEN 'defined' 
+END AS "MCV"  
+FROM pg_catalog.pg_statistic_ext es 
+WHERE pg_catalog.pg_statistics_obj_is_visible(es.oid)
+ORDER BY 1, 2;
+
+-- ExtendedStats(pattern="public.foo*")
+-- versions: 80000
+-- error: "The server (version 8.0) does not support extended statistics.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support extended statistics.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support extended statistics.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support extended statistics.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support extended statistics.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support extended statistics.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support extended statistics.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support extended statistics.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support extended statistics.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support extended statistics.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support extended statistics.\n"
+
+-- versions: 90600
+-- error: "The server (version 9.6) does not support extended statistics.\n"
+
+-- versions: 100000, 110000
+SELECT 
+es.stxnamespace::pg_catalog.regnamespace::pg_catalog.text AS "Schema", 
+es.stxname AS "Name", 
+pg_catalog.format('%s FROM %s', 
+  (SELECT pg_catalog.string_agg(pg_catalog.quote_ident(a.attname),', ') 
+   FROM pg_catalog.unnest(es.stxkeys) s(attnum) 
+   JOIN pg_catalog.pg_attribute a 
+   ON (es.stxrelid = a.attrelid 
+   AND a.attnum = s.attnum 
+   AND NOT a.attisdropped)), 
+es.stxrelid::pg_catalog.regclass) AS "Definition",
+CASE WHEN 'd' = any(es.stxkind) THEN 'defined' 
+END AS "Ndistinct", 
+CASE WHEN 'f' = any(es.stxkind) THEN 'defined' 
+END AS "Dependencies" 
+FROM pg_catalog.pg_statistic_ext es 
+WHERE es.stxname OPERATOR(pg_catalog.~) $1
+  AND es.stxnamespace::pg_catalog.regnamespace::pg_catalog.text OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000
+SELECT 
+es.stxnamespace::pg_catalog.regnamespace::pg_catalog.text AS "Schema", 
+es.stxname AS "Name", 
+pg_catalog.format('%s FROM %s', 
+  (SELECT pg_catalog.string_agg(pg_catalog.quote_ident(a.attname),', ') 
+   FROM pg_catalog.unnest(es.stxkeys) s(attnum) 
+   JOIN pg_catalog.pg_attribute a 
+   ON (es.stxrelid = a.attrelid 
+   AND a.attnum = s.attnum 
+   AND NOT a.attisdropped)), 
+es.stxrelid::pg_catalog.regclass) AS "Definition",
+CASE WHEN 'd' = any(es.stxkind) THEN 'defined' 
+END AS "Ndistinct", 
+CASE WHEN 'f' = any(es.stxkind) THEN 'defined' 
+END AS "Dependencies",
+CASE WHEN 'm' = any(es.stxkind) THEN 'defined' 
+END AS "MCV"  
+FROM pg_catalog.pg_statistic_ext es 
+WHERE es.stxname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND es.stxnamespace::pg_catalog.regnamespace::pg_catalog.text OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 140000, 150000, 160000, 170000
+SELECT 
+es.stxnamespace::pg_catalog.regnamespace::pg_catalog.text AS "Schema", 
+es.stxname AS "Name", 
+pg_catalog.format('%s FROM %s', 
+  pg_catalog.pg_get_statisticsobjdef_columns(es.oid), 
+  es.stxrelid::pg_catalog.regclass) AS "Definition",
+CASE WHEN 'd' = any(es.stxkind) THEN 'defined' 
+END AS "Ndistinct", 
+CASE WHEN 'f' = any(es.stxkind) THEN 'defined' 
+END AS "Dependencies",
+CASE WHEN 'm' = any(es.stxkind) THEN 'defined' 
+END AS "MCV"  
+FROM pg_catalog.pg_statistic_ext es 
+WHERE es.stxname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND es.stxnamespace::pg_catalog.regnamespace::pg_catalog.text OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
@@ -0,0 +1,46 @@
+-- Extensions(pattern="")
+-- versions: 80000
+-- error: "The server (version 8.0) does not support extensions.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support extensions.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support extensions.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support extensions.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support extensions.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support extensions.\n"
+
+-- versions: 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT e.extname AS "Name", e.extversion AS "Version", n.nspname AS "Schema", c.description AS "Description"
+FROM pg_catalog.pg_extension e LEFT JOIN pg_catalog.pg_namespace n ON n.oid = e.extnamespace LEFT JOIN pg_catalog.pg_description c ON c.objoid = e.oid AND c.classoid = 'pg_catalog.pg_extension'::pg_catalog.regclass
+ORDER BY 1;
+
+-- Extensions(pattern="public.foo*")
+-- versions: 80000
+-- error: "The server (version 8.0) does not support extensions.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support extensions.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support extensions.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support extensions.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support extensions.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support extensions.\n"
+
+-- versions: 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+-- error: "improper qualified name (too many dotted names): public.foo*"
+
@@ -0,0 +1,95 @@
+-- ForeignDataWrappers(pattern="", verbose=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support foreign-data wrappers.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support foreign-data wrappers.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support foreign-data wrappers.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support foreign-data wrappers.\n"
+
+-- versions: 80400, 90000
+SELECT fdw.fdwname AS "Name",
+  pg_catalog.pg_get_userbyid(fdw.fdwowner) AS "Owner",
+  fdw.fdwvalidator::pg_catalog.regproc AS "Validator"
+FROM pg_catalog.pg_foreign_data_wrapper fdw
+ORDER BY 1;
+
+-- versions: 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT fdw.fdwname AS "Name",
+  pg_catalog.pg_get_userbyid(fdw.fdwowner) AS "Owner",
+  fdw.fdwhandler::pg_catalog.regproc AS "Handler",
+  fdw.fdwvalidator::pg_catalog.regproc AS "Validator"
+FROM pg_catalog.pg_foreign_data_wrapper fdw
+ORDER BY 1;
+
+-- ForeignDataWrappers(pattern="", verbose=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support foreign-data wrappers.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support foreign-data wrappers.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support foreign-data wrappers.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support foreign-data wrappers.\n"
+
+-- versions: 80400, 90000
+SELECT fdw.fdwname AS "Name",
+  pg_catalog.pg_get_userbyid(fdw.fdwowner) AS "Owner",
+  fdw.fdwvalidator::pg_catalog.regproc AS "Validator",
+  pg_catalog.array_to_string(fdwacl, E'\n') AS "Access privileges",
+ CASE WHEN fdwoptions IS NULL THEN '' ELSE   '(' || pg_catalog.array_to_string(ARRAY(SELECT   pg_catalog.quote_ident(option_name) ||  ' ' ||   pg_catalog.quote_literal(option_value)  FROM   pg_catalog.pg_options_to_table(fdwoptions)),  ', ') || ')'   END AS "FDW options"
+FROM pg_catalog.pg_foreign_data_wrapper fdw
+ORDER BY 1;
+
+-- versions: 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT fdw.fdwname AS "Name",
+  pg_catalog.pg_get_userbyid(fdw.fdwowner) AS "Owner",
+  fdw.fdwhandler::pg_catalog.regproc AS "Handler",
+  fdw.fdwvalidator::pg_catalog.regproc AS "Validator",
+  pg_catalog.array_to_string(fdwacl, E'\n') AS "Access privileges",
+ CASE WHEN fdwoptions IS NULL THEN '' ELSE   '(' || pg_catalog.array_to_string(ARRAY(SELECT   pg_catalog.quote_ident(option_name) ||  ' ' ||   pg_catalog.quote_literal(option_value)  FROM   pg_catalog.pg_options_to_table(fdwoptions)),  ', ') || ')'   END AS "FDW options",
+  d.description AS "Description" 
+FROM pg_catalog.pg_foreign_data_wrapper fdw
+LEFT JOIN pg_catalog.pg_description d
+       ON d.classoid = fdw.tableoid AND d.objoid = fdw.oid AND d.objsubid = 0
+ORDER BY 1;
+
+-- ForeignDataWrappers(pattern="public.foo*", verbose=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support foreign-data wrappers.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support foreign-data wrappers.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support foreign-data wrappers.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support foreign-data wrappers.\n"
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+-- error: "improper qualified name (too many dotted names): public.foo*"
+
+-- ForeignDataWrappers(pattern="public.foo*", verbose=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support foreign-data wrappers.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support foreign-data wrappers.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support foreign-data wrappers.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support foreign-data wrappers.\n"
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+-- error: "improper qualified name (too many dotted names): public.foo*"
+
@@ -0,0 +1,81 @@
+-- ForeignServers(pattern="", verbose=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support foreign servers.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support foreign servers.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support foreign servers.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support foreign servers.\n"
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT s.srvname AS "Name",
+  pg_catalog.pg_get_userbyid(s.srvowner) AS "Owner",
+  f.fdwname AS "Foreign-data wrapper"
+FROM pg_catalog.pg_foreign_server s
+     JOIN pg_catalog.pg_foreign_data_wrapper f ON f.oid=s.srvfdw
+ORDER BY 1;
+
+-- ForeignServers(pattern="", verbose=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support foreign servers.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support foreign servers.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support foreign servers.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support foreign servers.\n"
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT s.srvname AS "Name",
+  pg_catalog.pg_get_userbyid(s.srvowner) AS "Owner",
+  f.fdwname AS "Foreign-data wrapper",
+  pg_catalog.array_to_string(s.srvacl, E'\n') AS "Access privileges",
+  s.srvtype AS "Type",
+  s.srvversion AS "Version",
+  CASE WHEN srvoptions IS NULL THEN '' ELSE   '(' || pg_catalog.array_to_string(ARRAY(SELECT   pg_catalog.quote_ident(option_name) ||  ' ' ||   pg_catalog.quote_literal(option_value)  FROM   pg_catalog.pg_options_to_table(srvoptions)),  ', ') || ')'   END AS "FDW options",
+  d.description AS "Description"
+FROM pg_catalog.pg_foreign_server s
+     JOIN pg_catalog.pg_foreign_data_wrapper f ON f.oid=s.srvfdw
+LEFT JOIN pg_catalog.pg_description d
+       ON d.classoid = s.tableoid AND d.objoid = s.oid AND d.objsubid = 0
+ORDER BY 1;
+
+-- ForeignServers(pattern="public.foo*", verbose=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support foreign servers.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support foreign servers.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support foreign servers.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support foreign servers.\n"
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+-- error: "improper qualified name (too many dotted names): public.foo*"
+
+-- ForeignServers(pattern="public.foo*", verbose=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support foreign servers.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support foreign servers.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support foreign servers.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support foreign servers.\n"
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+-- error: "improper qualified name (too many dotted names): public.foo*"
+
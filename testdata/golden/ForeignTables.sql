@@ -0,0 +1,166 @@
+-- ForeignTables(pattern="", verbose=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support foreign tables.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support foreign tables.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support foreign tables.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support foreign tables.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support foreign tables.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support foreign tables.\n"
+
+-- versions: 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname AS "Schema",
+  c.relname AS "Table",
+  s.srvname AS "Server"
+FROM pg_catalog.pg_foreign_table ft
+  INNER JOIN pg_catalog.pg_class c ON c.oid = ft.ftrelid
+  INNER JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+  INNER JOIN pg_catalog.pg_foreign_server s ON s.oid = ft.ftserver
+WHERE pg_catalog.pg_table_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- ForeignTables(pattern="", verbose=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support foreign tables.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support foreign tables.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support foreign tables.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support foreign tables.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support foreign tables.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support foreign tables.\n"
+
+-- versions: 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname AS "Schema",
+  c.relname AS "Table",
+  s.srvname AS "Server",
+ CASE WHEN ftoptions IS NULL THEN '' ELSE   '(' || pg_catalog.array_to_string(ARRAY(SELECT   pg_catalog.quote_ident(option_name) ||  ' ' ||   pg_catalog.quote_literal(option_value)  FROM   pg_catalog.pg_options_to_table(ftoptions)),  ', ') || ')'   END AS "FDW options",
+  d.description AS "Description"
+FROM pg_catalog.pg_foreign_table ft
+  INNER JOIN pg_catalog.pg_class c ON c.oid = ft.ftrelid
+  INNER JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+  INNER JOIN pg_catalog.pg_foreign_server s ON s.oid = ft.ftserver
+   LEFT JOIN pg_catalog.pg_description d
+          ON d.classoid = c.tableoid AND d.objoid = c.oid AND d.objsubid = 0
+WHERE pg_catalog.pg_table_is_visible(c.oid)
+ORDER BY 1, 2;
+
+-- ForeignTables(pattern="public.foo*", verbose=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support foreign tables.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support foreign tables.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support foreign tables.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support foreign tables.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support foreign tables.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support foreign tables.\n"
+
+-- versions: 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000
+SELECT n.nspname AS "Schema",
+  c.relname AS "Table",
+  s.srvname AS "Server"
+FROM pg_catalog.pg_foreign_table ft
+  INNER JOIN pg_catalog.pg_class c ON c.oid = ft.ftrelid
+  INNER JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+  INNER JOIN pg_catalog.pg_foreign_server s ON s.oid = ft.ftserver
+WHERE c.relname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname AS "Schema",
+  c.relname AS "Table",
+  s.srvname AS "Server"
+FROM pg_catalog.pg_foreign_table ft
+  INNER JOIN pg_catalog.pg_class c ON c.oid = ft.ftrelid
+  INNER JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+  INNER JOIN pg_catalog.pg_foreign_server s ON s.oid = ft.ftserver
+WHERE c.relname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- ForeignTables(pattern="public.foo*", verbose=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support foreign tables.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support foreign tables.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support foreign tables.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support foreign tables.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support foreign tables.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support foreign tables.\n"
+
+-- versions: 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000
+SELECT n.nspname AS "Schema",
+  c.relname AS "Table",
+  s.srvname AS "Server",
+ CASE WHEN ftoptions IS NULL THEN '' ELSE   '(' || pg_catalog.array_to_string(ARRAY(SELECT   pg_catalog.quote_ident(option_name) ||  ' ' ||   pg_catalog.quote_literal(option_value)  FROM   pg_catalog.pg_options_to_table(ftoptions)),  ', ') || ')'   END AS "FDW options",
+  d.description AS "Description"
+FROM pg_catalog.pg_foreign_table ft
+  INNER JOIN pg_catalog.pg_class c ON c.oid = ft.ftrelid
+  INNER JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+  INNER JOIN pg_catalog.pg_foreign_server s ON s.oid = ft.ftserver
+   LEFT JOIN pg_catalog.pg_description d
+          ON d.classoid = c.tableoid AND d.objoid = c.oid AND d.objsubid = 0
+WHERE c.relname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname AS "Schema",
+  c.relname AS "Table",
+  s.srvname AS "Server",
+ CASE WHEN ftoptions IS NULL THEN '' ELSE   '(' || pg_catalog.array_to_string(ARRAY(SELECT   pg_catalog.quote_ident(option_name) ||  ' ' ||   pg_catalog.quote_literal(option_value)  FROM   pg_catalog.pg_options_to_table(ftoptions)),  ', ') || ')'   END AS "FDW options",
+  d.description AS "Description"
+FROM pg_catalog.pg_foreign_table ft
+  INNER JOIN pg_catalog.pg_class c ON c.oid = ft.ftrelid
+  INNER JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+  INNER JOIN pg_catalog.pg_foreign_server s ON s.oid = ft.ftserver
+   LEFT JOIN pg_catalog.pg_description d
+          ON d.classoid = c.tableoid AND d.objoid = c.oid AND d.objsubid = 0
+WHERE c.relname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
@@ -0,0 +1,1912 @@
+-- Functions(functypes="", pattern="", verbose=false, showSystem=false)
+-- versions: 80000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  CASE WHEN p.proretset THEN 'SETOF ' ELSE '' END ||
+  pg_catalog.format_type(p.prorettype, NULL) as "Result data type",
+  pg_catalog.oidvectortypes(p.proargtypes) as "Argument data types",
+  CASE
+    WHEN p.proisagg THEN 'agg'
+    WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+    ELSE 'func'
+  END AS "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+ORDER BY 1, 2, 4;
+
+-- versions: 80100, 80200, 80300
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  CASE WHEN p.proretset THEN 'SETOF ' ELSE '' END ||
+  pg_catalog.format_type(p.prorettype, NULL) as "Result data type",
+  CASE WHEN proallargtypes IS NOT NULL THEN
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        CASE
+          WHEN p.proargmodes[s.i] = 'i' THEN ''
+          WHEN p.proargmodes[s.i] = 'o' THEN 'OUT '
+          WHEN p.proargmodes[s.i] = 'b' THEN 'INOUT '
+          WHEN p.proargmodes[s.i] = 'v' THEN 'VARIADIC '
+        END ||
+        CASE
+          WHEN COALESCE(p.proargnames[s.i], '') = '' THEN ''
+          ELSE p.proargnames[s.i] || ' '
+        END ||
+        pg_catalog.format_type(p.proallargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(1, pg_catalog.array_upper(p.proallargtypes, 1)) AS s(i)
+    ), ', ')
+  ELSE
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        CASE
+          WHEN COALESCE(p.proargnames[s.i+1], '') = '' THEN ''
+          ELSE p.proargnames[s.i+1] || ' '
+          END ||
+        pg_catalog.format_type(p.proargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(0, pg_catalog.array_upper(p.proargtypes, 1)) AS s(i)
+    ), ', ')
+  END AS "Argument data types",
+  CASE
+    WHEN p.proisagg THEN 'agg'
+    WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+    ELSE 'func'
+  END AS "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+ORDER BY 1, 2, 4;
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE
+  WHEN p.proisagg THEN 'agg'
+  WHEN p.proiswindow THEN 'window'
+  WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+  ELSE 'func'
+ END as "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+ORDER BY 1, 2, 4;
+
+-- versions: 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+ORDER BY 1, 2, 4;
+
+-- Functions(functypes="", pattern="", verbose=false, showSystem=true)
+-- versions: 80000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  CASE WHEN p.proretset THEN 'SETOF ' ELSE '' END ||
+  pg_catalog.format_type(p.prorettype, NULL) as "Result data type",
+  pg_catalog.oidvectortypes(p.proargtypes) as "Argument data types",
+  CASE
+    WHEN p.proisagg THEN 'agg'
+    WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+    ELSE 'func'
+  END AS "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- versions: 80100, 80200, 80300
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  CASE WHEN p.proretset THEN 'SETOF ' ELSE '' END ||
+  pg_catalog.format_type(p.prorettype, NULL) as "Result data type",
+  CASE WHEN proallargtypes IS NOT NULL THEN
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        CASE
+          WHEN p.proargmodes[s.i] = 'i' THEN ''
+          WHEN p.proargmodes[s.i] = 'o' THEN 'OUT '
+          WHEN p.proargmodes[s.i] = 'b' THEN 'INOUT '
+          WHEN p.proargmodes[s.i] = 'v' THEN 'VARIADIC '
+        END ||
+        CASE
+          WHEN COALESCE(p.proargnames[s.i], '') = '' THEN ''
+          ELSE p.proargnames[s.i] || ' '
+        END ||
+        pg_catalog.format_type(p.proallargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(1, pg_catalog.array_upper(p.proallargtypes, 1)) AS s(i)
+    ), ', ')
+  ELSE
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        CASE
+          WHEN COALESCE(p.proargnames[s.i+1], '') = '' THEN ''
+          ELSE p.proargnames[s.i+1] || ' '
+          END ||
+        pg_catalog.format_type(p.proargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(0, pg_catalog.array_upper(p.proargtypes, 1)) AS s(i)
+    ), ', ')
+  END AS "Argument data types",
+  CASE
+    WHEN p.proisagg THEN 'agg'
+    WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+    ELSE 'func'
+  END AS "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE
+  WHEN p.proisagg THEN 'agg'
+  WHEN p.proiswindow THEN 'window'
+  WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+  ELSE 'func'
+ END as "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- versions: 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- Functions(functypes="", pattern="", verbose=true, showSystem=false)
+-- versions: 80000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  CASE WHEN p.proretset THEN 'SETOF ' ELSE '' END ||
+  pg_catalog.format_type(p.prorettype, NULL) as "Result data type",
+  pg_catalog.oidvectortypes(p.proargtypes) as "Argument data types",
+  CASE
+    WHEN p.proisagg THEN 'agg'
+    WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+    ELSE 'func'
+  END AS "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, '\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+ORDER BY 1, 2, 4;
+
+-- versions: 80100, 80200, 80300
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  CASE WHEN p.proretset THEN 'SETOF ' ELSE '' END ||
+  pg_catalog.format_type(p.prorettype, NULL) as "Result data type",
+  CASE WHEN proallargtypes IS NOT NULL THEN
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        CASE
+          WHEN p.proargmodes[s.i] = 'i' THEN ''
+          WHEN p.proargmodes[s.i] = 'o' THEN 'OUT '
+          WHEN p.proargmodes[s.i] = 'b' THEN 'INOUT '
+          WHEN p.proargmodes[s.i] = 'v' THEN 'VARIADIC '
+        END ||
+        CASE
+          WHEN COALESCE(p.proargnames[s.i], '') = '' THEN ''
+          ELSE p.proargnames[s.i] || ' '
+        END ||
+        pg_catalog.format_type(p.proallargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(1, pg_catalog.array_upper(p.proallargtypes, 1)) AS s(i)
+    ), ', ')
+  ELSE
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        CASE
+          WHEN COALESCE(p.proargnames[s.i+1], '') = '' THEN ''
+          ELSE p.proargnames[s.i+1] || ' '
+          END ||
+        pg_catalog.format_type(p.proargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(0, pg_catalog.array_upper(p.proargtypes, 1)) AS s(i)
+    ), ', ')
+  END AS "Argument data types",
+  CASE
+    WHEN p.proisagg THEN 'agg'
+    WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+    ELSE 'func'
+  END AS "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+ORDER BY 1, 2, 4;
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE
+  WHEN p.proisagg THEN 'agg'
+  WHEN p.proiswindow THEN 'window'
+  WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+  ELSE 'func'
+ END as "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+ORDER BY 1, 2, 4;
+
+-- versions: 90600, 100000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE
+  WHEN p.proisagg THEN 'agg'
+  WHEN p.proiswindow THEN 'window'
+  WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+  ELSE 'func'
+ END as "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ CASE
+  WHEN p.proparallel = 'r' THEN 'restricted'
+  WHEN p.proparallel = 's' THEN 'safe'
+  WHEN p.proparallel = 'u' THEN 'unsafe'
+ END as "Parallel",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+ORDER BY 1, 2, 4;
+
+-- versions: 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ CASE
+  WHEN p.proparallel = 'r' THEN 'restricted'
+  WHEN p.proparallel = 's' THEN 'safe'
+  WHEN p.proparallel = 'u' THEN 'unsafe'
+ END as "Parallel",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+ORDER BY 1, 2, 4;
+
+-- Functions(functypes="", pattern="", verbose=true, showSystem=true)
+-- versions: 80000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  CASE WHEN p.proretset THEN 'SETOF ' ELSE '' END ||
+  pg_catalog.format_type(p.prorettype, NULL) as "Result data type",
+  pg_catalog.oidvectortypes(p.proargtypes) as "Argument data types",
+  CASE
+    WHEN p.proisagg THEN 'agg'
+    WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+    ELSE 'func'
+  END AS "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, '\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- versions: 80100, 80200, 80300
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  CASE WHEN p.proretset THEN 'SETOF ' ELSE '' END ||
+  pg_catalog.format_type(p.prorettype, NULL) as "Result data type",
+  CASE WHEN proallargtypes IS NOT NULL THEN
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        CASE
+          WHEN p.proargmodes[s.i] = 'i' THEN ''
+          WHEN p.proargmodes[s.i] = 'o' THEN 'OUT '
+          WHEN p.proargmodes[s.i] = 'b' THEN 'INOUT '
+          WHEN p.proargmodes[s.i] = 'v' THEN 'VARIADIC '
+        END ||
+        CASE
+          WHEN COALESCE(p.proargnames[s.i], '') = '' THEN ''
+          ELSE p.proargnames[s.i] || ' '
+        END ||
+        pg_catalog.format_type(p.proallargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(1, pg_catalog.array_upper(p.proallargtypes, 1)) AS s(i)
+    ), ', ')
+  ELSE
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        CASE
+          WHEN COALESCE(p.proargnames[s.i+1], '') = '' THEN ''
+          ELSE p.proargnames[s.i+1] || ' '
+          END ||
+        pg_catalog.format_type(p.proargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(0, pg_catalog.array_upper(p.proargtypes, 1)) AS s(i)
+    ), ', ')
+  END AS "Argument data types",
+  CASE
+    WHEN p.proisagg THEN 'agg'
+    WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+    ELSE 'func'
+  END AS "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE
+  WHEN p.proisagg THEN 'agg'
+  WHEN p.proiswindow THEN 'window'
+  WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+  ELSE 'func'
+ END as "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- versions: 90600, 100000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE
+  WHEN p.proisagg THEN 'agg'
+  WHEN p.proiswindow THEN 'window'
+  WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+  ELSE 'func'
+ END as "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ CASE
+  WHEN p.proparallel = 'r' THEN 'restricted'
+  WHEN p.proparallel = 's' THEN 'safe'
+  WHEN p.proparallel = 'u' THEN 'unsafe'
+ END as "Parallel",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- versions: 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ CASE
+  WHEN p.proparallel = 'r' THEN 'restricted'
+  WHEN p.proparallel = 's' THEN 'safe'
+  WHEN p.proparallel = 'u' THEN 'unsafe'
+ END as "Parallel",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- Functions(functypes="", pattern="public.foo*", verbose=false, showSystem=false)
+-- versions: 80000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  CASE WHEN p.proretset THEN 'SETOF ' ELSE '' END ||
+  pg_catalog.format_type(p.prorettype, NULL) as "Result data type",
+  pg_catalog.oidvectortypes(p.proargtypes) as "Argument data types",
+  CASE
+    WHEN p.proisagg THEN 'agg'
+    WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+    ELSE 'func'
+  END AS "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 80100, 80200, 80300
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  CASE WHEN p.proretset THEN 'SETOF ' ELSE '' END ||
+  pg_catalog.format_type(p.prorettype, NULL) as "Result data type",
+  CASE WHEN proallargtypes IS NOT NULL THEN
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        CASE
+          WHEN p.proargmodes[s.i] = 'i' THEN ''
+          WHEN p.proargmodes[s.i] = 'o' THEN 'OUT '
+          WHEN p.proargmodes[s.i] = 'b' THEN 'INOUT '
+          WHEN p.proargmodes[s.i] = 'v' THEN 'VARIADIC '
+        END ||
+        CASE
+          WHEN COALESCE(p.proargnames[s.i], '') = '' THEN ''
+          ELSE p.proargnames[s.i] || ' '
+        END ||
+        pg_catalog.format_type(p.proallargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(1, pg_catalog.array_upper(p.proallargtypes, 1)) AS s(i)
+    ), ', ')
+  ELSE
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        CASE
+          WHEN COALESCE(p.proargnames[s.i+1], '') = '' THEN ''
+          ELSE p.proargnames[s.i+1] || ' '
+          END ||
+        pg_catalog.format_type(p.proargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(0, pg_catalog.array_upper(p.proargtypes, 1)) AS s(i)
+    ), ', ')
+  END AS "Argument data types",
+  CASE
+    WHEN p.proisagg THEN 'agg'
+    WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+    ELSE 'func'
+  END AS "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE
+  WHEN p.proisagg THEN 'agg'
+  WHEN p.proiswindow THEN 'window'
+  WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+  ELSE 'func'
+ END as "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 110000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- Functions(functypes="", pattern="public.foo*", verbose=false, showSystem=true)
+-- versions: 80000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  CASE WHEN p.proretset THEN 'SETOF ' ELSE '' END ||
+  pg_catalog.format_type(p.prorettype, NULL) as "Result data type",
+  pg_catalog.oidvectortypes(p.proargtypes) as "Argument data types",
+  CASE
+    WHEN p.proisagg THEN 'agg'
+    WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+    ELSE 'func'
+  END AS "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 80100, 80200, 80300
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  CASE WHEN p.proretset THEN 'SETOF ' ELSE '' END ||
+  pg_catalog.format_type(p.prorettype, NULL) as "Result data type",
+  CASE WHEN proallargtypes IS NOT NULL THEN
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        CASE
+          WHEN p.proargmodes[s.i] = 'i' THEN ''
+          WHEN p.proargmodes[s.i] = 'o' THEN 'OUT '
+          WHEN p.proargmodes[s.i] = 'b' THEN 'INOUT '
+          WHEN p.proargmodes[s.i] = 'v' THEN 'VARIADIC '
+        END ||
+        CASE
+          WHEN COALESCE(p.proargnames[s.i], '') = '' THEN ''
+          ELSE p.proargnames[s.i] || ' '
+        END ||
+        pg_catalog.format_type(p.proallargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(1, pg_catalog.array_upper(p.proallargtypes, 1)) AS s(i)
+    ), ', ')
+  ELSE
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        CASE
+          WHEN COALESCE(p.proargnames[s.i+1], '') = '' THEN ''
+          ELSE p.proargnames[s.i+1] || ' '
+          END ||
+        pg_catalog.format_type(p.proargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(0, pg_catalog.array_upper(p.proargtypes, 1)) AS s(i)
+    ), ', ')
+  END AS "Argument data types",
+  CASE
+    WHEN p.proisagg THEN 'agg'
+    WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+    ELSE 'func'
+  END AS "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE
+  WHEN p.proisagg THEN 'agg'
+  WHEN p.proiswindow THEN 'window'
+  WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+  ELSE 'func'
+ END as "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 110000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- Functions(functypes="", pattern="public.foo*", verbose=true, showSystem=false)
+-- versions: 80000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  CASE WHEN p.proretset THEN 'SETOF ' ELSE '' END ||
+  pg_catalog.format_type(p.prorettype, NULL) as "Result data type",
+  pg_catalog.oidvectortypes(p.proargtypes) as "Argument data types",
+  CASE
+    WHEN p.proisagg THEN 'agg'
+    WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+    ELSE 'func'
+  END AS "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, '\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 80100, 80200, 80300
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  CASE WHEN p.proretset THEN 'SETOF ' ELSE '' END ||
+  pg_catalog.format_type(p.prorettype, NULL) as "Result data type",
+  CASE WHEN proallargtypes IS NOT NULL THEN
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        CASE
+          WHEN p.proargmodes[s.i] = 'i' THEN ''
+          WHEN p.proargmodes[s.i] = 'o' THEN 'OUT '
+          WHEN p.proargmodes[s.i] = 'b' THEN 'INOUT '
+          WHEN p.proargmodes[s.i] = 'v' THEN 'VARIADIC '
+        END ||
+        CASE
+          WHEN COALESCE(p.proargnames[s.i], '') = '' THEN ''
+          ELSE p.proargnames[s.i] || ' '
+        END ||
+        pg_catalog.format_type(p.proallargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(1, pg_catalog.array_upper(p.proallargtypes, 1)) AS s(i)
+    ), ', ')
+  ELSE
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        CASE
+          WHEN COALESCE(p.proargnames[s.i+1], '') = '' THEN ''
+          ELSE p.proargnames[s.i+1] || ' '
+          END ||
+        pg_catalog.format_type(p.proargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(0, pg_catalog.array_upper(p.proargtypes, 1)) AS s(i)
+    ), ', ')
+  END AS "Argument data types",
+  CASE
+    WHEN p.proisagg THEN 'agg'
+    WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+    ELSE 'func'
+  END AS "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE
+  WHEN p.proisagg THEN 'agg'
+  WHEN p.proiswindow THEN 'window'
+  WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+  ELSE 'func'
+ END as "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 90600, 100000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE
+  WHEN p.proisagg THEN 'agg'
+  WHEN p.proiswindow THEN 'window'
+  WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+  ELSE 'func'
+ END as "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ CASE
+  WHEN p.proparallel = 'r' THEN 'restricted'
+  WHEN p.proparallel = 's' THEN 'safe'
+  WHEN p.proparallel = 'u' THEN 'unsafe'
+ END as "Parallel",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 110000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ CASE
+  WHEN p.proparallel = 'r' THEN 'restricted'
+  WHEN p.proparallel = 's' THEN 'safe'
+  WHEN p.proparallel = 'u' THEN 'unsafe'
+ END as "Parallel",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ CASE
+  WHEN p.proparallel = 'r' THEN 'restricted'
+  WHEN p.proparallel = 's' THEN 'safe'
+  WHEN p.proparallel = 'u' THEN 'unsafe'
+ END as "Parallel",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE p.proname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- Functions(functypes="", pattern="public.foo*", verbose=true, showSystem=true)
+-- versions: 80000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  CASE WHEN p.proretset THEN 'SETOF ' ELSE '' END ||
+  pg_catalog.format_type(p.prorettype, NULL) as "Result data type",
+  pg_catalog.oidvectortypes(p.proargtypes) as "Argument data types",
+  CASE
+    WHEN p.proisagg THEN 'agg'
+    WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+    ELSE 'func'
+  END AS "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, '\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 80100, 80200, 80300
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  CASE WHEN p.proretset THEN 'SETOF ' ELSE '' END ||
+  pg_catalog.format_type(p.prorettype, NULL) as "Result data type",
+  CASE WHEN proallargtypes IS NOT NULL THEN
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        CASE
+          WHEN p.proargmodes[s.i] = 'i' THEN ''
+          WHEN p.proargmodes[s.i] = 'o' THEN 'OUT '
+          WHEN p.proargmodes[s.i] = 'b' THEN 'INOUT '
+          WHEN p.proargmodes[s.i] = 'v' THEN 'VARIADIC '
+        END ||
+        CASE
+          WHEN COALESCE(p.proargnames[s.i], '') = '' THEN ''
+          ELSE p.proargnames[s.i] || ' '
+        END ||
+        pg_catalog.format_type(p.proallargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(1, pg_catalog.array_upper(p.proallargtypes, 1)) AS s(i)
+    ), ', ')
+  ELSE
+    pg_catalog.array_to_string(ARRAY(
+      SELECT
+        CASE
+          WHEN COALESCE(p.proargnames[s.i+1], '') = '' THEN ''
+          ELSE p.proargnames[s.i+1] || ' '
+          END ||
+        pg_catalog.format_type(p.proargtypes[s.i], NULL)
+      FROM
+        pg_catalog.generate_series(0, pg_catalog.array_upper(p.proargtypes, 1)) AS s(i)
+    ), ', ')
+  END AS "Argument data types",
+  CASE
+    WHEN p.proisagg THEN 'agg'
+    WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+    ELSE 'func'
+  END AS "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE
+  WHEN p.proisagg THEN 'agg'
+  WHEN p.proiswindow THEN 'window'
+  WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+  ELSE 'func'
+ END as "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 90600, 100000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE
+  WHEN p.proisagg THEN 'agg'
+  WHEN p.proiswindow THEN 'window'
+  WHEN p.prorettype = 'pg_catalog.trigger'::pg_catalog.regtype THEN 'trigger'
+  ELSE 'func'
+ END as "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ CASE
+  WHEN p.proparallel = 'r' THEN 'restricted'
+  WHEN p.proparallel = 's' THEN 'safe'
+  WHEN p.proparallel = 'u' THEN 'unsafe'
+ END as "Parallel",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 110000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ CASE
+  WHEN p.proparallel = 'r' THEN 'restricted'
+  WHEN p.proparallel = 's' THEN 'safe'
+  WHEN p.proparallel = 'u' THEN 'unsafe'
+ END as "Parallel",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ CASE
+  WHEN p.proparallel = 'r' THEN 'restricted'
+  WHEN p.proparallel = 's' THEN 'safe'
+  WHEN p.proparallel = 'u' THEN 'unsafe'
+ END as "Parallel",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE p.proname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- Functions(functypes="anptwS", pattern="", verbose=false, showSystem=false)
+-- versions: 80000
+-- error: "\\df does not take a \"p\" option with server version 8.0\n"
+
+-- versions: 80100
+-- error: "\\df does not take a \"p\" option with server version 8.1\n"
+
+-- versions: 80200
+-- error: "\\df does not take a \"p\" option with server version 8.2\n"
+
+-- versions: 80300
+-- error: "\\df does not take a \"p\" option with server version 8.3\n"
+
+-- versions: 80400
+-- error: "\\df does not take a \"p\" option with server version 8.4\n"
+
+-- versions: 90000
+-- error: "\\df does not take a \"p\" option with server version 9.0\n"
+
+-- versions: 90100
+-- error: "\\df does not take a \"p\" option with server version 9.1\n"
+
+-- versions: 90200
+-- error: "\\df does not take a \"p\" option with server version 9.2\n"
+
+-- versions: 90300
+-- error: "\\df does not take a \"p\" option with server version 9.3\n"
+
+-- versions: 90400
+-- error: "\\df does not take a \"p\" option with server version 9.4\n"
+
+-- versions: 90500
+-- error: "\\df does not take a \"p\" option with server version 9.5\n"
+
+-- versions: 90600
+-- error: "\\df does not take a \"p\" option with server version 9.6\n"
+
+-- versions: 100000
+-- error: "\\df does not take a \"p\" option with server version 10\n"
+
+-- versions: 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+ORDER BY 1, 2, 4;
+
+-- Functions(functypes="anptwS", pattern="", verbose=false, showSystem=true)
+-- versions: 80000
+-- error: "\\df does not take a \"p\" option with server version 8.0\n"
+
+-- versions: 80100
+-- error: "\\df does not take a \"p\" option with server version 8.1\n"
+
+-- versions: 80200
+-- error: "\\df does not take a \"p\" option with server version 8.2\n"
+
+-- versions: 80300
+-- error: "\\df does not take a \"p\" option with server version 8.3\n"
+
+-- versions: 80400
+-- error: "\\df does not take a \"p\" option with server version 8.4\n"
+
+-- versions: 90000
+-- error: "\\df does not take a \"p\" option with server version 9.0\n"
+
+-- versions: 90100
+-- error: "\\df does not take a \"p\" option with server version 9.1\n"
+
+-- versions: 90200
+-- error: "\\df does not take a \"p\" option with server version 9.2\n"
+
+-- versions: 90300
+-- error: "\\df does not take a \"p\" option with server version 9.3\n"
+
+-- versions: 90400
+-- error: "\\df does not take a \"p\" option with server version 9.4\n"
+
+-- versions: 90500
+-- error: "\\df does not take a \"p\" option with server version 9.5\n"
+
+-- versions: 90600
+-- error: "\\df does not take a \"p\" option with server version 9.6\n"
+
+-- versions: 100000
+-- error: "\\df does not take a \"p\" option with server version 10\n"
+
+-- versions: 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- Functions(functypes="anptwS", pattern="", verbose=true, showSystem=false)
+-- versions: 80000
+-- error: "\\df does not take a \"p\" option with server version 8.0\n"
+
+-- versions: 80100
+-- error: "\\df does not take a \"p\" option with server version 8.1\n"
+
+-- versions: 80200
+-- error: "\\df does not take a \"p\" option with server version 8.2\n"
+
+-- versions: 80300
+-- error: "\\df does not take a \"p\" option with server version 8.3\n"
+
+-- versions: 80400
+-- error: "\\df does not take a \"p\" option with server version 8.4\n"
+
+-- versions: 90000
+-- error: "\\df does not take a \"p\" option with server version 9.0\n"
+
+-- versions: 90100
+-- error: "\\df does not take a \"p\" option with server version 9.1\n"
+
+-- versions: 90200
+-- error: "\\df does not take a \"p\" option with server version 9.2\n"
+
+-- versions: 90300
+-- error: "\\df does not take a \"p\" option with server version 9.3\n"
+
+-- versions: 90400
+-- error: "\\df does not take a \"p\" option with server version 9.4\n"
+
+-- versions: 90500
+-- error: "\\df does not take a \"p\" option with server version 9.5\n"
+
+-- versions: 90600
+-- error: "\\df does not take a \"p\" option with server version 9.6\n"
+
+-- versions: 100000
+-- error: "\\df does not take a \"p\" option with server version 10\n"
+
+-- versions: 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ CASE
+  WHEN p.proparallel = 'r' THEN 'restricted'
+  WHEN p.proparallel = 's' THEN 'safe'
+  WHEN p.proparallel = 'u' THEN 'unsafe'
+ END as "Parallel",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+ORDER BY 1, 2, 4;
+
+-- Functions(functypes="anptwS", pattern="", verbose=true, showSystem=true)
+-- versions: 80000
+-- error: "\\df does not take a \"p\" option with server version 8.0\n"
+
+-- versions: 80100
+-- error: "\\df does not take a \"p\" option with server version 8.1\n"
+
+-- versions: 80200
+-- error: "\\df does not take a \"p\" option with server version 8.2\n"
+
+-- versions: 80300
+-- error: "\\df does not take a \"p\" option with server version 8.3\n"
+
+-- versions: 80400
+-- error: "\\df does not take a \"p\" option with server version 8.4\n"
+
+-- versions: 90000
+-- error: "\\df does not take a \"p\" option with server version 9.0\n"
+
+-- versions: 90100
+-- error: "\\df does not take a \"p\" option with server version 9.1\n"
+
+-- versions: 90200
+-- error: "\\df does not take a \"p\" option with server version 9.2\n"
+
+-- versions: 90300
+-- error: "\\df does not take a \"p\" option with server version 9.3\n"
+
+-- versions: 90400
+-- error: "\\df does not take a \"p\" option with server version 9.4\n"
+
+-- versions: 90500
+-- error: "\\df does not take a \"p\" option with server version 9.5\n"
+
+-- versions: 90600
+-- error: "\\df does not take a \"p\" option with server version 9.6\n"
+
+-- versions: 100000
+-- error: "\\df does not take a \"p\" option with server version 10\n"
+
+-- versions: 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ CASE
+  WHEN p.proparallel = 'r' THEN 'restricted'
+  WHEN p.proparallel = 's' THEN 'safe'
+  WHEN p.proparallel = 'u' THEN 'unsafe'
+ END as "Parallel",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+ORDER BY 1, 2, 4;
+
+-- Functions(functypes="anptwS", pattern="public.foo*", verbose=false, showSystem=false)
+-- versions: 80000
+-- error: "\\df does not take a \"p\" option with server version 8.0\n"
+
+-- versions: 80100
+-- error: "\\df does not take a \"p\" option with server version 8.1\n"
+
+-- versions: 80200
+-- error: "\\df does not take a \"p\" option with server version 8.2\n"
+
+-- versions: 80300
+-- error: "\\df does not take a \"p\" option with server version 8.3\n"
+
+-- versions: 80400
+-- error: "\\df does not take a \"p\" option with server version 8.4\n"
+
+-- versions: 90000
+-- error: "\\df does not take a \"p\" option with server version 9.0\n"
+
+-- versions: 90100
+-- error: "\\df does not take a \"p\" option with server version 9.1\n"
+
+-- versions: 90200
+-- error: "\\df does not take a \"p\" option with server version 9.2\n"
+
+-- versions: 90300
+-- error: "\\df does not take a \"p\" option with server version 9.3\n"
+
+-- versions: 90400
+-- error: "\\df does not take a \"p\" option with server version 9.4\n"
+
+-- versions: 90500
+-- error: "\\df does not take a \"p\" option with server version 9.5\n"
+
+-- versions: 90600
+-- error: "\\df does not take a \"p\" option with server version 9.6\n"
+
+-- versions: 100000
+-- error: "\\df does not take a \"p\" option with server version 10\n"
+
+-- versions: 110000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- Functions(functypes="anptwS", pattern="public.foo*", verbose=false, showSystem=true)
+-- versions: 80000
+-- error: "\\df does not take a \"p\" option with server version 8.0\n"
+
+-- versions: 80100
+-- error: "\\df does not take a \"p\" option with server version 8.1\n"
+
+-- versions: 80200
+-- error: "\\df does not take a \"p\" option with server version 8.2\n"
+
+-- versions: 80300
+-- error: "\\df does not take a \"p\" option with server version 8.3\n"
+
+-- versions: 80400
+-- error: "\\df does not take a \"p\" option with server version 8.4\n"
+
+-- versions: 90000
+-- error: "\\df does not take a \"p\" option with server version 9.0\n"
+
+-- versions: 90100
+-- error: "\\df does not take a \"p\" option with server version 9.1\n"
+
+-- versions: 90200
+-- error: "\\df does not take a \"p\" option with server version 9.2\n"
+
+-- versions: 90300
+-- error: "\\df does not take a \"p\" option with server version 9.3\n"
+
+-- versions: 90400
+-- error: "\\df does not take a \"p\" option with server version 9.4\n"
+
+-- versions: 90500
+-- error: "\\df does not take a \"p\" option with server version 9.5\n"
+
+-- versions: 90600
+-- error: "\\df does not take a \"p\" option with server version 9.6\n"
+
+-- versions: 100000
+-- error: "\\df does not take a \"p\" option with server version 10\n"
+
+-- versions: 110000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE p.proname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- Functions(functypes="anptwS", pattern="public.foo*", verbose=true, showSystem=false)
+-- versions: 80000
+-- error: "\\df does not take a \"p\" option with server version 8.0\n"
+
+-- versions: 80100
+-- error: "\\df does not take a \"p\" option with server version 8.1\n"
+
+-- versions: 80200
+-- error: "\\df does not take a \"p\" option with server version 8.2\n"
+
+-- versions: 80300
+-- error: "\\df does not take a \"p\" option with server version 8.3\n"
+
+-- versions: 80400
+-- error: "\\df does not take a \"p\" option with server version 8.4\n"
+
+-- versions: 90000
+-- error: "\\df does not take a \"p\" option with server version 9.0\n"
+
+-- versions: 90100
+-- error: "\\df does not take a \"p\" option with server version 9.1\n"
+
+-- versions: 90200
+-- error: "\\df does not take a \"p\" option with server version 9.2\n"
+
+-- versions: 90300
+-- error: "\\df does not take a \"p\" option with server version 9.3\n"
+
+-- versions: 90400
+-- error: "\\df does not take a \"p\" option with server version 9.4\n"
+
+-- versions: 90500
+-- error: "\\df does not take a \"p\" option with server version 9.5\n"
+
+-- versions: 90600
+-- error: "\\df does not take a \"p\" option with server version 9.6\n"
+
+-- versions: 100000
+-- error: "\\df does not take a \"p\" option with server version 10\n"
+
+-- versions: 110000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ CASE
+  WHEN p.proparallel = 'r' THEN 'restricted'
+  WHEN p.proparallel = 's' THEN 'safe'
+  WHEN p.proparallel = 'u' THEN 'unsafe'
+ END as "Parallel",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ CASE
+  WHEN p.proparallel = 'r' THEN 'restricted'
+  WHEN p.proparallel = 's' THEN 'safe'
+  WHEN p.proparallel = 'u' THEN 'unsafe'
+ END as "Parallel",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE p.proname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- Functions(functypes="anptwS", pattern="public.foo*", verbose=true, showSystem=true)
+-- versions: 80000
+-- error: "\\df does not take a \"p\" option with server version 8.0\n"
+
+-- versions: 80100
+-- error: "\\df does not take a \"p\" option with server version 8.1\n"
+
+-- versions: 80200
+-- error: "\\df does not take a \"p\" option with server version 8.2\n"
+
+-- versions: 80300
+-- error: "\\df does not take a \"p\" option with server version 8.3\n"
+
+-- versions: 80400
+-- error: "\\df does not take a \"p\" option with server version 8.4\n"
+
+-- versions: 90000
+-- error: "\\df does not take a \"p\" option with server version 9.0\n"
+
+-- versions: 90100
+-- error: "\\df does not take a \"p\" option with server version 9.1\n"
+
+-- versions: 90200
+-- error: "\\df does not take a \"p\" option with server version 9.2\n"
+
+-- versions: 90300
+-- error: "\\df does not take a \"p\" option with server version 9.3\n"
+
+-- versions: 90400
+-- error: "\\df does not take a \"p\" option with server version 9.4\n"
+
+-- versions: 90500
+-- error: "\\df does not take a \"p\" option with server version 9.5\n"
+
+-- versions: 90600
+-- error: "\\df does not take a \"p\" option with server version 9.6\n"
+
+-- versions: 100000
+-- error: "\\df does not take a \"p\" option with server version 10\n"
+
+-- versions: 110000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ CASE
+  WHEN p.proparallel = 'r' THEN 'restricted'
+  WHEN p.proparallel = 's' THEN 'safe'
+  WHEN p.proparallel = 'u' THEN 'unsafe'
+ END as "Parallel",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE p.proname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type",
+ CASE
+  WHEN p.provolatile = 'i' THEN 'immutable'
+  WHEN p.provolatile = 's' THEN 'stable'
+  WHEN p.provolatile = 'v' THEN 'volatile'
+ END as "Volatility",
+ CASE
+  WHEN p.proparallel = 'r' THEN 'restricted'
+  WHEN p.proparallel = 's' THEN 'safe'
+  WHEN p.proparallel = 'u' THEN 'unsafe'
+ END as "Parallel",
+ pg_catalog.pg_get_userbyid(p.proowner) as "Owner",
+ CASE WHEN prosecdef THEN 'definer' ELSE 'invoker' END AS "Security",
+ pg_catalog.array_to_string(p.proacl, E'\n') AS "Access privileges",
+ l.lanname as "Language",
+ p.prosrc as "Source code",
+ pg_catalog.obj_description(p.oid, 'pg_proc') as "Description"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+     LEFT JOIN pg_catalog.pg_language l ON l.oid = p.prolang
+WHERE p.proname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2, 4;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
@@ -0,0 +1,196 @@
+-- GetPublicationDetails(pattern="pgdesc_*")
+-- versions: 80000
+-- error: "The server (version 8.0) does not support publications.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support publications.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support publications.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support publications.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support publications.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support publications.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support publications.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support publications.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support publications.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support publications.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support publications.\n"
+
+-- versions: 90600
+-- error: "The server (version 9.6) does not support publications.\n"
+
+-- versions: 100000
+SELECT oid, pubname,
+  pg_catalog.pg_get_userbyid(pubowner) AS owner,
+  puballtables, pubinsert, pubupdate, pubdelete, false AS pubtruncate, false AS pubviaroot
+FROM pg_catalog.pg_publication
+WHERE pubname OPERATOR(pg_catalog.~) $1
+ORDER BY 2;
+-- $1 = "^(pgdesc_.*)$"
+SELECT n.nspname, c.relname, NULL, NULL
+FROM pg_catalog.pg_class c,
+     pg_catalog.pg_namespace n,
+     pg_catalog.pg_publication_rel pr
+WHERE c.relnamespace = n.oid
+  AND c.oid = pr.prrelid
+  AND pr.prpubid = $1
+ORDER BY 1,2
+-- $1 = "16401"
+SELECT n.nspname, c.relname, NULL, NULL
+FROM pg_catalog.pg_class c,
+     pg_catalog.pg_namespace n,
+     pg_catalog.pg_publication_rel pr
+WHERE c.relnamespace = n.oid
+  AND c.oid = pr.prrelid
+  AND pr.prpubid = $1
+ORDER BY 1,2
+-- $1 = "16402"
+
+-- versions: 110000
+SELECT oid, pubname,
+  pg_catalog.pg_get_userbyid(pubowner) AS owner,
+  puballtables, pubinsert, pubupdate, pubdelete, pubtruncate, false AS pubviaroot
+FROM pg_catalog.pg_publication
+WHERE pubname OPERATOR(pg_catalog.~) $1
+ORDER BY 2;
+-- $1 = "^(pgdesc_.*)$"
+SELECT n.nspname, c.relname, NULL, NULL
+FROM pg_catalog.pg_class c,
+     pg_catalog.pg_namespace n,
+     pg_catalog.pg_publication_rel pr
+WHERE c.relnamespace = n.oid
+  AND c.oid = pr.prrelid
+  AND pr.prpubid = $1
+ORDER BY 1,2
+-- $1 = "16401"
+SELECT n.nspname, c.relname, NULL, NULL
+FROM pg_catalog.pg_class c,
+     pg_catalog.pg_namespace n,
+     pg_catalog.pg_publication_rel pr
+WHERE c.relnamespace = n.oid
+  AND c.oid = pr.prrelid
+  AND pr.prpubid = $1
+ORDER BY 1,2
+-- $1 = "16402"
+
+-- versions: 120000
+SELECT oid, pubname,
+  pg_catalog.pg_get_userbyid(pubowner) AS owner,
+  puballtables, pubinsert, pubupdate, pubdelete, pubtruncate, false AS pubviaroot
+FROM pg_catalog.pg_publication
+WHERE pubname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+ORDER BY 2;
+-- $1 = "^(pgdesc_.*)$"
+SELECT n.nspname, c.relname, NULL, NULL
+FROM pg_catalog.pg_class c,
+     pg_catalog.pg_namespace n,
+     pg_catalog.pg_publication_rel pr
+WHERE c.relnamespace = n.oid
+  AND c.oid = pr.prrelid
+  AND pr.prpubid = $1
+ORDER BY 1,2
+-- $1 = "16401"
+SELECT n.nspname, c.relname, NULL, NULL
+FROM pg_catalog.pg_class c,
+     pg_catalog.pg_namespace n,
+     pg_catalog.pg_publication_rel pr
+WHERE c.relnamespace = n.oid
+  AND c.oid = pr.prrelid
+  AND pr.prpubid = $1
+ORDER BY 1,2
+-- $1 = "16402"
+
+-- versions: 130000, 140000
+SELECT oid, pubname,
+  pg_catalog.pg_get_userbyid(pubowner) AS owner,
+  puballtables, pubinsert, pubupdate, pubdelete, pubtruncate, pubviaroot
+FROM pg_catalog.pg_publication
+WHERE pubname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+ORDER BY 2;
+-- $1 = "^(pgdesc_.*)$"
+SELECT n.nspname, c.relname, NULL, NULL
+FROM pg_catalog.pg_class c,
+     pg_catalog.pg_namespace n,
+     pg_catalog.pg_publication_rel pr
+WHERE c.relnamespace = n.oid
+  AND c.oid = pr.prrelid
+  AND pr.prpubid = $1
+ORDER BY 1,2
+-- $1 = "16401"
+SELECT n.nspname, c.relname, NULL, NULL
+FROM pg_catalog.pg_class c,
+     pg_catalog.pg_namespace n,
+     pg_catalog.pg_publication_rel pr
+WHERE c.relnamespace = n.oid
+  AND c.oid = pr.prrelid
+  AND pr.prpubid = $1
+ORDER BY 1,2
+-- $1 = "16402"
+
+-- versions: 150000, 160000, 170000
+SELECT oid, pubname,
+  pg_catalog.pg_get_userbyid(pubowner) AS owner,
+  puballtables, pubinsert, pubupdate, pubdelete, pubtruncate, pubviaroot
+FROM pg_catalog.pg_publication
+WHERE pubname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+ORDER BY 2;
+-- $1 = "^(pgdesc_.*)$"
+SELECT n.nspname, c.relname, pg_get_expr(pr.prqual, c.oid), (CASE WHEN pr.prattrs IS NOT NULL THEN
+     pg_catalog.array_to_string(      ARRAY(SELECT attname
+              FROM
+                pg_catalog.generate_series(0, pg_catalog.array_upper(pr.prattrs::pg_catalog.int2[], 1)) s,
+                pg_catalog.pg_attribute
+        WHERE attrelid = c.oid AND attnum = prattrs[s]), ', ')
+       ELSE NULL END)
+FROM pg_catalog.pg_class c,
+     pg_catalog.pg_namespace n,
+     pg_catalog.pg_publication_rel pr
+WHERE c.relnamespace = n.oid
+  AND c.oid = pr.prrelid
+  AND pr.prpubid = $1
+ORDER BY 1,2
+-- $1 = "16401"
+SELECT n.nspname
+FROM pg_catalog.pg_namespace n
+     JOIN pg_catalog.pg_publication_namespace pn ON n.oid = pn.pnnspid
+WHERE pn.pnpubid = $1
+ORDER BY 1
+-- $1 = "16401"
+SELECT n.nspname, c.relname, pg_get_expr(pr.prqual, c.oid), (CASE WHEN pr.prattrs IS NOT NULL THEN
+     pg_catalog.array_to_string(      ARRAY(SELECT attname
+              FROM
+                pg_catalog.generate_series(0, pg_catalog.array_upper(pr.prattrs::pg_catalog.int2[], 1)) s,
+                pg_catalog.pg_attribute
+        WHERE attrelid = c.oid AND attnum = prattrs[s]), ', ')
+       ELSE NULL END)
+FROM pg_catalog.pg_class c,
+     pg_catalog.pg_namespace n,
+     pg_catalog.pg_publication_rel pr
+WHERE c.relnamespace = n.oid
+  AND c.oid = pr.prrelid
+  AND pr.prpubid = $1
+ORDER BY 1,2
+-- $1 = "16402"
+SELECT n.nspname
+FROM pg_catalog.pg_namespace n
+     JOIN pg_catalog.pg_publication_namespace pn ON n.oid = pn.pnnspid
+WHERE pn.pnpubid = $1
+ORDER BY 1
+-- $1 = "16402"
+
@@ -0,0 +1,37 @@
+-- OneTextSearchParser(oid="16384", nspname="foo", prsname="foo")
+-- versions: 80000, 80100, 80200, 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT 'Start parse' AS "Method",
+   p.prsstart::pg_catalog.regproc AS "Function",
+   pg_catalog.obj_description(p.prsstart, 'pg_proc') as "Description"
+ FROM pg_catalog.pg_ts_parser p
+ WHERE p.oid = $1
+UNION ALL
+SELECT 'Get next token',
+   p.prstoken::pg_catalog.regproc,
+   pg_catalog.obj_description(p.prstoken, 'pg_proc')
+ FROM pg_catalog.pg_ts_parser p
+ WHERE p.oid = $2
+UNION ALL
+SELECT 'End parse',
+   p.prsend::pg_catalog.regproc,
+   pg_catalog.obj_description(p.prsend, 'pg_proc')
+ FROM pg_catalog.pg_ts_parser p
+ WHERE p.oid = $3
+UNION ALL
+SELECT 'Get headline',
+   p.prsheadline::pg_catalog.regproc,
+   pg_catalog.obj_description(p.prsheadline, 'pg_proc')
+ FROM pg_catalog.pg_ts_parser p
+ WHERE p.oid = $4
+UNION ALL
+SELECT 'Get token types',
+   p.prslextype::pg_catalog.regproc,
+   pg_catalog.obj_description(p.prslextype, 'pg_proc')
+ FROM pg_catalog.pg_ts_parser p
+ WHERE p.oid = $5;
+-- $1 = "16384"
+-- $2 = "16384"
+-- $3 = "16384"
+-- $4 = "16384"
+-- $5 = "16384"
+
@@ -0,0 +1,520 @@
+-- PartitionedTables(reltypes="", pattern="", verbose=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support declarative table partitioning.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support declarative table partitioning.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support declarative table partitioning.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support declarative table partitioning.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support declarative table partitioning.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support declarative table partitioning.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support declarative table partitioning.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support declarative table partitioning.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support declarative table partitioning.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support declarative table partitioning.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support declarative table partitioning.\n"
+
+-- versions: 90600
+-- error: "The server (version 9.6) does not support declarative table partitioning.\n"
+
+-- versions: 100000
+-- error: "The server (version 10) does not support declarative table partitioning.\n"
+
+-- versions: 110000
+-- error: "The server (version 11) does not support declarative table partitioning.\n"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  c.relname as "Name",
+  pg_catalog.pg_get_userbyid(c.relowner) as "Owner",
+  CASE c.relkind WHEN 'p' THEN 'partitioned table' WHEN 'I' THEN 'partitioned index' END as "Type",
+ c2.oid::pg_catalog.regclass as "Table"
+FROM pg_catalog.pg_class c
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+     LEFT JOIN pg_catalog.pg_index i ON i.indexrelid = c.oid
+     LEFT JOIN pg_catalog.pg_class c2 ON i.indrelid = c2.oid
+WHERE c.relkind IN ('p','I','')
+ AND NOT c.relispartition
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname !~ '^pg_toast'
+      AND n.nspname <> 'information_schema'
+  AND pg_catalog.pg_table_is_visible(c.oid)
+ORDER BY "Schema", "Type" DESC, "Name";
+
+-- PartitionedTables(reltypes="", pattern="", verbose=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support declarative table partitioning.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support declarative table partitioning.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support declarative table partitioning.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support declarative table partitioning.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support declarative table partitioning.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support declarative table partitioning.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support declarative table partitioning.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support declarative table partitioning.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support declarative table partitioning.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support declarative table partitioning.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support declarative table partitioning.\n"
+
+-- versions: 90600
+-- error: "The server (version 9.6) does not support declarative table partitioning.\n"
+
+-- versions: 100000
+-- error: "The server (version 10) does not support declarative table partitioning.\n"
+
+-- versions: 110000
+-- error: "The server (version 11) does not support declarative table partitioning.\n"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  c.relname as "Name",
+  pg_catalog.pg_get_userbyid(c.relowner) as "Owner",
+  CASE c.relkind WHEN 'p' THEN 'partitioned table' WHEN 'I' THEN 'partitioned index' END as "Type",
+ c2.oid::pg_catalog.regclass as "Table",
+  s.tps as "Total size",
+  pg_catalog.obj_description(c.oid, 'pg_class') as "Description"
+FROM pg_catalog.pg_class c
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+     LEFT JOIN pg_catalog.pg_index i ON i.indexrelid = c.oid
+     LEFT JOIN pg_catalog.pg_class c2 ON i.indrelid = c2.oid,
+     LATERAL (SELECT pg_catalog.pg_size_pretty(sum(
+                 CASE WHEN ppt.isleaf AND ppt.level = 1
+                      THEN pg_catalog.pg_table_size(ppt.relid) ELSE 0 END)) AS dps,
+                     pg_catalog.pg_size_pretty(sum(pg_catalog.pg_table_size(ppt.relid))) AS tps
+              FROM pg_catalog.pg_partition_tree(c.oid) ppt) s
+WHERE c.relkind IN ('p','I','')
+ AND NOT c.relispartition
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname !~ '^pg_toast'
+      AND n.nspname <> 'information_schema'
+  AND pg_catalog.pg_table_is_visible(c.oid)
+ORDER BY "Schema", "Type" DESC, "Name";
+
+-- PartitionedTables(reltypes="", pattern="public.foo*", verbose=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support declarative table partitioning.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support declarative table partitioning.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support declarative table partitioning.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support declarative table partitioning.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support declarative table partitioning.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support declarative table partitioning.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support declarative table partitioning.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support declarative table partitioning.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support declarative table partitioning.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support declarative table partitioning.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support declarative table partitioning.\n"
+
+-- versions: 90600
+-- error: "The server (version 9.6) does not support declarative table partitioning.\n"
+
+-- versions: 100000
+-- error: "The server (version 10) does not support declarative table partitioning.\n"
+
+-- versions: 110000
+-- error: "The server (version 11) does not support declarative table partitioning.\n"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  c.relname as "Name",
+  pg_catalog.pg_get_userbyid(c.relowner) as "Owner",
+  CASE c.relkind WHEN 'p' THEN 'partitioned table' WHEN 'I' THEN 'partitioned index' END as "Type",
+  inh.inhparent::pg_catalog.regclass as "Parent name",
+ c2.oid::pg_catalog.regclass as "Table"
+FROM pg_catalog.pg_class c
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+     LEFT JOIN pg_catalog.pg_index i ON i.indexrelid = c.oid
+     LEFT JOIN pg_catalog.pg_class c2 ON i.indrelid = c2.oid
+     LEFT JOIN pg_catalog.pg_inherits inh ON c.oid = inh.inhrelid
+WHERE c.relkind IN ('p','I','')
+  AND c.relname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY "Schema", "Type" DESC, "Parent name" NULLS FIRST, "Name";
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- PartitionedTables(reltypes="", pattern="public.foo*", verbose=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support declarative table partitioning.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support declarative table partitioning.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support declarative table partitioning.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support declarative table partitioning.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support declarative table partitioning.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support declarative table partitioning.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support declarative table partitioning.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support declarative table partitioning.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support declarative table partitioning.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support declarative table partitioning.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support declarative table partitioning.\n"
+
+-- versions: 90600
+-- error: "The server (version 9.6) does not support declarative table partitioning.\n"
+
+-- versions: 100000
+-- error: "The server (version 10) does not support declarative table partitioning.\n"
+
+-- versions: 110000
+-- error: "The server (version 11) does not support declarative table partitioning.\n"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  c.relname as "Name",
+  pg_catalog.pg_get_userbyid(c.relowner) as "Owner",
+  CASE c.relkind WHEN 'p' THEN 'partitioned table' WHEN 'I' THEN 'partitioned index' END as "Type",
+  inh.inhparent::pg_catalog.regclass as "Parent name",
+ c2.oid::pg_catalog.regclass as "Table",
+  s.tps as "Total size",
+  pg_catalog.obj_description(c.oid, 'pg_class') as "Description"
+FROM pg_catalog.pg_class c
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+     LEFT JOIN pg_catalog.pg_index i ON i.indexrelid = c.oid
+     LEFT JOIN pg_catalog.pg_class c2 ON i.indrelid = c2.oid
+     LEFT JOIN pg_catalog.pg_inherits inh ON c.oid = inh.inhrelid,
+     LATERAL (SELECT pg_catalog.pg_size_pretty(sum(
+                 CASE WHEN ppt.isleaf AND ppt.level = 1
+                      THEN pg_catalog.pg_table_size(ppt.relid) ELSE 0 END)) AS dps,
+                     pg_catalog.pg_size_pretty(sum(pg_catalog.pg_table_size(ppt.relid))) AS tps
+              FROM pg_catalog.pg_partition_tree(c.oid) ppt) s
+WHERE c.relkind IN ('p','I','')
+  AND c.relname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY "Schema", "Type" DESC, "Parent name" NULLS FIRST, "Name";
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- PartitionedTables(reltypes="ti", pattern="", verbose=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support declarative table partitioning.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support declarative table partitioning.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support declarative table partitioning.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support declarative table partitioning.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support declarative table partitioning.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support declarative table partitioning.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support declarative table partitioning.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support declarative table partitioning.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support declarative table partitioning.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support declarative table partitioning.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support declarative table partitioning.\n"
+
+-- versions: 90600
+-- error: "The server (version 9.6) does not support declarative table partitioning.\n"
+
+-- versions: 100000
+-- error: "The server (version 10) does not support declarative table partitioning.\n"
+
+-- versions: 110000
+-- error: "The server (version 11) does not support declarative table partitioning.\n"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  c.relname as "Name",
+  pg_catalog.pg_get_userbyid(c.relowner) as "Owner",
+  CASE c.relkind WHEN 'p' THEN 'partitioned table' WHEN 'I' THEN 'partitioned index' END as "Type",
+ c2.oid::pg_catalog.regclass as "Table"
+FROM pg_catalog.pg_class c
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+     LEFT JOIN pg_catalog.pg_index i ON i.indexrelid = c.oid
+     LEFT JOIN pg_catalog.pg_class c2 ON i.indrelid = c2.oid
+WHERE c.relkind IN ('p','I','')
+ AND NOT c.relispartition
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname !~ '^pg_toast'
+      AND n.nspname <> 'information_schema'
+  AND pg_catalog.pg_table_is_visible(c.oid)
+ORDER BY "Schema", "Type" DESC, "Name";
+
+-- PartitionedTables(reltypes="ti", pattern="", verbose=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support declarative table partitioning.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support declarative table partitioning.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support declarative table partitioning.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support declarative table partitioning.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support declarative table partitioning.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support declarative table partitioning.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support declarative table partitioning.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support declarative table partitioning.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support declarative table partitioning.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support declarative table partitioning.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support declarative table partitioning.\n"
+
+-- versions: 90600
+-- error: "The server (version 9.6) does not support declarative table partitioning.\n"
+
+-- versions: 100000
+-- error: "The server (version 10) does not support declarative table partitioning.\n"
+
+-- versions: 110000
+-- error: "The server (version 11) does not support declarative table partitioning.\n"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  c.relname as "Name",
+  pg_catalog.pg_get_userbyid(c.relowner) as "Owner",
+  CASE c.relkind WHEN 'p' THEN 'partitioned table' WHEN 'I' THEN 'partitioned index' END as "Type",
+ c2.oid::pg_catalog.regclass as "Table",
+  s.tps as "Total size",
+  pg_catalog.obj_description(c.oid, 'pg_class') as "Description"
+FROM pg_catalog.pg_class c
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+     LEFT JOIN pg_catalog.pg_index i ON i.indexrelid = c.oid
+     LEFT JOIN pg_catalog.pg_class c2 ON i.indrelid = c2.oid,
+     LATERAL (SELECT pg_catalog.pg_size_pretty(sum(
+                 CASE WHEN ppt.isleaf AND ppt.level = 1
+                      THEN pg_catalog.pg_table_size(ppt.relid) ELSE 0 END)) AS dps,
+                     pg_catalog.pg_size_pretty(sum(pg_catalog.pg_table_size(ppt.relid))) AS tps
+              FROM pg_catalog.pg_partition_tree(c.oid) ppt) s
+WHERE c.relkind IN ('p','I','')
+ AND NOT c.relispartition
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname !~ '^pg_toast'
+      AND n.nspname <> 'information_schema'
+  AND pg_catalog.pg_table_is_visible(c.oid)
+ORDER BY "Schema", "Type" DESC, "Name";
+
+-- PartitionedTables(reltypes="ti", pattern="public.foo*", verbose=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support declarative table partitioning.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support declarative table partitioning.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support declarative table partitioning.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support declarative table partitioning.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support declarative table partitioning.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support declarative table partitioning.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support declarative table partitioning.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support declarative table partitioning.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support declarative table partitioning.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support declarative table partitioning.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support declarative table partitioning.\n"
+
+-- versions: 90600
+-- error: "The server (version 9.6) does not support declarative table partitioning.\n"
+
+-- versions: 100000
+-- error: "The server (version 10) does not support declarative table partitioning.\n"
+
+-- versions: 110000
+-- error: "The server (version 11) does not support declarative table partitioning.\n"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  c.relname as "Name",
+  pg_catalog.pg_get_userbyid(c.relowner) as "Owner",
+  CASE c.relkind WHEN 'p' THEN 'partitioned table' WHEN 'I' THEN 'partitioned index' END as "Type",
+  inh.inhparent::pg_catalog.regclass as "Parent name",
+ c2.oid::pg_catalog.regclass as "Table"
+FROM pg_catalog.pg_class c
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+     LEFT JOIN pg_catalog.pg_index i ON i.indexrelid = c.oid
+     LEFT JOIN pg_catalog.pg_class c2 ON i.indrelid = c2.oid
+     LEFT JOIN pg_catalog.pg_inherits inh ON c.oid = inh.inhrelid
+WHERE c.relkind IN ('p','I','')
+  AND c.relname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY "Schema", "Type" DESC, "Parent name" NULLS FIRST, "Name";
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- PartitionedTables(reltypes="ti", pattern="public.foo*", verbose=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support declarative table partitioning.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support declarative table partitioning.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support declarative table partitioning.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support declarative table partitioning.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support declarative table partitioning.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support declarative table partitioning.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support declarative table partitioning.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support declarative table partitioning.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support declarative table partitioning.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support declarative table partitioning.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support declarative table partitioning.\n"
+
+-- versions: 90600
+-- error: "The server (version 9.6) does not support declarative table partitioning.\n"
+
+-- versions: 100000
+-- error: "The server (version 10) does not support declarative table partitioning.\n"
+
+-- versions: 110000
+-- error: "The server (version 11) does not support declarative table partitioning.\n"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT n.nspname as "Schema",
+  c.relname as "Name",
+  pg_catalog.pg_get_userbyid(c.relowner) as "Owner",
+  CASE c.relkind WHEN 'p' THEN 'partitioned table' WHEN 'I' THEN 'partitioned index' END as "Type",
+  inh.inhparent::pg_catalog.regclass as "Parent name",
+ c2.oid::pg_catalog.regclass as "Table",
+  s.tps as "Total size",
+  pg_catalog.obj_description(c.oid, 'pg_class') as "Description"
+FROM pg_catalog.pg_class c
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+     LEFT JOIN pg_catalog.pg_index i ON i.indexrelid = c.oid
+     LEFT JOIN pg_catalog.pg_class c2 ON i.indrelid = c2.oid
+     LEFT JOIN pg_catalog.pg_inherits inh ON c.oid = inh.inhrelid,
+     LATERAL (SELECT pg_catalog.pg_size_pretty(sum(
+                 CASE WHEN ppt.isleaf AND ppt.level = 1
+                      THEN pg_catalog.pg_table_size(ppt.relid) ELSE 0 END)) AS dps,
+                     pg_catalog.pg_size_pretty(sum(pg_catalog.pg_table_size(ppt.relid))) AS tps
+              FROM pg_catalog.pg_partition_tree(c.oid) ppt) s
+WHERE c.relkind IN ('p','I','')
+  AND c.relname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY "Schema", "Type" DESC, "Parent name" NULLS FIRST, "Name";
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
@@ -0,0 +1,98 @@
+-- PublicationDetails(pattern="")
+-- versions: 80000
+-- error: "The server (version 8.0) does not support publications.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support publications.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support publications.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support publications.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support publications.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support publications.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support publications.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support publications.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support publications.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support publications.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support publications.\n"
+
+-- versions: 90600
+-- error: "The server (version 9.6) does not support publications.\n"
+
+-- versions: 100000
+SELECT oid, pubname,
+  pg_catalog.pg_get_userbyid(pubowner) AS owner,
+  puballtables, pubinsert, pubupdate, pubdelete, false AS pubtruncate, false AS pubviaroot
+FROM pg_catalog.pg_publication
+ORDER BY 2;
+
+-- versions: 110000, 120000
+SELECT oid, pubname,
+  pg_catalog.pg_get_userbyid(pubowner) AS owner,
+  puballtables, pubinsert, pubupdate, pubdelete, pubtruncate, false AS pubviaroot
+FROM pg_catalog.pg_publication
+ORDER BY 2;
+
+-- versions: 130000, 140000, 150000, 160000, 170000
+SELECT oid, pubname,
+  pg_catalog.pg_get_userbyid(pubowner) AS owner,
+  puballtables, pubinsert, pubupdate, pubdelete, pubtruncate, pubviaroot
+FROM pg_catalog.pg_publication
+ORDER BY 2;
+
+-- PublicationDetails(pattern="public.foo*")
+-- versions: 80000
+-- error: "The server (version 8.0) does not support publications.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support publications.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support publications.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support publications.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support publications.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support publications.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support publications.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support publications.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support publications.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support publications.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support publications.\n"
+
+-- versions: 90600
+-- error: "The server (version 9.6) does not support publications.\n"
+
+-- versions: 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+-- error: "improper qualified name (too many dotted names): public.foo*"
+
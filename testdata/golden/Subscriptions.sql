@@ -0,0 +1,256 @@
+-- Subscriptions(pattern="", verbose=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support subscriptions.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support subscriptions.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support subscriptions.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support subscriptions.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support subscriptions.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support subscriptions.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support subscriptions.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support subscriptions.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support subscriptions.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support subscriptions.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support subscriptions.\n"
+
+-- versions: 90600
+-- error: "The server (version 9.6) does not support subscriptions.\n"
+
+-- versions: 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT subname AS "Name"
+,  pg_catalog.pg_get_userbyid(subowner) AS "Owner"
+,  subenabled AS "Enabled"
+,  subpublications AS "Publication"
+FROM pg_catalog.pg_subscription
+WHERE subdbid = (SELECT oid
+                 FROM pg_catalog.pg_database
+                 WHERE datname = pg_catalog.current_database())ORDER BY 1;
+
+-- Subscriptions(pattern="", verbose=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support subscriptions.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support subscriptions.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support subscriptions.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support subscriptions.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support subscriptions.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support subscriptions.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support subscriptions.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support subscriptions.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support subscriptions.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support subscriptions.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support subscriptions.\n"
+
+-- versions: 90600
+-- error: "The server (version 9.6) does not support subscriptions.\n"
+
+-- versions: 100000, 110000, 120000, 130000
+SELECT subname AS "Name"
+,  pg_catalog.pg_get_userbyid(subowner) AS "Owner"
+,  subenabled AS "Enabled"
+,  subpublications AS "Publication"
+,  subsynccommit AS "Synchronous commit"
+,  subconninfo AS "Conninfo"
+FROM pg_catalog.pg_subscription
+WHERE subdbid = (SELECT oid
+                 FROM pg_catalog.pg_database
+                 WHERE datname = pg_catalog.current_database())ORDER BY 1;
+
+-- versions: 140000
+SELECT subname AS "Name"
+,  pg_catalog.pg_get_userbyid(subowner) AS "Owner"
+,  subenabled AS "Enabled"
+,  subpublications AS "Publication"
+,  subbinary AS "Binary"
+,  substream AS "Streaming"
+,  subsynccommit AS "Synchronous commit"
+,  subconninfo AS "Conninfo"
+FROM pg_catalog.pg_subscription
+WHERE subdbid = (SELECT oid
+                 FROM pg_catalog.pg_database
+                 WHERE datname = pg_catalog.current_database())ORDER BY 1;
+
+-- versions: 150000
+SELECT subname AS "Name"
+,  pg_catalog.pg_get_userbyid(subowner) AS "Owner"
+,  subenabled AS "Enabled"
+,  subpublications AS "Publication"
+,  subbinary AS "Binary"
+,  substream AS "Streaming"
+,  subtwophasestate AS "Two-phase commit"
+,  subdisableonerr AS "Disable on error"
+,  subsynccommit AS "Synchronous commit"
+,  subconninfo AS "Conninfo"
+,  subskiplsn AS "Skip LSN"
+FROM pg_catalog.pg_subscription
+WHERE subdbid = (SELECT oid
+                 FROM pg_catalog.pg_database
+                 WHERE datname = pg_catalog.current_database())ORDER BY 1;
+
+-- versions: 160000
+SELECT subname AS "Name"
+,  pg_catalog.pg_get_userbyid(subowner) AS "Owner"
+,  subenabled AS "Enabled"
+,  subpublications AS "Publication"
+,  subbinary AS "Binary"
+,  (CASE substream
+    WHEN 'f' THEN 'off'
+    WHEN 't' THEN 'on'
+    WHEN 'p' THEN 'parallel'
+   END) AS "Streaming"
+,  subtwophasestate AS "Two-phase commit"
+,  subdisableonerr AS "Disable on error"
+,  suborigin AS "Origin"
+,  subpasswordrequired AS "Password required"
+,  subrunasowner AS "Run as owner?"
+,  subsynccommit AS "Synchronous commit"
+,  subconninfo AS "Conninfo"
+,  subskiplsn AS "Skip LSN"
+FROM pg_catalog.pg_subscription
+WHERE subdbid = (SELECT oid
+                 FROM pg_catalog.pg_database
+                 WHERE datname = pg_catalog.current_database())ORDER BY 1;
+
+-- versions: 170000
+SELECT subname AS "Name"
+,  pg_catalog.pg_get_userbyid(subowner) AS "Owner"
+,  subenabled AS "Enabled"
+,  subpublications AS "Publication"
+,  subbinary AS "Binary"
+,  (CASE substream
+    WHEN 'f' THEN 'off'
+    WHEN 't' THEN 'on'
+    WHEN 'p' THEN 'parallel'
+   END) AS "Streaming"
+,  subtwophasestate AS "Two-phase commit"
+,  subdisableonerr AS "Disable on error"
+,  suborigin AS "Origin"
+,  subpasswordrequired AS "Password required"
+,  subrunasowner AS "Run as owner?"
+,  subfailover AS "Failover"
+,  subsynccommit AS "Synchronous commit"
+,  subconninfo AS "Conninfo"
+,  subskiplsn AS "Skip LSN"
+FROM pg_catalog.pg_subscription
+WHERE subdbid = (SELECT oid
+                 FROM pg_catalog.pg_database
+                 WHERE datname = pg_catalog.current_database())ORDER BY 1;
+
+-- Subscriptions(pattern="public.foo*", verbose=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support subscriptions.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support subscriptions.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support subscriptions.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support subscriptions.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support subscriptions.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support subscriptions.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support subscriptions.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support subscriptions.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support subscriptions.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support subscriptions.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support subscriptions.\n"
+
+-- versions: 90600
+-- error: "The server (version 9.6) does not support subscriptions.\n"
+
+-- versions: 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+-- error: "improper qualified name (too many dotted names): public.foo*"
+
+-- Subscriptions(pattern="public.foo*", verbose=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support subscriptions.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support subscriptions.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support subscriptions.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support subscriptions.\n"
+
+-- versions: 80400
+-- error: "The server (version 8.4) does not support subscriptions.\n"
+
+-- versions: 90000
+-- error: "The server (version 9.0) does not support subscriptions.\n"
+
+-- versions: 90100
+-- error: "The server (version 9.1) does not support subscriptions.\n"
+
+-- versions: 90200
+-- error: "The server (version 9.2) does not support subscriptions.\n"
+
+-- versions: 90300
+-- error: "The server (version 9.3) does not support subscriptions.\n"
+
+-- versions: 90400
+-- error: "The server (version 9.4) does not support subscriptions.\n"
+
+-- versions: 90500
+-- error: "The server (version 9.5) does not support subscriptions.\n"
+
+-- versions: 90600
+-- error: "The server (version 9.6) does not support subscriptions.\n"
+
+-- versions: 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+-- error: "improper qualified name (too many dotted names): public.foo*"
+
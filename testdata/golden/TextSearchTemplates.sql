@@ -0,0 +1,118 @@
+-- TextSearchTemplates(pattern="", verbose=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support full text search.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support full text search.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support full text search.\n"
+
+-- versions: 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT
+  n.nspname AS "Schema",
+  t.tmplname AS "Name",
+  pg_catalog.obj_description(t.oid, 'pg_ts_template') AS "Description"
+FROM pg_catalog.pg_ts_template t
+LEFT JOIN pg_catalog.pg_namespace n ON n.oid = t.tmplnamespace
+WHERE pg_catalog.pg_ts_template_is_visible(t.oid)
+ORDER BY 1, 2;
+
+-- TextSearchTemplates(pattern="", verbose=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support full text search.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support full text search.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support full text search.\n"
+
+-- versions: 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT
+  n.nspname AS "Schema",
+  t.tmplname AS "Name",
+  t.tmplinit::pg_catalog.regproc AS "Init",
+  t.tmpllexize::pg_catalog.regproc AS "Lexize",
+  pg_catalog.obj_description(t.oid, 'pg_ts_template') AS "Description"
+FROM pg_catalog.pg_ts_template t
+LEFT JOIN pg_catalog.pg_namespace n ON n.oid = t.tmplnamespace
+WHERE pg_catalog.pg_ts_template_is_visible(t.oid)
+ORDER BY 1, 2;
+
+-- TextSearchTemplates(pattern="public.foo*", verbose=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support full text search.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support full text search.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support full text search.\n"
+
+-- versions: 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000
+SELECT
+  n.nspname AS "Schema",
+  t.tmplname AS "Name",
+  pg_catalog.obj_description(t.oid, 'pg_ts_template') AS "Description"
+FROM pg_catalog.pg_ts_template t
+LEFT JOIN pg_catalog.pg_namespace n ON n.oid = t.tmplnamespace
+WHERE t.tmplname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT
+  n.nspname AS "Schema",
+  t.tmplname AS "Name",
+  pg_catalog.obj_description(t.oid, 'pg_ts_template') AS "Description"
+FROM pg_catalog.pg_ts_template t
+LEFT JOIN pg_catalog.pg_namespace n ON n.oid = t.tmplnamespace
+WHERE t.tmplname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- TextSearchTemplates(pattern="public.foo*", verbose=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support full text search.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support full text search.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support full text search.\n"
+
+-- versions: 80300, 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000
+SELECT
+  n.nspname AS "Schema",
+  t.tmplname AS "Name",
+  t.tmplinit::pg_catalog.regproc AS "Init",
+  t.tmpllexize::pg_catalog.regproc AS "Lexize",
+  pg_catalog.obj_description(t.oid, 'pg_ts_template') AS "Description"
+FROM pg_catalog.pg_ts_template t
+LEFT JOIN pg_catalog.pg_namespace n ON n.oid = t.tmplnamespace
+WHERE t.tmplname OPERATOR(pg_catalog.~) $1
+  AND n.nspname OPERATOR(pg_catalog.~) $2
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
+-- versions: 120000, 130000, 140000, 150000, 160000, 170000
+SELECT
+  n.nspname AS "Schema",
+  t.tmplname AS "Name",
+  t.tmplinit::pg_catalog.regproc AS "Init",
+  t.tmpllexize::pg_catalog.regproc AS "Lexize",
+  pg_catalog.obj_description(t.oid, 'pg_ts_template') AS "Description"
+FROM pg_catalog.pg_ts_template t
+LEFT JOIN pg_catalog.pg_namespace n ON n.oid = t.tmplnamespace
+WHERE t.tmplname OPERATOR(pg_catalog.~) $1 COLLATE pg_catalog.default
+  AND n.nspname OPERATOR(pg_catalog.~) $2 COLLATE pg_catalog.default
+ORDER BY 1, 2;
+-- $1 = "^(foo.*)$"
+-- $2 = "^(public)$"
+
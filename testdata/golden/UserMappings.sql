@@ -0,0 +1,71 @@
+-- UserMappings(pattern="", verbose=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support user mappings.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support user mappings.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support user mappings.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support user mappings.\n"
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT um.srvname AS "Server",
+  um.usename AS "User name"
+FROM pg_catalog.pg_user_mappings um
+ORDER BY 1, 2;
+
+-- UserMappings(pattern="", verbose=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support user mappings.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support user mappings.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support user mappings.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support user mappings.\n"
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+SELECT um.srvname AS "Server",
+  um.usename AS "User name",
+ CASE WHEN umoptions IS NULL THEN '' ELSE   '(' || pg_catalog.array_to_string(ARRAY(SELECT   pg_catalog.quote_ident(option_name) ||  ' ' ||   pg_catalog.quote_literal(option_value)  FROM   pg_catalog.pg_options_to_table(umoptions)),  ', ') || ')'   END AS "FDW options"
+FROM pg_catalog.pg_user_mappings um
+ORDER BY 1, 2;
+
+-- UserMappings(pattern="public.foo*", verbose=false)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support user mappings.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support user mappings.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support user mappings.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support user mappings.\n"
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+-- error: "improper qualified name (too many dotted names): public.foo*"
+
+-- UserMappings(pattern="public.foo*", verbose=true)
+-- versions: 80000
+-- error: "The server (version 8.0) does not support user mappings.\n"
+
+-- versions: 80100
+-- error: "The server (version 8.1) does not support user mappings.\n"
+
+-- versions: 80200
+-- error: "The server (version 8.2) does not support user mappings.\n"
+
+-- versions: 80300
+-- error: "The server (version 8.3) does not support user mappings.\n"
+
+-- versions: 80400, 90000, 90100, 90200, 90300, 90400, 90500, 90600, 100000, 110000, 120000, 130000, 140000, 150000, 160000, 170000
+-- error: "improper qualified name (too many dotted names): public.foo*"
+
@@ -0,0 +1,326 @@
+package pgdesc
+
+// Manually translated describe.c funcs for \dF+ and \dFp+, which describe
+// each matching text search configuration or parser with separate queries.
+// gen.go skips these funcs (see manualFuncs), so that regenerating does not
+// duplicate them.
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// TextSearchConfigsVerbose handles \dF+.
+//
+// Manually translated from listTSConfigsVerbose in psql's describe.c.
+//
+// Full description of text search configurations.
+func (d *PgDesc) TextSearchConfigsVerbose(w io.Writer, pattern string) error {
+	var err error
+
+	var res *Result
+	var i int
+
+	fmt.Fprintf(w,
+		"SELECT c.oid, c.cfgname,\n"+
+			"   n.nspname,\n"+
+			"   p.prsname,\n"+
+			"   np.nspname as pnspname\n"+
+			"FROM pg_catalog.pg_ts_config c\n"+
+			"   LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.cfgnamespace,\n"+
+			" pg_catalog.pg_ts_parser p\n"+
+			"   LEFT JOIN pg_catalog.pg_namespace np ON np.oid = p.prsnamespace\n"+
+			"WHERE  p.oid = c.cfgparser\n")
+
+	if err := d.validateSQLNamePattern(w, pattern, true, false,
+		"n.nspname", "c.cfgname", NULL,
+		"pg_catalog.pg_ts_config_is_visible(c.oid)", nil, 3); err != nil {
+		return err
+	}
+
+	fmt.Fprint(w, "ORDER BY 3, 2;")
+
+	res, err = d.psqlExec(w)
+	if res == nil {
+		return err
+	}
+
+	if res.Len() == 0 {
+		if pattern != NULL {
+			return fmt.Errorf("Did not find any text search configuration named \"%s\".\n",
+				pattern)
+		} else {
+			return fmt.Errorf("Did not find any text search configurations.\n")
+		}
+	}
+
+	for i = 0; i < res.Len(); i++ {
+		var oid string
+		var cfgname string
+		nspname := NULL
+		var prsname string
+		pnspname := NULL
+
+		oid = res.Value(i, 0)
+		cfgname = res.Value(i, 1)
+		if !res.IsNull(i, 2) {
+			nspname = res.Value(i, 2)
+		}
+		prsname = res.Value(i, 3)
+		if !res.IsNull(i, 4) {
+			pnspname = res.Value(i, 4)
+		}
+
+		if err := d.OneTextSearchConfig(w, oid, nspname, cfgname, pnspname, prsname); err != nil {
+			return err
+		}
+
+		if err := d.context().Err(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OneTextSearchConfig describes one text search configuration, for \dF+.
+//
+// Manually translated from describeOneTSConfig in psql's describe.c.
+func (d *PgDesc) OneTextSearchConfig(w io.Writer, oid string, nspname string, cfgname string, pnspname string, prsname string) error {
+	var err error
+
+	title := new(bytes.Buffer)
+	var res *Result
+	myopt := d.popt()
+
+	fmt.Fprintf(w,
+		"SELECT\n"+
+			"  ( SELECT t.alias FROM\n"+
+			"    pg_catalog.ts_token_type(c.cfgparser) AS t\n"+
+			"    WHERE t.tokid = m.maptokentype ) AS \"%s\",\n"+
+			"  pg_catalog.btrim(\n"+
+			"    ARRAY( SELECT mm.mapdict::pg_catalog.regdictionary\n"+
+			"           FROM pg_catalog.pg_ts_config_map AS mm\n"+
+			"           WHERE mm.mapcfg = m.mapcfg AND mm.maptokentype = m.maptokentype\n"+
+			"           ORDER BY mapcfg, maptokentype, mapseqno\n"+
+			"    ) :: pg_catalog.text,\n"+
+			"  '{}') AS \"%s\"\n"+
+			"FROM pg_catalog.pg_ts_config AS c, pg_catalog.pg_ts_config_map AS m\n"+
+			"WHERE c.oid = %s AND m.mapcfg = c.oid\n"+
+			"GROUP BY m.mapcfg, m.maptokentype, c.cfgparser\n"+
+			"ORDER BY 1;",
+		GettextNoop("Token"),
+		GettextNoop("Dictionaries"),
+		literal(w, oid))
+
+	res, err = d.psqlExec(w)
+	if res == nil {
+		return err
+	}
+
+	if nspname != NULL {
+		fmt.Fprint(title, Gettext("Text search configuration \"%s.%s\"",
+			nspname, cfgname))
+	} else {
+		fmt.Fprint(title, Gettext("Text search configuration \"%s\"",
+			cfgname))
+	}
+
+	if pnspname != NULL {
+		fmt.Fprint(title, Gettext("\nParser: \"%s.%s\"",
+			pnspname, prsname))
+	} else {
+		fmt.Fprint(title, Gettext("\nParser: \"%s\"",
+			prsname))
+	}
+
+	myopt.nullPrint = NULL
+	myopt.title = title.String()
+	myopt.footers = nil
+	myopt.topt.defaultFooter = false
+	myopt.translateHeader = true
+
+	if err = d.psqlPrintQuery(w, res, &myopt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// TextSearchParsersVerbose handles \dFp+.
+//
+// Manually translated from listTSParsersVerbose in psql's describe.c.
+//
+// Full description of text search parsers.
+func (d *PgDesc) TextSearchParsersVerbose(w io.Writer, pattern string) error {
+	var err error
+
+	var res *Result
+	var i int
+
+	fmt.Fprintf(w,
+		"SELECT p.oid,\n"+
+			"  n.nspname,\n"+
+			"  p.prsname\n"+
+			"FROM pg_catalog.pg_ts_parser p\n"+
+			"LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.prsnamespace\n")
+
+	if err := d.validateSQLNamePattern(w, pattern, false, false,
+		"n.nspname", "p.prsname", NULL,
+		"pg_catalog.pg_ts_parser_is_visible(p.oid)", nil, 3); err != nil {
+		return err
+	}
+
+	fmt.Fprint(w, "ORDER BY 1, 2;")
+
+	res, err = d.psqlExec(w)
+	if res == nil {
+		return err
+	}
+
+	if res.Len() == 0 {
+		if pattern != NULL {
+			return fmt.Errorf("Did not find any text search parser named \"%s\".\n",
+				pattern)
+		} else {
+			return fmt.Errorf("Did not find any text search parsers.\n")
+		}
+	}
+
+	for i = 0; i < res.Len(); i++ {
+		var oid string
+		nspname := NULL
+		var prsname string
+
+		oid = res.Value(i, 0)
+		if !res.IsNull(i, 1) {
+			nspname = res.Value(i, 1)
+		}
+		prsname = res.Value(i, 2)
+
+		if err := d.OneTextSearchParser(w, oid, nspname, prsname); err != nil {
+			return err
+		}
+
+		if err := d.context().Err(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OneTextSearchParser describes one text search parser, for \dFp+.
+//
+// Manually translated from describeOneTSParser in psql's describe.c.
+func (d *PgDesc) OneTextSearchParser(w io.Writer, oid string, nspname string, prsname string) error {
+	var err error
+
+	var res *Result
+	title := new(bytes.Buffer)
+	myopt := d.popt()
+	translate_columns := []bool{true, false, false}
+
+	fmt.Fprintf(w,
+		"SELECT '%s' AS \"%s\",\n"+
+			"   p.prsstart::pg_catalog.regproc AS \"%s\",\n"+
+			"   pg_catalog.obj_description(p.prsstart, 'pg_proc') as \"%s\"\n"+
+			" FROM pg_catalog.pg_ts_parser p\n"+
+			" WHERE p.oid = %s\n"+
+			"UNION ALL\n"+
+			"SELECT '%s',\n"+
+			"   p.prstoken::pg_catalog.regproc,\n"+
+			"   pg_catalog.obj_description(p.prstoken, 'pg_proc')\n"+
+			" FROM pg_catalog.pg_ts_parser p\n"+
+			" WHERE p.oid = %s\n"+
+			"UNION ALL\n"+
+			"SELECT '%s',\n"+
+			"   p.prsend::pg_catalog.regproc,\n"+
+			"   pg_catalog.obj_description(p.prsend, 'pg_proc')\n"+
+			" FROM pg_catalog.pg_ts_parser p\n"+
+			" WHERE p.oid = %s\n"+
+			"UNION ALL\n"+
+			"SELECT '%s',\n"+
+			"   p.prsheadline::pg_catalog.regproc,\n"+
+			"   pg_catalog.obj_description(p.prsheadline, 'pg_proc')\n"+
+			" FROM pg_catalog.pg_ts_parser p\n"+
+			" WHERE p.oid = %s\n"+
+			"UNION ALL\n"+
+			"SELECT '%s',\n"+
+			"   p.prslextype::pg_catalog.regproc,\n"+
+			"   pg_catalog.obj_description(p.prslextype, 'pg_proc')\n"+
+			" FROM pg_catalog.pg_ts_parser p\n"+
+			" WHERE p.oid = %s;",
+		GettextNoop("Start parse"),
+		GettextNoop("Method"),
+		GettextNoop("Function"),
+		GettextNoop("Description"),
+		literal(w, oid),
+		GettextNoop("Get next token"),
+		literal(w, oid),
+		GettextNoop("End parse"),
+		literal(w, oid),
+		GettextNoop("Get headline"),
+		literal(w, oid),
+		GettextNoop("Get token types"),
+		literal(w, oid))
+
+	res, err = d.psqlExec(w)
+	if res == nil {
+		return err
+	}
+
+	myopt.nullPrint = NULL
+	if nspname != NULL {
+		fmt.Fprint(title, Gettext("Text search parser \"%s.%s\"",
+			nspname, prsname))
+	} else {
+		fmt.Fprint(title, Gettext("Text search parser \"%s\"", prsname))
+	}
+	myopt.title = title.String()
+	myopt.footers = nil
+	myopt.topt.defaultFooter = false
+	myopt.translateHeader = true
+	myopt.translateColumns = translate_columns
+	myopt.nTranslateColumns = len(translate_columns)
+
+	if err = d.psqlPrintQuery(w, res, &myopt); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w,
+		"SELECT t.alias as \"%s\",\n"+
+			"  t.description as \"%s\"\n"+
+			"FROM pg_catalog.ts_token_type( %s::pg_catalog.oid ) as t\n"+
+			"ORDER BY 1;",
+		GettextNoop("Token name"),
+		GettextNoop("Description"),
+		literal(w, oid))
+
+	res, err = d.psqlExec(w)
+	if res == nil {
+		return err
+	}
+
+	myopt.nullPrint = NULL
+	title.Reset()
+	if nspname != NULL {
+		fmt.Fprint(title, Gettext("Token types for parser \"%s.%s\"",
+			nspname, prsname))
+	} else {
+		fmt.Fprint(title, Gettext("Token types for parser \"%s\"", prsname))
+	}
+	myopt.title = title.String()
+	myopt.footers = nil
+	myopt.topt.defaultFooter = true
+	myopt.translateHeader = true
+	myopt.translateColumns = nil
+	myopt.nTranslateColumns = 0
+
+	if err = d.psqlPrintQuery(w, res, &myopt); err != nil {
+		return err
+	}
+
+	return nil
+}
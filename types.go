@@ -0,0 +1,1220 @@
+package pgdesc
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// AccessMethod is a \dA result row.
+type AccessMethod struct {
+	Name        string `pgdesc:"Name"`
+	Type        string `pgdesc:"Type"`
+	Handler     string `pgdesc:"Handler"`
+	Description string `pgdesc:"Description"`
+}
+
+// Aggregate is a \da result row.
+type Aggregate struct {
+	Schema        string `pgdesc:"Schema"`
+	Name          string `pgdesc:"Name"`
+	ResultType    string `pgdesc:"Result data type"`
+	ArgumentTypes string `pgdesc:"Argument data types"`
+	Description   string `pgdesc:"Description"`
+}
+
+// Cast is a \dC result row.
+type Cast struct {
+	SourceType  string `pgdesc:"Source type"`
+	TargetType  string `pgdesc:"Target type"`
+	Function    string `pgdesc:"Function"`
+	Implicit    string `pgdesc:"Implicit?"`
+	Description string `pgdesc:"Description"`
+}
+
+// Collation is a \dO result row.
+type Collation struct {
+	Schema        string `pgdesc:"Schema"`
+	Name          string `pgdesc:"Name"`
+	Collate       string `pgdesc:"Collate"`
+	Ctype         string `pgdesc:"Ctype"`
+	Provider      string `pgdesc:"Provider"`
+	Locale        string `pgdesc:"Locale"`
+	ICURules      string `pgdesc:"ICU Rules"`
+	Deterministic string `pgdesc:"Deterministic?"`
+	Description   string `pgdesc:"Description"`
+}
+
+// ConfigurationParameter is a \dconfig result row.
+type ConfigurationParameter struct {
+	Parameter        string   `pgdesc:"Parameter"`
+	Value            string   `pgdesc:"Value"`
+	Type             string   `pgdesc:"Type"`
+	Context          string   `pgdesc:"Context"`
+	AccessPrivileges []string `pgdesc:"Access privileges"`
+}
+
+// Conversion is a \dc result row.
+type Conversion struct {
+	Schema      string `pgdesc:"Schema"`
+	Name        string `pgdesc:"Name"`
+	Source      string `pgdesc:"Source"`
+	Destination string `pgdesc:"Destination"`
+	Default     bool   `pgdesc:"Default?"`
+	Description string `pgdesc:"Description"`
+}
+
+// RoleSetting is a \drds result row.
+type RoleSetting struct {
+	Role     string   `pgdesc:"Role"`
+	Database string   `pgdesc:"Database"`
+	Settings []string `pgdesc:"Settings"`
+}
+
+// Database is a \l result row.
+type Database struct {
+	Name             string   `pgdesc:"Name"`
+	Owner            string   `pgdesc:"Owner"`
+	Encoding         string   `pgdesc:"Encoding"`
+	Collate          string   `pgdesc:"Collate"`
+	Ctype            string   `pgdesc:"Ctype"`
+	LocaleProvider   string   `pgdesc:"Locale Provider"`
+	Locale           string   `pgdesc:"Locale"`
+	ICURules         string   `pgdesc:"ICU Rules"`
+	AccessPrivileges []string `pgdesc:"Access privileges"`
+	Size             string   `pgdesc:"Size"`
+	Tablespace       string   `pgdesc:"Tablespace"`
+	Description      string   `pgdesc:"Description"`
+}
+
+// DefaultACL is a \ddp result row.
+type DefaultACL struct {
+	Owner            string   `pgdesc:"Owner"`
+	Schema           string   `pgdesc:"Schema"`
+	Type             string   `pgdesc:"Type"`
+	AccessPrivileges []string `pgdesc:"Access privileges"`
+}
+
+// Domain is a \dD result row.
+type Domain struct {
+	Schema           string   `pgdesc:"Schema"`
+	Name             string   `pgdesc:"Name"`
+	Type             string   `pgdesc:"Type"`
+	Collation        string   `pgdesc:"Collation"`
+	Nullable         string   `pgdesc:"Nullable"`
+	Default          string   `pgdesc:"Default"`
+	Check            string   `pgdesc:"Check"`
+	AccessPrivileges []string `pgdesc:"Access privileges"`
+	Description      string   `pgdesc:"Description"`
+}
+
+// EventTrigger is a \dy result row.
+type EventTrigger struct {
+	Name        string `pgdesc:"Name"`
+	Event       string `pgdesc:"Event"`
+	Owner       string `pgdesc:"Owner"`
+	Enabled     string `pgdesc:"Enabled"`
+	Function    string `pgdesc:"Function"`
+	Tags        string `pgdesc:"Tags"`
+	Description string `pgdesc:"Description"`
+}
+
+// ExtendedStatistic is a \dX result row.
+type ExtendedStatistic struct {
+	Schema       string `pgdesc:"Schema"`
+	Name         string `pgdesc:"Name"`
+	Definition   string `pgdesc:"Definition"`
+	Ndistinct    string `pgdesc:"Ndistinct"`
+	Dependencies string `pgdesc:"Dependencies"`
+	MCV          string `pgdesc:"MCV"`
+}
+
+// Extension is a \dx result row.
+type Extension struct {
+	Name        string `pgdesc:"Name"`
+	Version     string `pgdesc:"Version"`
+	Schema      string `pgdesc:"Schema"`
+	Description string `pgdesc:"Description"`
+}
+
+// ExtensionObject is a member object of an extension, as listed by \dx+.
+type ExtensionObject struct {
+	Description string `pgdesc:"Object description"`
+}
+
+// ForeignDataWrapper is a \dew result row.
+type ForeignDataWrapper struct {
+	Name             string   `pgdesc:"Name"`
+	Owner            string   `pgdesc:"Owner"`
+	Handler          string   `pgdesc:"Handler"`
+	Validator        string   `pgdesc:"Validator"`
+	AccessPrivileges []string `pgdesc:"Access privileges"`
+	Options          string   `pgdesc:"FDW options"`
+	Description      string   `pgdesc:"Description"`
+}
+
+// ForeignServer is a \des result row.
+type ForeignServer struct {
+	Name               string   `pgdesc:"Name"`
+	Owner              string   `pgdesc:"Owner"`
+	ForeignDataWrapper string   `pgdesc:"Foreign-data wrapper"`
+	AccessPrivileges   []string `pgdesc:"Access privileges"`
+	Type               string   `pgdesc:"Type"`
+	Version            string   `pgdesc:"Version"`
+	Options            string   `pgdesc:"FDW options"`
+	Description        string   `pgdesc:"Description"`
+}
+
+// ForeignTable is a \det result row.
+type ForeignTable struct {
+	Schema      string `pgdesc:"Schema"`
+	Table       string `pgdesc:"Table"`
+	Server      string `pgdesc:"Server"`
+	Options     string `pgdesc:"FDW options"`
+	Description string `pgdesc:"Description"`
+}
+
+// Function is a \df result row.
+type Function struct {
+	Schema           string   `pgdesc:"Schema"`
+	Name             string   `pgdesc:"Name"`
+	ResultType       string   `pgdesc:"Result data type"`
+	ArgumentTypes    string   `pgdesc:"Argument data types"`
+	Type             string   `pgdesc:"Type"`
+	Volatility       string   `pgdesc:"Volatility"`
+	Parallel         string   `pgdesc:"Parallel"`
+	Owner            string   `pgdesc:"Owner"`
+	Security         string   `pgdesc:"Security"`
+	AccessPrivileges []string `pgdesc:"Access privileges"`
+	Language         string   `pgdesc:"Language"`
+	SourceCode       string   `pgdesc:"Source code"`
+	Description      string   `pgdesc:"Description"`
+}
+
+// Language is a \dL result row.
+type Language struct {
+	Name             string   `pgdesc:"Name"`
+	Owner            string   `pgdesc:"Owner"`
+	Trusted          bool     `pgdesc:"Trusted"`
+	Internal         bool     `pgdesc:"Internal language"`
+	CallHandler      string   `pgdesc:"Call handler"`
+	Validator        string   `pgdesc:"Validator"`
+	InlineHandler    string   `pgdesc:"Inline handler"`
+	AccessPrivileges []string `pgdesc:"Access privileges"`
+	Description      string   `pgdesc:"Description"`
+}
+
+// ObjectDescription is a \dd result row.
+type ObjectDescription struct {
+	Schema      string `pgdesc:"Schema"`
+	Name        string `pgdesc:"Name"`
+	Object      string `pgdesc:"Object"`
+	Description string `pgdesc:"Description"`
+}
+
+// Operator is a \do result row.
+type Operator struct {
+	Schema       string `pgdesc:"Schema"`
+	Name         string `pgdesc:"Name"`
+	LeftArgType  string `pgdesc:"Left arg type"`
+	RightArgType string `pgdesc:"Right arg type"`
+	ResultType   string `pgdesc:"Result type"`
+	Function     string `pgdesc:"Function"`
+	Description  string `pgdesc:"Description"`
+}
+
+// PartitionedRelation is a \dP result row.
+type PartitionedRelation struct {
+	Schema            string `pgdesc:"Schema"`
+	Name              string `pgdesc:"Name"`
+	Owner             string `pgdesc:"Owner"`
+	Kind              string `pgdesc:"Type"`
+	ParentName        string `pgdesc:"Parent name"`
+	Table             string `pgdesc:"Table"`
+	LeafPartitionSize string `pgdesc:"Leaf partition size"`
+	TotalSize         string `pgdesc:"Total size"`
+	Description       string `pgdesc:"Description"`
+}
+
+// Permission is a \dp result row.
+type Permission struct {
+	Schema           string   `pgdesc:"Schema"`
+	Name             string   `pgdesc:"Name"`
+	Type             string   `pgdesc:"Type"`
+	AccessPrivileges []string `pgdesc:"Access privileges"`
+	ColumnPrivileges string   `pgdesc:"Column privileges"`
+	Policies         string   `pgdesc:"Policies"`
+}
+
+// Publication is a \dRp result row.
+type Publication struct {
+	Name      string `pgdesc:"Name"`
+	Owner     string `pgdesc:"Owner"`
+	AllTables bool   `pgdesc:"All tables"`
+	Inserts   bool   `pgdesc:"Inserts"`
+	Updates   bool   `pgdesc:"Updates"`
+	Deletes   bool   `pgdesc:"Deletes"`
+	Truncates bool   `pgdesc:"Truncates"`
+	ViaRoot   bool   `pgdesc:"Via root"`
+}
+
+// RoleGrant is a \drg result row.
+type RoleGrant struct {
+	Name     string `pgdesc:"Role name"`
+	MemberOf string `pgdesc:"Member of"`
+	Options  string `pgdesc:"Options"`
+	Grantor  string `pgdesc:"Grantor"`
+}
+
+// Role is a \du result row.
+type Role struct {
+	Name        string   `pgdesc:"rolname"`
+	Superuser   bool     `pgdesc:"rolsuper"`
+	Inherit     bool     `pgdesc:"rolinherit"`
+	CreateRole  bool     `pgdesc:"rolcreaterole"`
+	CreateDB    bool     `pgdesc:"rolcreatedb"`
+	CanLogin    bool     `pgdesc:"rolcanlogin"`
+	ConnLimit   int      `pgdesc:"rolconnlimit"`
+	ValidUntil  string   `pgdesc:"rolvaliduntil"`
+	MemberOf    []string `pgdesc:"memberof,array"`
+	Description string   `pgdesc:"description"`
+	Replication bool     `pgdesc:"rolreplication"`
+	BypassRLS   bool     `pgdesc:"rolbypassrls"`
+}
+
+// Schema is a \dn result row.
+type Schema struct {
+	Name             string   `pgdesc:"Name"`
+	Owner            string   `pgdesc:"Owner"`
+	AccessPrivileges []string `pgdesc:"Access privileges"`
+	Description      string   `pgdesc:"Description"`
+}
+
+// Subscription is a \dRs result row.
+type Subscription struct {
+	Name              string   `pgdesc:"Name"`
+	Owner             string   `pgdesc:"Owner"`
+	Enabled           bool     `pgdesc:"Enabled"`
+	Publications      []string `pgdesc:"Publication,array"`
+	Binary            bool     `pgdesc:"Binary"`
+	Streaming         string   `pgdesc:"Streaming"`
+	TwoPhaseCommit    string   `pgdesc:"Two-phase commit"`
+	DisableOnError    bool     `pgdesc:"Disable on error"`
+	Origin            string   `pgdesc:"Origin"`
+	PasswordRequired  bool     `pgdesc:"Password required"`
+	RunAsOwner        bool     `pgdesc:"Run as owner?"`
+	Failover          bool     `pgdesc:"Failover"`
+	SynchronousCommit string   `pgdesc:"Synchronous commit"`
+	Conninfo          string   `pgdesc:"Conninfo"`
+	SkipLSN           string   `pgdesc:"Skip LSN"`
+}
+
+// Relation is a \dt, \di, \dv, \dm, \ds or \dE result row.
+type Relation struct {
+	Schema       string `pgdesc:"Schema"`
+	Name         string `pgdesc:"Name"`
+	Kind         string `pgdesc:"Type"`
+	Owner        string `pgdesc:"Owner"`
+	Table        string `pgdesc:"Table"`
+	AccessMethod string `pgdesc:"Access method"`
+	Size         string `pgdesc:"Size"`
+	Description  string `pgdesc:"Description"`
+}
+
+// Tablespace is a \db result row.
+type Tablespace struct {
+	Name             string   `pgdesc:"Name"`
+	Owner            string   `pgdesc:"Owner"`
+	Location         string   `pgdesc:"Location"`
+	AccessPrivileges []string `pgdesc:"Access privileges"`
+	Options          []string `pgdesc:"Options,array"`
+	Size             string   `pgdesc:"Size"`
+	Description      string   `pgdesc:"Description"`
+}
+
+// TextSearchConfig is a \dF result row.
+type TextSearchConfig struct {
+	Schema      string `pgdesc:"Schema"`
+	Name        string `pgdesc:"Name"`
+	Description string `pgdesc:"Description"`
+}
+
+// TextSearchConfigMapping is a token mapping of a text search configuration,
+// as listed by \dF+.
+type TextSearchConfigMapping struct {
+	Token        string `pgdesc:"Token"`
+	Dictionaries string `pgdesc:"Dictionaries"`
+}
+
+// TextSearchDictionary is a \dFd result row.
+type TextSearchDictionary struct {
+	Schema      string `pgdesc:"Schema"`
+	Name        string `pgdesc:"Name"`
+	Template    string `pgdesc:"Template"`
+	InitOptions string `pgdesc:"Init options"`
+	Description string `pgdesc:"Description"`
+}
+
+// TextSearchParser is a \dFp result row.
+type TextSearchParser struct {
+	Schema      string `pgdesc:"Schema"`
+	Name        string `pgdesc:"Name"`
+	Description string `pgdesc:"Description"`
+}
+
+// TextSearchParserMethod is a method of a text search parser, as listed by
+// \dFp+.
+type TextSearchParserMethod struct {
+	Method      string `pgdesc:"Method"`
+	Function    string `pgdesc:"Function"`
+	Description string `pgdesc:"Description"`
+}
+
+// TextSearchTemplate is a \dFt result row.
+type TextSearchTemplate struct {
+	Schema      string `pgdesc:"Schema"`
+	Name        string `pgdesc:"Name"`
+	Init        string `pgdesc:"Init"`
+	Lexize      string `pgdesc:"Lexize"`
+	Description string `pgdesc:"Description"`
+}
+
+// Type is a \dT result row.
+type Type struct {
+	Schema           string   `pgdesc:"Schema"`
+	Name             string   `pgdesc:"Name"`
+	InternalName     string   `pgdesc:"Internal name"`
+	Size             string   `pgdesc:"Size"`
+	Elements         []string `pgdesc:"Elements"`
+	Owner            string   `pgdesc:"Owner"`
+	AccessPrivileges []string `pgdesc:"Access privileges"`
+	Description      string   `pgdesc:"Description"`
+}
+
+// UserMapping is a \deu result row.
+type UserMapping struct {
+	Server   string `pgdesc:"Server"`
+	UserName string `pgdesc:"User name"`
+	Options  string `pgdesc:"FDW options"`
+}
+
+// GetAccessMethods executes \dA, returning the AccessMethod results.
+func (d *PgDesc) GetAccessMethods(pattern string, verbose bool) ([]AccessMethod, error) {
+	res, err := d.QueryAccessMethods(pattern, verbose)
+	if err != nil {
+		return nil, err
+	}
+	var v []AccessMethod
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetAccessMethodsContext is the same as GetAccessMethods, but with a context.
+func (d *PgDesc) GetAccessMethodsContext(ctx context.Context, pattern string, verbose bool) ([]AccessMethod, error) {
+	return d.withContext(ctx).GetAccessMethods(pattern, verbose)
+}
+
+// GetAggregates executes \da, returning the Aggregate results.
+func (d *PgDesc) GetAggregates(pattern string, verbose, showSystem bool) ([]Aggregate, error) {
+	res, err := d.QueryAggregates(pattern, verbose, showSystem)
+	if err != nil {
+		return nil, err
+	}
+	var v []Aggregate
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetAggregatesContext is the same as GetAggregates, but with a context.
+func (d *PgDesc) GetAggregatesContext(ctx context.Context, pattern string, verbose, showSystem bool) ([]Aggregate, error) {
+	return d.withContext(ctx).GetAggregates(pattern, verbose, showSystem)
+}
+
+// GetCasts executes \dC, returning the Cast results.
+func (d *PgDesc) GetCasts(pattern string, verbose bool) ([]Cast, error) {
+	res, err := d.QueryCasts(pattern, verbose)
+	if err != nil {
+		return nil, err
+	}
+	var v []Cast
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetCastsContext is the same as GetCasts, but with a context.
+func (d *PgDesc) GetCastsContext(ctx context.Context, pattern string, verbose bool) ([]Cast, error) {
+	return d.withContext(ctx).GetCasts(pattern, verbose)
+}
+
+// GetCollations executes \dO, returning the Collation results.
+func (d *PgDesc) GetCollations(pattern string, verbose, showSystem bool) ([]Collation, error) {
+	res, err := d.QueryCollations(pattern, verbose, showSystem)
+	if err != nil {
+		return nil, err
+	}
+	var v []Collation
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetCollationsContext is the same as GetCollations, but with a context.
+func (d *PgDesc) GetCollationsContext(ctx context.Context, pattern string, verbose, showSystem bool) ([]Collation, error) {
+	return d.withContext(ctx).GetCollations(pattern, verbose, showSystem)
+}
+
+// GetConfigurationParameters executes \dconfig, returning the ConfigurationParameter results.
+func (d *PgDesc) GetConfigurationParameters(pattern string, verbose, showSystem bool) ([]ConfigurationParameter, error) {
+	res, err := d.QueryConfigurationParameters(pattern, verbose, showSystem)
+	if err != nil {
+		return nil, err
+	}
+	var v []ConfigurationParameter
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetConfigurationParametersContext is the same as GetConfigurationParameters, but with a context.
+func (d *PgDesc) GetConfigurationParametersContext(ctx context.Context, pattern string, verbose, showSystem bool) ([]ConfigurationParameter, error) {
+	return d.withContext(ctx).GetConfigurationParameters(pattern, verbose, showSystem)
+}
+
+// GetConversions executes \dc, returning the Conversion results.
+func (d *PgDesc) GetConversions(pattern string, verbose, showSystem bool) ([]Conversion, error) {
+	res, err := d.QueryConversions(pattern, verbose, showSystem)
+	if err != nil {
+		return nil, err
+	}
+	var v []Conversion
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetConversionsContext is the same as GetConversions, but with a context.
+func (d *PgDesc) GetConversionsContext(ctx context.Context, pattern string, verbose, showSystem bool) ([]Conversion, error) {
+	return d.withContext(ctx).GetConversions(pattern, verbose, showSystem)
+}
+
+// GetDatabaseRoleSettings executes \drds, returning the RoleSetting results.
+func (d *PgDesc) GetDatabaseRoleSettings(pattern, pattern2 string) ([]RoleSetting, error) {
+	res, err := d.QueryDatabaseRoleSettings(pattern, pattern2)
+	if err != nil {
+		return nil, err
+	}
+	var v []RoleSetting
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetDatabaseRoleSettingsContext is the same as GetDatabaseRoleSettings, but with a context.
+func (d *PgDesc) GetDatabaseRoleSettingsContext(ctx context.Context, pattern, pattern2 string) ([]RoleSetting, error) {
+	return d.withContext(ctx).GetDatabaseRoleSettings(pattern, pattern2)
+}
+
+// GetDatabases executes \l, returning the Database results.
+func (d *PgDesc) GetDatabases(pattern string, verbose bool) ([]Database, error) {
+	res, err := d.QueryDatabases(pattern, verbose)
+	if err != nil {
+		return nil, err
+	}
+	var v []Database
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetDatabasesContext is the same as GetDatabases, but with a context.
+func (d *PgDesc) GetDatabasesContext(ctx context.Context, pattern string, verbose bool) ([]Database, error) {
+	return d.withContext(ctx).GetDatabases(pattern, verbose)
+}
+
+// GetDefaultACLS executes \ddp, returning the DefaultACL results.
+func (d *PgDesc) GetDefaultACLS(pattern string) ([]DefaultACL, error) {
+	res, err := d.QueryDefaultACLS(pattern)
+	if err != nil {
+		return nil, err
+	}
+	var v []DefaultACL
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetDefaultACLSContext is the same as GetDefaultACLS, but with a context.
+func (d *PgDesc) GetDefaultACLSContext(ctx context.Context, pattern string) ([]DefaultACL, error) {
+	return d.withContext(ctx).GetDefaultACLS(pattern)
+}
+
+// GetDomains executes \dD, returning the Domain results.
+func (d *PgDesc) GetDomains(pattern string, verbose, showSystem bool) ([]Domain, error) {
+	res, err := d.QueryDomains(pattern, verbose, showSystem)
+	if err != nil {
+		return nil, err
+	}
+	var v []Domain
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetDomainsContext is the same as GetDomains, but with a context.
+func (d *PgDesc) GetDomainsContext(ctx context.Context, pattern string, verbose, showSystem bool) ([]Domain, error) {
+	return d.withContext(ctx).GetDomains(pattern, verbose, showSystem)
+}
+
+// GetEventTriggers executes \dy, returning the EventTrigger results.
+func (d *PgDesc) GetEventTriggers(pattern string, verbose bool) ([]EventTrigger, error) {
+	res, err := d.QueryEventTriggers(pattern, verbose)
+	if err != nil {
+		return nil, err
+	}
+	var v []EventTrigger
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetEventTriggersContext is the same as GetEventTriggers, but with a context.
+func (d *PgDesc) GetEventTriggersContext(ctx context.Context, pattern string, verbose bool) ([]EventTrigger, error) {
+	return d.withContext(ctx).GetEventTriggers(pattern, verbose)
+}
+
+// GetExtendedStats executes \dX, returning the ExtendedStatistic results.
+func (d *PgDesc) GetExtendedStats(pattern string) ([]ExtendedStatistic, error) {
+	res, err := d.QueryExtendedStats(pattern)
+	if err != nil {
+		return nil, err
+	}
+	var v []ExtendedStatistic
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetExtendedStatsContext is the same as GetExtendedStats, but with a context.
+func (d *PgDesc) GetExtendedStatsContext(ctx context.Context, pattern string) ([]ExtendedStatistic, error) {
+	return d.withContext(ctx).GetExtendedStats(pattern)
+}
+
+// GetExtensions executes \dx, returning the Extension results.
+func (d *PgDesc) GetExtensions(pattern string) ([]Extension, error) {
+	res, err := d.QueryExtensions(pattern)
+	if err != nil {
+		return nil, err
+	}
+	var v []Extension
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetExtensionsContext is the same as GetExtensions, but with a context.
+func (d *PgDesc) GetExtensionsContext(ctx context.Context, pattern string) ([]Extension, error) {
+	return d.withContext(ctx).GetExtensions(pattern)
+}
+
+// GetForeignDataWrappers executes \dew, returning the ForeignDataWrapper results.
+func (d *PgDesc) GetForeignDataWrappers(pattern string, verbose bool) ([]ForeignDataWrapper, error) {
+	res, err := d.QueryForeignDataWrappers(pattern, verbose)
+	if err != nil {
+		return nil, err
+	}
+	var v []ForeignDataWrapper
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetForeignDataWrappersContext is the same as GetForeignDataWrappers, but with a context.
+func (d *PgDesc) GetForeignDataWrappersContext(ctx context.Context, pattern string, verbose bool) ([]ForeignDataWrapper, error) {
+	return d.withContext(ctx).GetForeignDataWrappers(pattern, verbose)
+}
+
+// GetForeignServers executes \des, returning the ForeignServer results.
+func (d *PgDesc) GetForeignServers(pattern string, verbose bool) ([]ForeignServer, error) {
+	res, err := d.QueryForeignServers(pattern, verbose)
+	if err != nil {
+		return nil, err
+	}
+	var v []ForeignServer
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetForeignServersContext is the same as GetForeignServers, but with a context.
+func (d *PgDesc) GetForeignServersContext(ctx context.Context, pattern string, verbose bool) ([]ForeignServer, error) {
+	return d.withContext(ctx).GetForeignServers(pattern, verbose)
+}
+
+// GetForeignTables executes \det, returning the ForeignTable results.
+func (d *PgDesc) GetForeignTables(pattern string, verbose bool) ([]ForeignTable, error) {
+	res, err := d.QueryForeignTables(pattern, verbose)
+	if err != nil {
+		return nil, err
+	}
+	var v []ForeignTable
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetForeignTablesContext is the same as GetForeignTables, but with a context.
+func (d *PgDesc) GetForeignTablesContext(ctx context.Context, pattern string, verbose bool) ([]ForeignTable, error) {
+	return d.withContext(ctx).GetForeignTables(pattern, verbose)
+}
+
+// GetFunctions executes \df, \dfa, \dfn, \dft, \dfw, etc, returning the Function results.
+func (d *PgDesc) GetFunctions(functypes, pattern string, verbose, showSystem bool) ([]Function, error) {
+	res, err := d.QueryFunctions(functypes, pattern, verbose, showSystem)
+	if err != nil {
+		return nil, err
+	}
+	var v []Function
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetFunctionsContext is the same as GetFunctions, but with a context.
+func (d *PgDesc) GetFunctionsContext(ctx context.Context, functypes, pattern string, verbose, showSystem bool) ([]Function, error) {
+	return d.withContext(ctx).GetFunctions(functypes, pattern, verbose, showSystem)
+}
+
+// GetLanguages executes \dL, returning the Language results.
+func (d *PgDesc) GetLanguages(pattern string, verbose, showSystem bool) ([]Language, error) {
+	res, err := d.QueryLanguages(pattern, verbose, showSystem)
+	if err != nil {
+		return nil, err
+	}
+	var v []Language
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetLanguagesContext is the same as GetLanguages, but with a context.
+func (d *PgDesc) GetLanguagesContext(ctx context.Context, pattern string, verbose, showSystem bool) ([]Language, error) {
+	return d.withContext(ctx).GetLanguages(pattern, verbose, showSystem)
+}
+
+// GetObjectDescription executes \dd, returning the ObjectDescription results.
+func (d *PgDesc) GetObjectDescription(pattern string, showSystem bool) ([]ObjectDescription, error) {
+	res, err := d.QueryObjectDescription(pattern, showSystem)
+	if err != nil {
+		return nil, err
+	}
+	var v []ObjectDescription
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetObjectDescriptionContext is the same as GetObjectDescription, but with a context.
+func (d *PgDesc) GetObjectDescriptionContext(ctx context.Context, pattern string, showSystem bool) ([]ObjectDescription, error) {
+	return d.withContext(ctx).GetObjectDescription(pattern, showSystem)
+}
+
+// GetOneExtensionContents executes the contents query for a single extension, returning the ExtensionObject results.
+func (d *PgDesc) GetOneExtensionContents(extname, oid string) ([]ExtensionObject, error) {
+	res, err := d.QueryOneExtensionContents(extname, oid)
+	if err != nil {
+		return nil, err
+	}
+	var v []ExtensionObject
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetOneExtensionContentsContext is the same as GetOneExtensionContents, but with a context.
+func (d *PgDesc) GetOneExtensionContentsContext(ctx context.Context, extname, oid string) ([]ExtensionObject, error) {
+	return d.withContext(ctx).GetOneExtensionContents(extname, oid)
+}
+
+// GetOneTextSearchConfig executes the mapping query for a single text search configuration, returning the TextSearchConfigMapping results.
+func (d *PgDesc) GetOneTextSearchConfig(oid, nspname, cfgname, pnspname, prsname string) ([]TextSearchConfigMapping, error) {
+	res, err := d.QueryOneTextSearchConfig(oid, nspname, cfgname, pnspname, prsname)
+	if err != nil {
+		return nil, err
+	}
+	var v []TextSearchConfigMapping
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetOneTextSearchConfigContext is the same as GetOneTextSearchConfig, but with a context.
+func (d *PgDesc) GetOneTextSearchConfigContext(ctx context.Context, oid, nspname, cfgname, pnspname, prsname string) ([]TextSearchConfigMapping, error) {
+	return d.withContext(ctx).GetOneTextSearchConfig(oid, nspname, cfgname, pnspname, prsname)
+}
+
+// GetOneTextSearchParser executes the methods query for a single text search parser, returning the TextSearchParserMethod results.
+func (d *PgDesc) GetOneTextSearchParser(oid, nspname, prsname string) ([]TextSearchParserMethod, error) {
+	res, err := d.QueryOneTextSearchParser(oid, nspname, prsname)
+	if err != nil {
+		return nil, err
+	}
+	var v []TextSearchParserMethod
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetOneTextSearchParserContext is the same as GetOneTextSearchParser, but with a context.
+func (d *PgDesc) GetOneTextSearchParserContext(ctx context.Context, oid, nspname, prsname string) ([]TextSearchParserMethod, error) {
+	return d.withContext(ctx).GetOneTextSearchParser(oid, nspname, prsname)
+}
+
+// GetOperators executes \do, returning the Operator results.
+func (d *PgDesc) GetOperators(pattern string, verbose, showSystem bool) ([]Operator, error) {
+	res, err := d.QueryOperators(pattern, verbose, showSystem)
+	if err != nil {
+		return nil, err
+	}
+	var v []Operator
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetOperatorsContext is the same as GetOperators, but with a context.
+func (d *PgDesc) GetOperatorsContext(ctx context.Context, pattern string, verbose, showSystem bool) ([]Operator, error) {
+	return d.withContext(ctx).GetOperators(pattern, verbose, showSystem)
+}
+
+// GetPartitionedTables executes \dP, \dPt, \dPi, \dPn, etc., returning the PartitionedRelation results.
+func (d *PgDesc) GetPartitionedTables(reltypes, pattern string, verbose bool) ([]PartitionedRelation, error) {
+	res, err := d.QueryPartitionedTables(reltypes, pattern, verbose)
+	if err != nil {
+		return nil, err
+	}
+	var v []PartitionedRelation
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetPartitionedTablesContext is the same as GetPartitionedTables, but with a context.
+func (d *PgDesc) GetPartitionedTablesContext(ctx context.Context, reltypes, pattern string, verbose bool) ([]PartitionedRelation, error) {
+	return d.withContext(ctx).GetPartitionedTables(reltypes, pattern, verbose)
+}
+
+// GetPermissions executes \z (or \dp), returning the Permission results.
+func (d *PgDesc) GetPermissions(pattern string) ([]Permission, error) {
+	res, err := d.QueryPermissions(pattern)
+	if err != nil {
+		return nil, err
+	}
+	var v []Permission
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetPermissionsContext is the same as GetPermissions, but with a context.
+func (d *PgDesc) GetPermissionsContext(ctx context.Context, pattern string) ([]Permission, error) {
+	return d.withContext(ctx).GetPermissions(pattern)
+}
+
+// GetPublications executes \dRp, returning the Publication results.
+func (d *PgDesc) GetPublications(pattern string) ([]Publication, error) {
+	res, err := d.QueryPublications(pattern)
+	if err != nil {
+		return nil, err
+	}
+	var v []Publication
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetPublicationsContext is the same as GetPublications, but with a context.
+func (d *PgDesc) GetPublicationsContext(ctx context.Context, pattern string) ([]Publication, error) {
+	return d.withContext(ctx).GetPublications(pattern)
+}
+
+// GetRoleGrants executes \drg, returning the RoleGrant results.
+func (d *PgDesc) GetRoleGrants(pattern string, showSystem bool) ([]RoleGrant, error) {
+	res, err := d.QueryRoleGrants(pattern, showSystem)
+	if err != nil {
+		return nil, err
+	}
+	var v []RoleGrant
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetRoleGrantsContext is the same as GetRoleGrants, but with a context.
+func (d *PgDesc) GetRoleGrantsContext(ctx context.Context, pattern string, showSystem bool) ([]RoleGrant, error) {
+	return d.withContext(ctx).GetRoleGrants(pattern, showSystem)
+}
+
+// GetRoles executes \du, \dg, returning the Role results.
+func (d *PgDesc) GetRoles(pattern string, verbose, showSystem bool) ([]Role, error) {
+	res, err := d.QueryRoles(pattern, verbose, showSystem)
+	if err != nil {
+		return nil, err
+	}
+	var v []Role
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetRolesContext is the same as GetRoles, but with a context.
+func (d *PgDesc) GetRolesContext(ctx context.Context, pattern string, verbose, showSystem bool) ([]Role, error) {
+	return d.withContext(ctx).GetRoles(pattern, verbose, showSystem)
+}
+
+// GetSchemas executes \dn, returning the Schema results.
+func (d *PgDesc) GetSchemas(pattern string, verbose, showSystem bool) ([]Schema, error) {
+	res, err := d.QuerySchemas(pattern, verbose, showSystem)
+	if err != nil {
+		return nil, err
+	}
+	var v []Schema
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetSchemasContext is the same as GetSchemas, but with a context.
+func (d *PgDesc) GetSchemasContext(ctx context.Context, pattern string, verbose, showSystem bool) ([]Schema, error) {
+	return d.withContext(ctx).GetSchemas(pattern, verbose, showSystem)
+}
+
+// GetSubscriptions executes \dRs, returning the Subscription results.
+func (d *PgDesc) GetSubscriptions(pattern string, verbose bool) ([]Subscription, error) {
+	res, err := d.QuerySubscriptions(pattern, verbose)
+	if err != nil {
+		return nil, err
+	}
+	var v []Subscription
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetSubscriptionsContext is the same as GetSubscriptions, but with a context.
+func (d *PgDesc) GetSubscriptionsContext(ctx context.Context, pattern string, verbose bool) ([]Subscription, error) {
+	return d.withContext(ctx).GetSubscriptions(pattern, verbose)
+}
+
+// GetTables executes \dt, \di, \ds, \dS, etc, returning the Relation results.
+func (d *PgDesc) GetTables(tabtypes, pattern string, verbose, showSystem bool) ([]Relation, error) {
+	res, err := d.QueryTables(tabtypes, pattern, verbose, showSystem)
+	if err != nil {
+		return nil, err
+	}
+	var v []Relation
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetTablesContext is the same as GetTables, but with a context.
+func (d *PgDesc) GetTablesContext(ctx context.Context, tabtypes, pattern string, verbose, showSystem bool) ([]Relation, error) {
+	return d.withContext(ctx).GetTables(tabtypes, pattern, verbose, showSystem)
+}
+
+// GetTablespaces executes \db, returning the Tablespace results.
+func (d *PgDesc) GetTablespaces(pattern string, verbose bool) ([]Tablespace, error) {
+	res, err := d.QueryTablespaces(pattern, verbose)
+	if err != nil {
+		return nil, err
+	}
+	var v []Tablespace
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetTablespacesContext is the same as GetTablespaces, but with a context.
+func (d *PgDesc) GetTablespacesContext(ctx context.Context, pattern string, verbose bool) ([]Tablespace, error) {
+	return d.withContext(ctx).GetTablespaces(pattern, verbose)
+}
+
+// GetTextSearchConfigs executes \dF, returning the TextSearchConfig results.
+func (d *PgDesc) GetTextSearchConfigs(pattern string, verbose bool) ([]TextSearchConfig, error) {
+	res, err := d.QueryTextSearchConfigs(pattern, verbose)
+	if err != nil {
+		return nil, err
+	}
+	var v []TextSearchConfig
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetTextSearchConfigsContext is the same as GetTextSearchConfigs, but with a context.
+func (d *PgDesc) GetTextSearchConfigsContext(ctx context.Context, pattern string, verbose bool) ([]TextSearchConfig, error) {
+	return d.withContext(ctx).GetTextSearchConfigs(pattern, verbose)
+}
+
+// GetTextSearchDictionaries executes \dFd, returning the TextSearchDictionary results.
+func (d *PgDesc) GetTextSearchDictionaries(pattern string, verbose bool) ([]TextSearchDictionary, error) {
+	res, err := d.QueryTextSearchDictionaries(pattern, verbose)
+	if err != nil {
+		return nil, err
+	}
+	var v []TextSearchDictionary
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetTextSearchDictionariesContext is the same as GetTextSearchDictionaries, but with a context.
+func (d *PgDesc) GetTextSearchDictionariesContext(ctx context.Context, pattern string, verbose bool) ([]TextSearchDictionary, error) {
+	return d.withContext(ctx).GetTextSearchDictionaries(pattern, verbose)
+}
+
+// GetTextSearchParsers executes \dFp, returning the TextSearchParser results.
+func (d *PgDesc) GetTextSearchParsers(pattern string, verbose bool) ([]TextSearchParser, error) {
+	res, err := d.QueryTextSearchParsers(pattern, verbose)
+	if err != nil {
+		return nil, err
+	}
+	var v []TextSearchParser
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetTextSearchParsersContext is the same as GetTextSearchParsers, but with a context.
+func (d *PgDesc) GetTextSearchParsersContext(ctx context.Context, pattern string, verbose bool) ([]TextSearchParser, error) {
+	return d.withContext(ctx).GetTextSearchParsers(pattern, verbose)
+}
+
+// GetTextSearchTemplates executes \dFt, returning the TextSearchTemplate results.
+func (d *PgDesc) GetTextSearchTemplates(pattern string, verbose bool) ([]TextSearchTemplate, error) {
+	res, err := d.QueryTextSearchTemplates(pattern, verbose)
+	if err != nil {
+		return nil, err
+	}
+	var v []TextSearchTemplate
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetTextSearchTemplatesContext is the same as GetTextSearchTemplates, but with a context.
+func (d *PgDesc) GetTextSearchTemplatesContext(ctx context.Context, pattern string, verbose bool) ([]TextSearchTemplate, error) {
+	return d.withContext(ctx).GetTextSearchTemplates(pattern, verbose)
+}
+
+// GetTypes executes \dT, returning the Type results.
+func (d *PgDesc) GetTypes(pattern string, verbose, showSystem bool) ([]Type, error) {
+	res, err := d.QueryTypes(pattern, verbose, showSystem)
+	if err != nil {
+		return nil, err
+	}
+	var v []Type
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetTypesContext is the same as GetTypes, but with a context.
+func (d *PgDesc) GetTypesContext(ctx context.Context, pattern string, verbose, showSystem bool) ([]Type, error) {
+	return d.withContext(ctx).GetTypes(pattern, verbose, showSystem)
+}
+
+// GetUserMappings executes \deu, returning the UserMapping results.
+func (d *PgDesc) GetUserMappings(pattern string, verbose bool) ([]UserMapping, error) {
+	res, err := d.QueryUserMappings(pattern, verbose)
+	if err != nil {
+		return nil, err
+	}
+	var v []UserMapping
+	if err := res.Scan(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// GetUserMappingsContext is the same as GetUserMappings, but with a context.
+func (d *PgDesc) GetUserMappingsContext(ctx context.Context, pattern string, verbose bool) ([]UserMapping, error) {
+	return d.withContext(ctx).GetUserMappings(pattern, verbose)
+}
+
+// Scan scans the result rows into v, which must be a pointer to a slice of
+// structs.
+//
+// Struct fields are matched to result columns by their pgdesc tag, which
+// holds the untranslated column name. As the column names in the queries are
+// translated using GettextNoop, a field also matches the translation of its
+// tag. A tag option of "array" parses the value as a postgres array literal,
+// otherwise []string fields are split on newlines (as is done for aggregated
+// values such as access privileges).
+func (r *Result) Scan(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Slice || rv.Elem().Type().Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("cannot scan into %T: must be a pointer to a slice of structs", v)
+	}
+	slice := rv.Elem()
+	typ := slice.Type().Elem()
+
+	// map result columns to struct fields
+	fields, opts := make([]int, len(r.Columns)), make([]string, len(r.Columns))
+	for j, col := range r.Columns {
+		fields[j] = -1
+		for i := 0; i < typ.NumField(); i++ {
+			name, opt := typ.Field(i).Tag.Get("pgdesc"), ""
+			if k := strings.Index(name, ","); k != -1 {
+				name, opt = name[:k], name[k+1:]
+			}
+			if name != "" && (name == col || GettextNoop(name) == col) {
+				fields[j], opts[j] = i, opt
+				break
+			}
+		}
+	}
+
+	out := reflect.MakeSlice(slice.Type(), 0, r.Len())
+	for i := range r.Rows {
+		elem := reflect.New(typ).Elem()
+		for j, f := range fields {
+			if f == -1 || r.IsNull(i, j) {
+				continue
+			}
+			if err := setField(elem.Field(f), r.Value(i, j), opts[j]); err != nil {
+				return fmt.Errorf("cannot scan column %q: %v", r.Columns[j], err)
+			}
+		}
+		out = reflect.Append(out, elem)
+	}
+	slice.Set(out)
+
+	return nil
+}
+
+// setField sets the struct field f from the text value s.
+func setField(f reflect.Value, s, opt string) error {
+	switch f.Kind() {
+	case reflect.String:
+		f.SetString(s)
+	case reflect.Bool:
+		b, err := parseBool(s)
+		if err != nil {
+			return err
+		}
+		f.SetBool(b)
+	case reflect.Int, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		f.SetInt(i)
+	case reflect.Slice:
+		if f.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported field type %s", f.Type())
+		}
+		var v []string
+		var err error
+		switch {
+		case opt == "array":
+			v, err = parseArray(s)
+		case s != "":
+			v = strings.Split(s, "\n")
+		}
+		if err != nil {
+			return err
+		}
+		f.Set(reflect.ValueOf(v))
+	default:
+		return fmt.Errorf("unsupported field type %s", f.Type())
+	}
+	return nil
+}
+
+// parseBool parses a postgres boolean, or the yes/no values used by psql,
+// either untranslated or translated using GettextNoop.
+func parseBool(s string) (bool, error) {
+	switch s {
+	case "t", "true", "yes", GettextNoop("yes"):
+		return true, nil
+	case "f", "false", "no", GettextNoop("no"):
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid boolean %q", s)
+}
+
+// parseArray parses a one-dimensional postgres array literal.
+func parseArray(s string) ([]string, error) {
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, fmt.Errorf("invalid array %q", s)
+	}
+	s = s[1 : len(s)-1]
+	var v []string
+	for i := 0; i < len(s); {
+		var elem []byte
+		if s[i] == '"' {
+			for i++; i < len(s) && s[i] != '"'; i++ {
+				if s[i] == '\\' && i+1 < len(s) {
+					i++
+				}
+				elem = append(elem, s[i])
+			}
+			if i == len(s) {
+				return nil, fmt.Errorf("invalid array %q", s)
+			}
+			i++
+		} else {
+			for ; i < len(s) && s[i] != ','; i++ {
+				elem = append(elem, s[i])
+			}
+		}
+		v = append(v, string(elem))
+		if i < len(s) && s[i] == ',' {
+			i++
+		}
+	}
+	return v, nil
+}
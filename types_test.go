@@ -0,0 +1,201 @@
+package pgdesc
+
+import (
+	"database/sql"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// scanRow is a struct for testing Result.Scan.
+type scanRow struct {
+	Name       string   `pgdesc:"Name"`
+	Enabled    bool     `pgdesc:"Enabled"`
+	Count      int      `pgdesc:"Count"`
+	Privileges []string `pgdesc:"Access privileges"`
+	Members    []string `pgdesc:"Members,array"`
+	Untagged   string
+}
+
+func TestScan(t *testing.T) {
+	res := &Result{
+		Columns: []string{"Name", "Enabled", "Count", "Access privileges", "Members", "Other"},
+		Rows: [][]sql.NullString{
+			nullStrings("a", "t", "1", "postgres=UC/postgres\n=U/postgres", `{b,"c d","e\"f",NULL}`, "x"),
+			nullStrings("b", "no", "-2", "", "{}", "y"),
+			{{String: "c", Valid: true}, {}, {}, {}, {}, {}},
+		},
+	}
+	var v []scanRow
+	if err := res.Scan(&v); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	exp := []scanRow{
+		{"a", true, 1, []string{"postgres=UC/postgres", "=U/postgres"}, []string{"b", "c d", `e"f`, "NULL"}, ""},
+		{"b", false, -2, nil, nil, ""},
+		{Name: "c"},
+	}
+	if !reflect.DeepEqual(v, exp) {
+		t.Errorf("expected %+v, got: %+v", exp, v)
+	}
+}
+
+func TestScanTranslated(t *testing.T) {
+	translateNoop(t, map[string]string{
+		"Name":    "Nom",
+		"Enabled": "Activé",
+		"yes":     "oui",
+		"no":      "non",
+	})
+	res := &Result{
+		Columns: []string{"Nom", "Activé", "Count"},
+		Rows: [][]sql.NullString{
+			nullStrings("a", "oui", "1"),
+			nullStrings("b", "non", "2"),
+			nullStrings("c", "yes", "3"),
+		},
+	}
+	var v []scanRow
+	if err := res.Scan(&v); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	exp := []scanRow{
+		{Name: "a", Enabled: true, Count: 1},
+		{Name: "b", Enabled: false, Count: 2},
+		{Name: "c", Enabled: true, Count: 3},
+	}
+	if !reflect.DeepEqual(v, exp) {
+		t.Errorf("expected %+v, got: %+v", exp, v)
+	}
+}
+
+func TestScanErrors(t *testing.T) {
+	tests := []struct {
+		v     interface{}
+		value string
+		exp   string
+	}{
+		{[]scanRow{}, "", "must be a pointer to a slice of structs"},
+		{new(scanRow), "", "must be a pointer to a slice of structs"},
+		{new([]string), "", "must be a pointer to a slice of structs"},
+		{new([]scanRow), "maybe", `cannot scan column "Enabled": invalid boolean "maybe"`},
+	}
+	for _, test := range tests {
+		res := &Result{
+			Columns: []string{"Enabled"},
+			Rows:    [][]sql.NullString{nullStrings(test.value)},
+		}
+		err := res.Scan(test.v)
+		if err == nil || !strings.Contains(err.Error(), test.exp) {
+			t.Errorf("%T: expected error %q, got: %v", test.v, test.exp, err)
+		}
+	}
+	res := &Result{
+		Columns: []string{"Count", "Members"},
+		Rows:    [][]sql.NullString{nullStrings("1", "{a")},
+	}
+	if err := res.Scan(new([]scanRow)); err == nil || !strings.Contains(err.Error(), `cannot scan column "Members"`) {
+		t.Errorf("expected an invalid array error, got: %v", err)
+	}
+}
+
+func TestParseArray(t *testing.T) {
+	tests := []struct {
+		s   string
+		exp []string
+		err bool
+	}{
+		{"{}", nil, false},
+		{"{a}", []string{"a"}, false},
+		{"{a,b,c}", []string{"a", "b", "c"}, false},
+		{`{"a b","c,d"}`, []string{"a b", "c,d"}, false},
+		{`{"a\"b","c\\d"}`, []string{`a"b`, `c\d`}, false},
+		{`{"",x}`, []string{"", "x"}, false},
+		{"", nil, true},
+		{"a,b", nil, true},
+		{"{a", nil, true},
+		{`{"a}`, nil, true},
+	}
+	for _, test := range tests {
+		v, err := parseArray(test.s)
+		switch {
+		case test.err && err == nil:
+			t.Errorf("%q: expected an error, got: %q", test.s, v)
+		case !test.err && err != nil:
+			t.Errorf("%q: expected no error, got: %v", test.s, err)
+		case !reflect.DeepEqual(v, test.exp):
+			t.Errorf("%q: expected %q, got: %q", test.s, test.exp, v)
+		}
+	}
+}
+
+func TestGet(t *testing.T) {
+	db, fdb := openFakeDB(t, "lists")
+	d := NewPgDesc(db, 170000)
+	pubs, err := d.GetPublications("")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	expPubs := []Publication{
+		{"pgdesc_all", "postgres", true, true, true, true, true, false},
+		{"pgdesc_pub", "postgres", false, true, true, false, true, true},
+	}
+	if !reflect.DeepEqual(pubs, expPubs) {
+		t.Errorf("expected %+v, got: %+v", expPubs, pubs)
+	}
+	schemas, err := d.GetSchemas("", false, false)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	expSchemas := []Schema{
+		{"pgdesc_s", "postgres", []string{"postgres=UC/postgres"}, ""},
+		{"public", "pg_database_owner", nil, "standard public schema"},
+	}
+	if !reflect.DeepEqual(schemas, expSchemas) {
+		t.Errorf("expected %+v, got: %+v", expSchemas, schemas)
+	}
+	if names, exp := fdb.names(), []string{"publications", "schemas"}; !reflect.DeepEqual(names, exp) {
+		t.Errorf("expected queries %q, got: %q", exp, names)
+	}
+
+	// translated column names
+	translateNoop(t, map[string]string{
+		"Name":       "Nom",
+		"Owner":      "Propriétaire",
+		"All tables": "Toutes les tables",
+		"Inserts":    "Insertions",
+		"Updates":    "Mises à jour",
+		"Deletes":    "Suppressions",
+		"Truncates":  "Tronque",
+		"Via root":   "Via la racine",
+	})
+	pubs, err = d.GetPublications("")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !reflect.DeepEqual(pubs, expPubs) {
+		t.Errorf("translated: expected %+v, got: %+v", expPubs, pubs)
+	}
+}
+
+// nullStrings returns the values as non-NULL values.
+func nullStrings(values ...string) []sql.NullString {
+	v := make([]sql.NullString, len(values))
+	for i, s := range values {
+		v[i] = sql.NullString{String: s, Valid: true}
+	}
+	return v
+}
+
+// translateNoop overrides GettextNoop with the translations in m for the
+// duration of the test.
+func translateNoop(t *testing.T, m map[string]string) {
+	prev := GettextNoop
+	t.Cleanup(func() { GettextNoop = prev })
+	GettextNoop = func(s string) string {
+		if v, ok := m[s]; ok {
+			return v
+		}
+		return s
+	}
+}
@@ -1,7 +1,9 @@
 package pgdesc
 
 import (
+	"context"
 	"fmt"
+	"io"
 )
 
 // Various constants.
@@ -22,16 +24,35 @@ var GettextNoop = func(s string) string {
 // PgDesc handles executing and displaying schema descriptions for a postgres
 // database.
 type PgDesc struct {
-	db       interface{}
+	db       Queryer
 	version  int
 	sversion string
+	dbname   string
+	printer  Printer
+	errw     io.Writer
+	ctx      context.Context
 }
 
 // NewPgDesc creates a new PgDesc for the supplied database and options.
+//
+// The db handle must satisfy Queryer (ie, *sql.DB) to execute queries, and
+// may be nil when only building query text. Executing a query with any other
+// db handle returns an error. Use NewPgDescFromServer to detect the version
+// from the server.
 func NewPgDesc(db interface{}, version int, opts ...Option) *PgDesc {
 	d := &PgDesc{
-		db:      db,
 		version: version,
+		errw:    io.Discard,
+	}
+	switch v := db.(type) {
+	case nil:
+	case Queryer:
+		d.db = v
+	default:
+		d.db = invalidQueryer{db}
+	}
+	if version != 0 {
+		d.sversion = formatPGVersionNumber(version, false)
 	}
 
 	// apply opts
@@ -44,3 +65,47 @@ func NewPgDesc(db interface{}, version int, opts ...Option) *PgDesc {
 
 // Option is a postgres description option.
 type Option func(*PgDesc)
+
+// WithDatabase is a postgres description option to set the name of the
+// current database, used to check the database part of database.schema.name
+// patterns. When not set, the current database is queried using the database
+// handle.
+func WithDatabase(dbname string) Option {
+	return func(d *PgDesc) {
+		d.dbname = dbname
+	}
+}
+
+// WithErrorWriter is a postgres description option to set the writer for
+// the messages that psql logs without failing the command (ie, "Did not find
+// any relations."). The messages are discarded when not set.
+func WithErrorWriter(w io.Writer) Option {
+	return func(d *PgDesc) {
+		d.errw = w
+	}
+}
+
+// logError writes a message to the error writer, similar to psql's
+// pg_log_error when the command does not fail.
+func (d *PgDesc) logError(s string, v ...interface{}) {
+	fmt.Fprintf(d.errw, s, v...)
+}
+
+// withContext returns a shallow copy of the description that uses ctx for
+// executing queries. A nil ctx is treated as context.Background().
+func (d *PgDesc) withContext(ctx context.Context) *PgDesc {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	c := *d
+	c.ctx = ctx
+	return &c
+}
+
+// context returns the context for executing queries.
+func (d *PgDesc) context() context.Context {
+	if d.ctx != nil {
+		return d.ctx
+	}
+	return context.Background()
+}
@@ -0,0 +1,139 @@
+package pgdesc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// NewPgDescFromServer creates a new PgDesc for the supplied database and
+// options, detecting the server version using the database handle.
+func NewPgDescFromServer(db Queryer, opts ...Option) (*PgDesc, error) {
+	d := NewPgDesc(db, 0, opts...)
+	if err := d.DetectVersion(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// NewPgDescFromServerContext is the same as NewPgDescFromServer, but with a
+// context.
+func NewPgDescFromServerContext(ctx context.Context, db Queryer, opts ...Option) (*PgDesc, error) {
+	d := NewPgDesc(db, 0, opts...)
+	if err := d.DetectVersionContext(ctx); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// DetectVersion queries the server's server_version_num and server_version
+// settings using the database handle, and sets the server version used to
+// build queries.
+//
+// When server_version_num is not available (ie, servers older than 8.2), the
+// version number is parsed from server_version, in the same manner as libpq.
+func (d *PgDesc) DetectVersion() error {
+	res, err := d.exec(
+		"SELECT name, setting\n" +
+			"FROM pg_catalog.pg_settings\n" +
+			"WHERE name IN ('server_version', 'server_version_num')")
+	if err != nil {
+		return err
+	}
+
+	var num, str string
+	for i := 0; i < res.Len(); i++ {
+		switch res.Value(i, 0) {
+		case "server_version_num":
+			num = res.Value(i, 1)
+		case "server_version":
+			str = res.Value(i, 1)
+		}
+	}
+
+	var version int
+	switch {
+	case num != "":
+		if version, err = strconv.Atoi(num); err != nil {
+			return fmt.Errorf("invalid server_version_num %q", num)
+		}
+	case str != "":
+		version = parseServerVersion(str)
+	}
+	if version == 0 {
+		return fmt.Errorf("could not determine server version")
+	}
+
+	d.version, d.sversion = version, formatPGVersionNumber(version, false)
+	return nil
+}
+
+// DetectVersionContext is the same as DetectVersion, but with a context.
+func (d *PgDesc) DetectVersionContext(ctx context.Context) error {
+	return d.withContext(ctx).DetectVersion()
+}
+
+// Version returns the server version number (ie, 90624 or 140002).
+func (d *PgDesc) Version() int {
+	return d.version
+}
+
+// parseServerVersion parses a server_version string into a version number,
+// in the same manner as libpq's pqSaveParameterStatus. Returns 0 when the
+// version cannot be parsed.
+func parseServerVersion(s string) int {
+	var v []int
+	for len(v) < 3 {
+		i := 0
+		for i < len(s) && '0' <= s[i] && s[i] <= '9' {
+			i++
+		}
+		if i == 0 {
+			break
+		}
+		n, _ := strconv.Atoi(s[:i])
+		v, s = append(v, n), s[i:]
+		if len(s) == 0 || s[0] != '.' {
+			break
+		}
+		s = s[1:]
+	}
+
+	switch len(v) {
+	case 3:
+		// old style, e.g. 9.6.1
+		return (100*v[0]+v[1])*100 + v[2]
+	case 2:
+		if v[0] >= 10 {
+			// new style, e.g. 10.1
+			return 100*100*v[0] + v[1]
+		}
+		// old style without minor version, e.g. 9.6devel
+		return (100*v[0] + v[1]) * 100
+	case 1:
+		// new style without minor version, e.g. 10devel
+		return 100 * 100 * v[0]
+	}
+	return 0
+}
+
+// formatPGVersionNumber formats a version number as a string, in the same
+// manner as psql's formatPGVersionNumber (ie, "9.6.24" or "14.2").
+//
+// When includeMinor is false, the minor version is omitted (ie, "9.6" or
+// "14").
+func formatPGVersionNumber(version int, includeMinor bool) string {
+	if version >= 100000 {
+		// new two-part style
+		if includeMinor {
+			return fmt.Sprintf("%d.%d", version/10000, version%10000)
+		}
+		return fmt.Sprintf("%d", version/10000)
+	}
+
+	// old three-part style
+	if includeMinor {
+		return fmt.Sprintf("%d.%d.%d", version/10000, (version/100)%100, version%100)
+	}
+	return fmt.Sprintf("%d.%d", version/10000, (version/100)%100)
+}
@@ -0,0 +1,82 @@
+package pgdesc
+
+import (
+	"testing"
+)
+
+func TestParseServerVersion(t *testing.T) {
+	tests := []struct {
+		s   string
+		exp int
+	}{
+		// old three-part style
+		{"8.4.22", 80422},
+		{"9.6.24", 90624},
+		{"9.0.1", 90001},
+		// old style devel and beta, without minor version
+		{"9.6devel", 90600},
+		{"9.6beta1", 90600},
+		{"9.6rc1", 90600},
+		{"9.6", 90600},
+		// new two-part style
+		{"10.1", 100001},
+		{"14.2", 140002},
+		{"16.10", 160010},
+		{"14.2 (Debian 14.2-1.pgdg110+1)", 140002},
+		// new style devel and beta, without minor version
+		{"10devel", 100000},
+		{"15beta3", 150000},
+		{"17rc1", 170000},
+		{"18", 180000},
+		// invalid
+		{"", 0},
+		{"devel", 0},
+		{".1", 0},
+	}
+	for _, test := range tests {
+		if v := parseServerVersion(test.s); v != test.exp {
+			t.Errorf("parseServerVersion(%q) expected %d, got: %d", test.s, test.exp, v)
+		}
+	}
+}
+
+func TestFormatPGVersionNumber(t *testing.T) {
+	tests := []struct {
+		version int
+		minor   string
+		major   string
+	}{
+		{80422, "8.4.22", "8.4"},
+		{90001, "9.0.1", "9.0"},
+		{90600, "9.6.0", "9.6"},
+		{90624, "9.6.24", "9.6"},
+		{100000, "10.0", "10"},
+		{100001, "10.1", "10"},
+		{140002, "14.2", "14"},
+		{160010, "16.10", "16"},
+	}
+	for _, test := range tests {
+		if s := formatPGVersionNumber(test.version, true); s != test.minor {
+			t.Errorf("formatPGVersionNumber(%d, true) expected %q, got: %q", test.version, test.minor, s)
+		}
+		if s := formatPGVersionNumber(test.version, false); s != test.major {
+			t.Errorf("formatPGVersionNumber(%d, false) expected %q, got: %q", test.version, test.major, s)
+		}
+	}
+}
+
+func TestParseFormatVersionRoundTrip(t *testing.T) {
+	for _, s := range []string{"8.4.22", "9.6.24", "10.1", "14.2", "16.10"} {
+		if v := formatPGVersionNumber(parseServerVersion(s), true); v != s {
+			t.Errorf("formatPGVersionNumber(parseServerVersion(%q)) expected %q, got: %q", s, s, v)
+		}
+	}
+}
+
+func TestUnsupportedVersionMessage(t *testing.T) {
+	d := NewPgDesc(nil, 90503)
+	err := d.AccessMethods(new(QueryBuffer), "", false)
+	if exp := "The server (version 9.5) does not support access methods.\n"; err == nil || err.Error() != exp {
+		t.Errorf("expected error %q, got: %v", exp, err)
+	}
+}